@@ -7,10 +7,12 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	docs "github.com/samthehai/ml-backend-test-samthehai/docs"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/middlewares"
 	moviehandlers "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http"
 	movierepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
 	movieusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
 	userhandlers "github.com/samthehai/ml-backend-test-samthehai/internal/user/interfaceadapters/http"
 	userrepository "github.com/samthehai/ml-backend-test-samthehai/internal/user/interfaceadapters/repository"
 	userusecase "github.com/samthehai/ml-backend-test-samthehai/internal/user/usecase"
@@ -26,30 +28,97 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 	userRepository := userrepository.NewUserRepository(s.connManager)
 	movieRepository := movierepository.NewMovieRepository(s.connManager)
 	favoriteRepository := movierepository.NewFavoriteRepository(s.connManager)
+	reviewRepository := movierepository.NewReviewRepository(s.connManager)
+	ratingRepository := movierepository.NewRatingRepository(s.connManager)
+	reviewVoteRepository := movierepository.NewReviewVoteRepository(s.connManager)
+	commentRepository := movierepository.NewCommentRepository(s.connManager)
+	reportRepository := movierepository.NewReportRepository(s.connManager)
+	moderationRepository := movierepository.NewModerationRepository(s.connManager)
+	postRepository := movierepository.NewPostRepository(s.connManager)
+	genreRepository := movierepository.NewGenreRepository(s.connManager)
+	creditRepository := movierepository.NewCreditRepository(s.connManager)
+	personRepository := movierepository.NewPersonRepository(s.connManager)
+	movieTranslationRepository := movierepository.NewMovieTranslationRepository(s.connManager)
+	collectionRepository := movierepository.NewCollectionRepository(s.connManager)
+	releaseDateRepository := movierepository.NewReleaseDateRepository(s.connManager)
+	tagRepository := movierepository.NewTagRepository(s.connManager)
+	externalIDRepository := movierepository.NewExternalIDRepository(s.connManager)
+	boxOfficeRepository := movierepository.NewBoxOfficeRepository(s.connManager)
+
+	// searcher
+	movieSearcher, err := s.newMovieSearcher(movieRepository)
+	if err != nil {
+		return err
+	}
 
 	tokenMaker, err := token.NewJWTMaker(s.cfg.Server.JWTSecretKey)
 	if err != nil {
 		return err
 	}
 
+	// content filter
+	contentFilterCfg := s.cfg.ContentFilter
+	contentFilter := contentfilter.NewPipeline(
+		contentfilter.NewProfanityFilter(contentFilterCfg.ProfanityWords),
+		contentfilter.NewLinkFilter(contentFilterCfg.MaxLinks, contentFilterCfg.BlockedDomains),
+		contentfilter.NewRateFilter(postRepository, contentFilterCfg.RateWindow, contentFilterCfg.RateLimit),
+		contentfilter.NewDuplicateFilter(postRepository, contentFilterCfg.DuplicateWindow, contentFilterCfg.DuplicatePosts),
+	)
+
 	// usecase
 	userUsecase := userusecase.NewUserUsecase(*s.cfg, userRepository, s.logger, tokenMaker)
-	movieUsecase := movieusecase.NewMovieUsecase(*s.cfg, s.logger, movieRepository, favoriteRepository)
+	movieUsecase := movieusecase.NewMovieUsecase(*s.cfg, s.logger, movieRepository, movieSearcher, favoriteRepository,
+		movieTranslationRepository, collectionRepository, releaseDateRepository, externalIDRepository)
+	reviewUsecase := movieusecase.NewReviewUsecase(*s.cfg, s.logger, movieRepository, reviewRepository,
+		reportRepository, contentFilter)
+	ratingUsecase := movieusecase.NewRatingUsecase(*s.cfg, s.logger, movieRepository, ratingRepository)
+	reviewVoteUsecase := movieusecase.NewReviewVoteUsecase(*s.cfg, s.logger, reviewRepository, reviewVoteRepository)
+	commentUsecase := movieusecase.NewCommentUsecase(*s.cfg, s.logger, reviewRepository, commentRepository,
+		reportRepository, contentFilter)
+	moderationUsecase := movieusecase.NewModerationUsecase(*s.cfg, s.logger, reviewRepository, commentRepository,
+		reportRepository, moderationRepository)
+	genreUsecase := movieusecase.NewGenreUsecase(*s.cfg, s.logger, genreRepository)
+	creditUsecase := movieusecase.NewCreditUsecase(*s.cfg, s.logger, movieRepository, creditRepository, personRepository)
+	collectionUsecase := movieusecase.NewCollectionUsecase(*s.cfg, s.logger, collectionRepository, favoriteRepository,
+		movieTranslationRepository)
+	tagUsecase := movieusecase.NewTagUsecase(*s.cfg, s.logger, movieRepository, movieSearcher, tagRepository,
+		movieTranslationRepository)
+	boxOfficeUsecase := movieusecase.NewBoxOfficeUsecase(*s.cfg, s.logger, movieRepository, boxOfficeRepository)
 
 	// middlewares
 	middlewareManager := middlewares.NewMiddlewareManager(s.cfg, s.logger, userUsecase)
+	authMiddleware := middlewareManager.AuthMiddleware(tokenMaker)
+	optionalAuthMiddleware := middlewareManager.OptionalAuthMiddleware(tokenMaker)
+	moderatorMiddleware := middlewareManager.RoleMiddleware(entity.UserRoleModerator, entity.UserRoleAdmin)
+	adminMiddleware := middlewareManager.RoleMiddleware(entity.UserRoleAdmin)
 
 	// handlers
-	userHanlders := userhandlers.NewUserHandlers(s.cfg, userUsecase, s.logger)
-	movieHanlders := moviehandlers.NewMovieHandlers(s.cfg, movieUsecase, s.logger, middlewareManager.GetCurrentUser)
+	userHanlders := userhandlers.NewUserHandlers(s.cfg, userUsecase, s.logger, middlewareManager.GetCurrentUser)
+	movieHanlders := moviehandlers.NewMovieHandlers(s.cfg, movieUsecase, s.logger, middlewareManager.GetCurrentUser,
+		middlewareManager.LookupCurrentUser)
+	reviewHandlers := moviehandlers.NewReviewHandlers(s.cfg, reviewUsecase, s.logger, middlewareManager.GetCurrentUser,
+		middlewareManager.LookupCurrentUser)
+	ratingHandlers := moviehandlers.NewRatingHandlers(s.cfg, ratingUsecase, s.logger, middlewareManager.GetCurrentUser)
+	reviewVoteHandlers := moviehandlers.NewReviewVoteHandlers(s.cfg, reviewVoteUsecase, s.logger, middlewareManager.GetCurrentUser)
+	commentHandlers := moviehandlers.NewCommentHandlers(s.cfg, commentUsecase, s.logger, middlewareManager.GetCurrentUser,
+		middlewareManager.LookupCurrentUser)
+	moderationHandlers := moviehandlers.NewModerationHandlers(s.cfg, moderationUsecase, s.logger,
+		middlewareManager.GetCurrentUser)
+	genreHandlers := moviehandlers.NewGenreHandlers(s.cfg, genreUsecase, s.logger)
+	creditHandlers := moviehandlers.NewCreditHandlers(s.cfg, creditUsecase, s.logger)
+	collectionHandlers := moviehandlers.NewCollectionHandlers(s.cfg, collectionUsecase, s.logger,
+		middlewareManager.GetCurrentUser)
+	tagHandlers := moviehandlers.NewTagHandlers(s.cfg, tagUsecase, s.logger, middlewareManager.GetCurrentUser)
+	boxOfficeHandlers := moviehandlers.NewBoxOfficeHandlers(s.cfg, boxOfficeUsecase, s.logger)
 
 	docs.SwaggerInfo.Title = "MonstarLab Backend Test REST API"
 	docs.SwaggerInfo.BasePath = "/api/v1"
 	e.GET("/swagger/*", echoswagger.WrapHandler)
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestID, csrf.CSRFHeader},
+		AllowOrigins:  []string{"*"},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestID, csrf.CSRFHeader},
+		ExposeHeaders: []string{"Link"},
 	}))
 	e.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
 		StackSize:         1 << 10, // 1 KB
@@ -72,16 +141,82 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 	userGroup := v1.Group("/users")
 	userGroup.POST("/register", userHanlders.Register())
 	userGroup.POST("/login", userHanlders.Login())
+	userGroup.GET("/me/preferences", userHanlders.GetPreferences(), authMiddleware)
+	userGroup.PUT("/me/preferences", userHanlders.UpdatePreferences(), authMiddleware)
+
+	// admin api
+	adminGroup := v1.Group("/admin", authMiddleware, adminMiddleware)
+	adminGroup.PUT("/users/:id/critic", userHanlders.SetCritic())
 
 	// movie api
 	movieGroup := v1.Group("/movies")
-	movieGroup.GET("", movieHanlders.SearchByKeyword())
+	movieGroup.GET("", movieHanlders.SearchByKeyword(), optionalAuthMiddleware)
 	movieGroup.GET("/:id", movieHanlders.GetByID())
+	movieGroup.GET("/external/:source/:id", movieHanlders.GetByExternalID())
+	movieGroup.POST("", movieHanlders.CreateMovie(), authMiddleware, adminMiddleware)
+	movieGroup.PUT("/:id", movieHanlders.UpdateMovie(), authMiddleware, adminMiddleware)
+	movieGroup.PATCH("/:id", movieHanlders.PatchMovie(), authMiddleware, adminMiddleware)
+	movieGroup.DELETE("/:id", movieHanlders.DeleteMovie(), authMiddleware, adminMiddleware)
+	movieGroup.POST("/:id/restore", movieHanlders.RestoreMovie(), authMiddleware, adminMiddleware)
+	movieGroup.PUT("/:id/external_ids/:source", movieHanlders.SetExternalID(), authMiddleware, adminMiddleware)
+	movieGroup.GET("/:id/credits", creditHandlers.ListMovieCredits())
+	movieGroup.GET("/:id/box-office", boxOfficeHandlers.GetBoxOffice())
+	movieGroup.GET("/:id/reviews", reviewHandlers.ListReviews(), optionalAuthMiddleware)
+	movieGroup.POST("/:id/reviews", reviewHandlers.CreateReview(), authMiddleware)
+	movieGroup.GET("/:id/rating", ratingHandlers.GetRating(), authMiddleware)
+	movieGroup.PUT("/:id/rating", ratingHandlers.RateMovie(), authMiddleware)
+	movieGroup.DELETE("/:id/rating", ratingHandlers.DeleteRating(), authMiddleware)
+	movieGroup.GET("/:id/tags", tagHandlers.ListMovieTags())
+	movieGroup.POST("/:id/tags", tagHandlers.AddMovieTags(), authMiddleware)
+	movieGroup.DELETE("/:id/tags/:slug", tagHandlers.DeleteMovieTag(), authMiddleware)
+
+	// genre api
+	genreGroup := v1.Group("/genres")
+	genreGroup.GET("", genreHandlers.ListGenres())
+
+	// people api
+	peopleGroup := v1.Group("/people")
+	peopleGroup.GET("/:id", creditHandlers.GetPerson())
+
+	// collection api
+	collectionGroup := v1.Group("/collections")
+	collectionGroup.GET("/:id", collectionHandlers.GetCollection())
+
+	// tag api
+	tagGroup := v1.Group("/tags")
+	tagGroup.GET("/:slug", tagHandlers.GetTag())
+
+	// review api
+	reviewGroup := v1.Group("/reviews")
+	reviewGroup.GET("/:id", reviewHandlers.GetReviewByID(), optionalAuthMiddleware)
+	reviewGroup.PUT("/:id", reviewHandlers.UpdateReview(), authMiddleware)
+	reviewGroup.DELETE("/:id", reviewHandlers.DeleteReview(), authMiddleware)
+	reviewGroup.GET("/:id/revisions", reviewHandlers.ListReviewRevisions(), optionalAuthMiddleware)
+	reviewGroup.PUT("/:id/vote", reviewVoteHandlers.VoteReview(), authMiddleware)
+	reviewGroup.DELETE("/:id/vote", reviewVoteHandlers.DeleteReviewVote(), authMiddleware)
+	reviewGroup.GET("/:id/comments", commentHandlers.ListComments(), optionalAuthMiddleware)
+	reviewGroup.POST("/:id/comments", commentHandlers.CreateComment(), authMiddleware)
+	reviewGroup.POST("/:id/reports", moderationHandlers.ReportReview(), authMiddleware)
+
+	// comment api
+	commentGroup := v1.Group("/comments")
+	commentGroup.GET("/:id", commentHandlers.GetCommentByID(), optionalAuthMiddleware)
+	commentGroup.GET("/:id/replies", commentHandlers.ListReplies(), optionalAuthMiddleware)
+	commentGroup.PUT("/:id", commentHandlers.UpdateComment(), authMiddleware)
+	commentGroup.DELETE("/:id", commentHandlers.DeleteComment(), authMiddleware)
+	commentGroup.POST("/:id/reports", moderationHandlers.ReportComment(), authMiddleware)
+
+	// moderation api
+	moderationGroup := v1.Group("/moderation", authMiddleware, moderatorMiddleware)
+	moderationGroup.GET("/reports", moderationHandlers.ListReports())
+	moderationGroup.POST("/reviews/:id", moderationHandlers.ModerateReview())
+	moderationGroup.POST("/comments/:id", moderationHandlers.ModerateComment())
 
 	// favorite api
-	favoriteGroup := v1.Group("/favorites", middlewareManager.AuthMiddleware(tokenMaker))
+	favoriteGroup := v1.Group("/favorites", authMiddleware)
 	favoriteGroup.GET("", movieHanlders.ListFavoriteMovies())
 	favoriteGroup.POST("/:id", movieHanlders.AddFavoriteMovie())
+	favoriteGroup.POST("/collections/:id", collectionHandlers.AddFavoriteCollection())
 
 	// health check api
 	health := v1.Group("/health")
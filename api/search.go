@@ -0,0 +1,35 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	movierepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// newMovieSearcher returns the movie searcher of the backend of the config, the Bleve index is opened, or created
+// empty until it is reindexed, and it is closed when the server exits
+func (s *Server) newMovieSearcher(movieRepository repository.MovieRepository) (repository.MovieSearcher, error) {
+	switch s.cfg.Search.Backend {
+	case "", config.SearchBackendMySQL:
+		return movierepository.NewMySQLMovieSearcher(movieRepository), nil
+	case config.SearchBackendBleve:
+		bleveCfg := s.cfg.Search.Bleve
+		index, err := movierepository.OpenMovieIndex(bleveCfg.IndexPath)
+		if err != nil {
+			return nil, fmt.Errorf("OpenMovieIndex: %w", err)
+		}
+		s.closers = append(s.closers, index)
+
+		return movierepository.NewBleveMovieSearcher(s.connManager, index, movieRepository, bleveCfg.MaxHits,
+			movierepository.MovieFieldBoosts{
+				Title:    bleveCfg.TitleBoost,
+				Overview: bleveCfg.OverviewBoost,
+				Person:   bleveCfg.PersonBoost,
+				Tag:      bleveCfg.TagBoost,
+			}), nil
+	}
+
+	return nil, fmt.Errorf("unknown search backend: %s", s.cfg.Search.Backend)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
@@ -27,6 +28,8 @@ type Server struct {
 	cfg         *config.Config
 	logger      logger.Logger
 	connManager ConnManager
+	// closers are closed when the server exits
+	closers []io.Closer
 }
 
 func NewServer(cfg *config.Config, logger logger.Logger, connManager ConnManager) *Server {
@@ -61,7 +64,16 @@ func (s *Server) Run() error {
 
 	ctx, shutdown := context.WithTimeout(context.Background(), ctxTimeout*time.Second)
 	defer shutdown()
+	defer s.closeAll()
 
 	s.logger.Info("Server Exited Properly")
 	return s.echo.Server.Shutdown(ctx)
 }
+
+func (s *Server) closeAll() {
+	for _, closer := range s.closers {
+		if err := closer.Close(); err != nil {
+			s.logger.Errorf("Error closing: %v", err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	movierepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/db/mysql"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/spf13/viper"
+)
+
+const defaultConfigPath = "config"
+
+// reindex rebuilds the Bleve index of the movies from the catalog. The new index is built next to the current one
+// which it replaces once every movie is indexed, so the current index is left as it is when the reindex fails.
+// The index is opened by one process at a time, the server has to be stopped while it is replaced
+func main() {
+	configPath := viper.GetString("CONFIG_PATH")
+	if len(configPath) == 0 {
+		configPath = defaultConfigPath
+	}
+
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		log.Fatalf("LoadConfig: %v", err)
+	}
+
+	appLogger := logger.NewApiLogger(cfg)
+	appLogger.InitLogger()
+
+	connManager, closeAllConn, err := mysql.NewConnManager(cfg)
+	if err != nil {
+		log.Fatalf("failed to init mysql client: %v", err)
+	}
+	defer closeAllConn()
+
+	bleveCfg := cfg.Search.Bleve
+	indexPath := bleveCfg.IndexPath
+	newIndexPath := indexPath + ".reindex"
+	if err := os.RemoveAll(newIndexPath); err != nil {
+		log.Fatalf("RemoveAll: %v", err)
+	}
+
+	index, err := bleve.New(newIndexPath, movierepository.NewMovieIndexMapping())
+	if err != nil {
+		log.Fatalf("failed to create the index: %v", err)
+	}
+
+	searcher := movierepository.NewBleveMovieSearcher(connManager, index,
+		movierepository.NewMovieRepository(connManager), bleveCfg.MaxHits, movierepository.MovieFieldBoosts{
+			Title:    bleveCfg.TitleBoost,
+			Overview: bleveCfg.OverviewBoost,
+			Person:   bleveCfg.PersonBoost,
+			Tag:      bleveCfg.TagBoost,
+		})
+	count, err := searcher.IndexAll(context.Background())
+	if err != nil {
+		log.Fatalf("IndexAll: %v", err)
+	}
+
+	if err := index.Close(); err != nil {
+		log.Fatalf("failed to close the index: %v", err)
+	}
+
+	if err := os.RemoveAll(indexPath); err != nil {
+		log.Fatalf("RemoveAll: %v", err)
+	}
+
+	if err := os.Rename(newIndexPath, indexPath); err != nil {
+		log.Fatalf("Rename: %v", err)
+	}
+
+	appLogger.Infof("Indexed %d movies into %s", count, indexPath)
+}
@@ -9,9 +9,16 @@ import (
 )
 
 type Config struct {
-	Server ServerConfig
-	MySQL  MySQLConfig
-	Logger Logger
+	Server        ServerConfig
+	MySQL         MySQLConfig
+	Logger        Logger
+	Ranking       RankingConfig
+	MovieList     MovieListConfig
+	Search        SearchConfig
+	Review        ReviewConfig
+	Comment       CommentConfig
+	Moderation    ModerationConfig
+	ContentFilter ContentFilterConfig
 }
 
 type ServerConfig struct {
@@ -29,6 +36,93 @@ type Logger struct {
 	Level             string
 }
 
+// RankingConfig configures the weighted score which orders the popular movies list.
+// The rating part is the IMDb-style bayesian average (v / (v + m)) * R + (m / (v + m)) * C where
+// v is the rating count of the movie, R its average rating, C the average rating of all movies
+// and m is MinimumVotes, the favorite part is ln(1 + number of favorites). A ranking which is not configured is
+// defaulted to MinimumVotes 10, RatingWeight 1 and FavoriteWeight 0.5, and MinimumVotes which is 0 to 10.
+type RankingConfig struct {
+	MinimumVotes   uint64
+	RatingWeight   float64
+	FavoriteWeight float64
+}
+
+// MovieListConfig pages the top movies, the search result, the favorite movies, the movies of a tag or of a collection
+// and the filmography of a person, a page size which is 0 is defaulted to 20 and a max page size which is 0 to 100
+type MovieListConfig struct {
+	DefaultPageSize uint
+	MaxPageSize     uint
+}
+
+const (
+	// SearchBackendMySQL searches the movies with the FULLTEXT indexes of MySQL
+	SearchBackendMySQL = "mysql"
+	// SearchBackendBleve searches the movies with an embedded Bleve index which is kept on disk
+	SearchBackendBleve = "bleve"
+)
+
+// SearchConfig chooses the Backend which searches the movies, MySQL is used when it is empty, and highlights the
+// search result: the matched terms are wrapped in HighlightPreTag and HighlightPostTag, a fragment of a long text is
+// about FragmentSize characters and a text has at most MaxFragments fragments
+type SearchConfig struct {
+	Backend          string
+	HighlightPreTag  string
+	HighlightPostTag string
+	FragmentSize     int
+	MaxFragments     int
+	Bleve            BleveConfig
+}
+
+// BleveConfig configures the Bleve index of the movies which is kept in the directory IndexPath. A search lists the
+// movies of its best MaxHits hits only, a match in the titles, the overviews, the names of the credited people or
+// the tags of a movie is weighted by the boost of the field. MaxHits which is 0 is defaulted to 1000 and a boost
+// which is 0 to 1
+type BleveConfig struct {
+	IndexPath     string
+	MaxHits       int
+	TitleBoost    float64
+	OverviewBoost float64
+	PersonBoost   float64
+	TagBoost      float64
+}
+
+// ReviewConfig pages the reviews of a movie, the page sizes are defaulted as the ones of MovieListConfig
+type ReviewConfig struct {
+	DefaultPageSize uint
+	MaxPageSize     uint
+}
+
+// CommentConfig limits the threads of review comments, a comment on a review has depth 1
+// and a reply has the depth of its parent + 1. MaxDepth which is 0 is defaulted to 3, the page sizes
+// are defaulted as the ones of MovieListConfig
+type CommentConfig struct {
+	MaxDepth        uint8
+	DefaultPageSize uint
+	MaxPageSize     uint
+}
+
+// ModerationConfig pages the queue of reported reviews and comments, the page sizes are defaulted as the ones of
+// MovieListConfig
+type ModerationConfig struct {
+	DefaultPageSize uint
+	MaxPageSize     uint
+}
+
+// ContentFilterConfig configures the filters which the reviews and comments pass through before they are saved.
+// A post containing ProfanityWords or a link to BlockedDomains (or their subdomains) is rejected and a post with
+// more than MaxLinks links is sent to moderation. A post which is the same as one of the last DuplicatePosts posts
+// of the user within DuplicateWindow is rejected, so is the post of a user who already wrote RateLimit posts
+// within RateWindow. A filter whose number (MaxLinks, DuplicatePosts or RateLimit) or window is 0 is disabled
+type ContentFilterConfig struct {
+	ProfanityWords  []string
+	MaxLinks        int
+	BlockedDomains  []string
+	DuplicateWindow time.Duration
+	DuplicatePosts  uint
+	RateWindow      time.Duration
+	RateLimit       uint
+}
+
 type MySQLConfig struct {
 	WriterDataSource  string
 	ReaderDataSources []string
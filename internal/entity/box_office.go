@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// Money is an amount in the minor unit of Currency, which is an ISO 4217 code, such as {12345, "USD"} for
+// 123.45 US dollars
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// BoxOfficeGross is the amount which a movie grossed in Territory during the week starting on WeekStart,
+// Territory is an ISO 3166-1 alpha-2 code
+type BoxOfficeGross struct {
+	ID        uint64    `json:"id"`
+	MovieID   uint64    `json:"movie_id"`
+	Territory string    `json:"territory"`
+	WeekStart time.Time `json:"week_start"`
+	Gross     Money     `json:"gross"`
+}
+
+// BoxOffice is the budget, the revenue and the grosses of a movie. The amounts of different currencies are never
+// added up, so every total is a list of amounts with one amount per currency ordered by currency
+type BoxOffice struct {
+	MovieID uint64 `json:"movie_id"`
+	// Budget and Revenue are nil when they are not known
+	Budget  *Money `json:"budget"`
+	Revenue *Money `json:"revenue"`
+	// Totals are the grosses of every territory and every week added up
+	Totals []Money `json:"totals"`
+	// Weekly is the time series of the grosses of every territory added up by week ordered by WeekStart
+	Weekly      []*WeeklyGross      `json:"weekly"`
+	Territories []*TerritoryGrosses `json:"territories"`
+}
+
+// WeeklyGross is the gross of the week starting on WeekStart, Cumulative is the gross from the first week until
+// the end of this one
+type WeeklyGross struct {
+	WeekStart  time.Time `json:"week_start"`
+	Grosses    []Money   `json:"grosses"`
+	Cumulative []Money   `json:"cumulative"`
+}
+
+// TerritoryGrosses are the grosses of a movie in Territory, Weekly is ordered by WeekStart
+type TerritoryGrosses struct {
+	Territory string         `json:"territory"`
+	Totals    []Money        `json:"totals"`
+	Weekly    []*WeeklyGross `json:"weekly"`
+}
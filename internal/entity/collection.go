@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// Collection groups movies such as a trilogy or a cinematic universe, Movies are ordered by Position and
+// NextCursor gets their next page, it is nil on the last page
+type Collection struct {
+	ID         uint64             `json:"id"`
+	Name       string             `json:"name"`
+	Overview   *string            `json:"overview"`
+	PosterPath *string            `json:"poster_path"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+	Movies     []*CollectionMovie `json:"movies"`
+	NextCursor *string            `json:"next_cursor"`
+}
+
+// CollectionMovie is a movie of a collection, Position is its order in the collection starting from 1
+type CollectionMovie struct {
+	Position uint   `json:"position"`
+	Movie    *Movie `json:"movie"`
+}
+
+// CollectionSummary is the collection which a movie belongs to, Position is the order of the movie in it and
+// MovieCount is the number of the movies of the collection
+type CollectionSummary struct {
+	ID         uint64 `json:"id"`
+	Name       string `json:"name"`
+	Position   uint   `json:"position"`
+	MovieCount uint   `json:"movie_count"`
+}
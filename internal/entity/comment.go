@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// Comment is a comment on a review or a reply to another comment, a deleted comment keeps its place
+// in the thread with empty content. A comment hidden by a moderator is only shown to its author and the
+// moderators
+type Comment struct {
+	ID               uint64           `json:"id"`
+	ReviewID         uint64           `json:"review_id"`
+	UserID           uint64           `json:"user_id"`
+	Username         string           `json:"username"`
+	ParentID         *uint64          `json:"parent_id"`
+	Depth            uint8            `json:"depth"`
+	Content          string           `json:"content"`
+	ModerationStatus ModerationStatus `json:"moderation_status"`
+	ReplyCount       uint64           `json:"reply_count"`
+	Deleted          bool             `json:"deleted"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// CommentPage is a page of the comments on a review or of the replies to a comment, NextCursor gets the next page
+// and is nil on the last page
+type CommentPage struct {
+	Comments   []*Comment `json:"comments"`
+	NextCursor *string    `json:"next_cursor"`
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// The precisions of a date, a date of the year precision is known only to the year such as 1927 and a date of the
+// month precision is known only to the month such as 1927-05
+const (
+	DatePrecisionYear  = "year"
+	DatePrecisionMonth = "month"
+	DatePrecisionDay   = "day"
+)
+
+// DateLayout is the format of a Date in JSON and in the database
+const DateLayout = "2006-01-02"
+
+// dateLayouts are the layouts which ParseDate accepts with the precision of the dates formatted with them
+var dateLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{layout: DateLayout, precision: DatePrecisionDay},
+	{layout: "2006-01", precision: DatePrecisionMonth},
+	{layout: "2006", precision: DatePrecisionYear},
+}
+
+// Date is a calendar date without a time of day and a time zone, so it never shifts with the time zone of the server
+// or of the client. A date which is known only to the year or to the month has 1 as its unknown month and day such
+// as 1927-01-01 for 1927, its precision is kept next to it
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in the location of t
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date formatted as 2006-01-02, 2006-01 or 2006 and returns it with its precision
+func ParseDate(value string) (Date, string, error) {
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return DateOf(t), l.precision, nil
+		}
+	}
+
+	return Date{}, "", fmt.Errorf("%q is not formatted as 2006-01-02, 2006-01 or 2006", value)
+}
+
+// ValidDatePrecision returns whether precision is one of the precisions of a date
+func ValidDatePrecision(precision string) bool {
+	return precision == DatePrecisionYear || precision == DatePrecisionMonth || precision == DatePrecisionDay
+}
+
+// Time returns the start of the date in UTC
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Truncate drops the parts of the date which are not known to precision
+func (d Date) Truncate(precision string) Date {
+	switch precision {
+	case DatePrecisionYear:
+		return Date{Year: d.Year, Month: time.January, Day: 1}
+	case DatePrecisionMonth:
+		return Date{Year: d.Year, Month: d.Month, Day: 1}
+	default:
+		return d
+	}
+}
+
+// End returns the day after the last day which the date of precision may be, such as 1928-01-01 for 1927
+func (d Date) End(precision string) Date {
+	d = d.Truncate(precision)
+	switch precision {
+	case DatePrecisionYear:
+		return DateOf(d.Time().AddDate(1, 0, 0))
+	case DatePrecisionMonth:
+		return DateOf(d.Time().AddDate(0, 1, 0))
+	default:
+		return DateOf(d.Time().AddDate(0, 0, 1))
+	}
+}
+
+func (d Date) String() string {
+	return d.Time().Format(DateLayout)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return err
+	}
+
+	*d = DateOf(t)
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package entity
+
+// The sources of the external ids, an external id identifies a movie in the system of its source
+const (
+	ExternalSourceIMDb     = "imdb"
+	ExternalSourceTMDB     = "tmdb"
+	ExternalSourceWikidata = "wikidata"
+)
+
+// ExternalIDs are the ids of a movie keyed by their source such as {"imdb": "tt0111161", "tmdb": "278"}
+type ExternalIDs map[string]string
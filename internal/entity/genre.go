@@ -0,0 +1,8 @@
+package entity
+
+// Genre is a category of movies, Slug identifies the genre in the genre filter of the apis
+type Genre struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
@@ -0,0 +1,71 @@
+package entity
+
+import "time"
+
+// ModerationStatus tells whether a review or a comment is shown in the public listings, a pending one was
+// sent to moderation by the content filters and is not shown until a moderator restores it
+type ModerationStatus string
+
+const (
+	ModerationStatusVisible ModerationStatus = "visible"
+	ModerationStatusHidden  ModerationStatus = "hidden"
+	ModerationStatusPending ModerationStatus = "pending"
+)
+
+// IsPublic tells whether the content can be read by everyone
+func (s ModerationStatus) IsPublic() bool {
+	return s != ModerationStatusHidden && s != ModerationStatusPending
+}
+
+type ReportTargetType string
+
+const (
+	ReportTargetReview  ReportTargetType = "review"
+	ReportTargetComment ReportTargetType = "comment"
+)
+
+// ReportStatus is open until a moderator takes an action on the reported content
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "open"
+	ReportStatusResolved ReportStatus = "resolved"
+)
+
+type ModerationActionType string
+
+const (
+	ModerationActionHide    ModerationActionType = "hide"
+	ModerationActionRestore ModerationActionType = "restore"
+	ModerationActionDelete  ModerationActionType = "delete"
+)
+
+// Report is made by a user or by the content filters, UserID is 0 for the latter
+type Report struct {
+	ID         uint64           `json:"id"`
+	UserID     uint64           `json:"user_id"`
+	Username   string           `json:"username"`
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   uint64           `json:"target_id"`
+	Reason     string           `json:"reason"`
+	Status     ReportStatus     `json:"status"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// ReportPage is a page of the moderation queue, NextCursor gets the next page and is nil on the last page
+type ReportPage struct {
+	Reports    []*Report `json:"reports"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+// ModerationAction records the decision of a moderator on a review or a comment
+type ModerationAction struct {
+	ID          uint64               `json:"id"`
+	ModeratorID uint64               `json:"moderator_id"`
+	TargetType  ReportTargetType     `json:"target_type"`
+	TargetID    uint64               `json:"target_id"`
+	Action      ModerationActionType `json:"action"`
+	Note        string               `json:"note"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
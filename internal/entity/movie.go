@@ -2,17 +2,92 @@ package entity
 
 import "time"
 
+// The orders of a movie list, the relevance is how well a movie matches the search
+const (
+	MovieSortRelevance   = "relevance"
+	MovieSortReleaseDate = "release_date"
+	MovieSortRevenue     = "revenue"
+	MovieSortPopularity  = "popularity"
+	MovieSortTitle       = "title"
+)
+
+// The directions of a movie list order
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
 type Movie struct {
-	ID               uint64     `json:"id"`
-	OriginalTitle    string     `json:"original_title"`
-	OriginalLanguage string     `json:"original_language"`
-	Overview         *string    `json:"overview"`
-	PosterPath       *string    `json:"poster_path"`
-	BackdropPath     *string    `json:"backdrop_path"`
-	Adult            bool       `json:"adult"`
-	ReleaseDate      *time.Time `json:"release_date"`
-	Budget           *uint64    `json:"budget"`
-	Revenue          *int64     `json:"revenue"`
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at"`
+	ID               uint64    `json:"id"`
+	OriginalTitle    string    `json:"original_title"`
+	OriginalLanguage string    `json:"original_language"`
+	Overview         *string   `json:"overview"`
+	PosterPath       *string   `json:"poster_path"`
+	BackdropPath     *string   `json:"backdrop_path"`
+	Adult            bool      `json:"adult"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	Genres           []Genre   `json:"genres"`
+
+	// ReleaseDate is known only to ReleaseDatePrecision, both are nil when the release date is not known
+	ReleaseDate          *Date   `json:"release_date" swaggertype:"string" format:"date"`
+	ReleaseDatePrecision *string `json:"release_date_precision"`
+
+	// Budget and Revenue are amounts in the minor unit of Currency, which is an ISO 4217 code such as USD, so
+	// that the amounts of every currency are integers
+	Budget   *uint64 `json:"budget"`
+	Revenue  *int64  `json:"revenue"`
+	Currency *string `json:"currency"`
+
+	// Title and Overview are translated into the language preferred by the user when the movie has a
+	// translation for it, TranslationLanguage is the language of the translation and is empty when they are the
+	// original ones
+	Title               string `json:"title"`
+	TranslationLanguage string `json:"translation_language"`
+
+	// Score is how well the texts of the movie match the search and Highlights are the fragments of them which
+	// match it, both are only set in a search result
+	Score      *float64         `json:"score"`
+	Highlights *MovieHighlights `json:"highlights"`
+
+	// Collection is the collection which the movie belongs to, it is nil when the movie belongs to none and is
+	// only set when getting a single movie
+	Collection *CollectionSummary `json:"collection"`
+	// ReleaseDates are the releases of the movie in every country, they are only set when getting a single movie
+	ReleaseDates []*ReleaseDate `json:"release_dates"`
+	// ExternalIDs are the ids of the movie in the other systems such as IMDb, they are only set when getting
+	// a single movie
+	ExternalIDs ExternalIDs `json:"external_ids" swaggertype:"object,string"`
+
+	AverageRating      float64            `json:"average_rating"`
+	RatingCount        uint64             `json:"rating_count"`
+	RatingDistribution RatingDistribution `json:"rating_distribution" swaggertype:"object,integer"`
+
+	// CriticScore is the average rating of the critics and AudienceScore is the one of the other users, the
+	// ratings of both are counted in AverageRating
+	CriticScore         float64 `json:"critic_score"`
+	CriticRatingCount   uint64  `json:"critic_rating_count"`
+	AudienceScore       float64 `json:"audience_score"`
+	AudienceRatingCount uint64  `json:"audience_rating_count"`
+}
+
+// MovieTranslation is the title and the overview of a movie in Language, which is a BCP 47 language tag
+type MovieTranslation struct {
+	MovieID  uint64  `json:"movie_id"`
+	Language string  `json:"language"`
+	Title    string  `json:"title"`
+	Overview *string `json:"overview"`
+}
+
+// MovieHighlights are the fragments of the original title and of the overview of a movie in which the search
+// matches, the matched terms are wrapped in the highlight tags. A field is nil when the search does not match it
+type MovieHighlights struct {
+	OriginalTitle []string `json:"original_title"`
+	Overview      []string `json:"overview"`
+}
+
+// MoviePage is a page of a movie list, NextCursor gets the next page and is nil on the last page
+type MoviePage struct {
+	Movies     []*Movie `json:"movies"`
+	NextCursor *string  `json:"next_cursor"`
 }
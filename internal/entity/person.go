@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+// DepartmentActing is the department of the cast, the credits of every other department are the crew
+const DepartmentActing = "Acting"
+
+// Person is someone who is credited in movies, Filmography lists the movies the person worked on and NextCursor
+// gets its next page, it is nil on the last page
+type Person struct {
+	ID          uint64               `json:"id"`
+	Name        string               `json:"name"`
+	Biography   *string              `json:"biography"`
+	ProfilePath *string              `json:"profile_path"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	Filmography []*FilmographyCredit `json:"filmography"`
+	NextCursor  *string              `json:"next_cursor"`
+}
+
+// Credit is the work of a person in a movie, Role is the job such as Actor or Director and Department groups
+// the roles. CharacterName is only set for the cast
+type Credit struct {
+	ID            uint64  `json:"id"`
+	MovieID       uint64  `json:"movie_id"`
+	PersonID      uint64  `json:"person_id"`
+	PersonName    string  `json:"person_name"`
+	ProfilePath   *string `json:"profile_path"`
+	Role          string  `json:"role"`
+	Department    string  `json:"department"`
+	CharacterName *string `json:"character_name"`
+	BillingOrder  uint    `json:"billing_order"`
+}
+
+// MovieCredits are the credits of a movie ordered by billing order, Cast are the credits of the Acting
+// department and Crew are the others
+type MovieCredits struct {
+	MovieID uint64    `json:"movie_id"`
+	Cast    []*Credit `json:"cast"`
+	Crew    []*Credit `json:"crew"`
+}
+
+// FilmographyCredit is a credit of a person together with the movie it belongs to
+type FilmographyCredit struct {
+	ID                   uint64  `json:"id"`
+	MovieID              uint64  `json:"movie_id"`
+	OriginalTitle        string  `json:"original_title"`
+	PosterPath           *string `json:"poster_path"`
+	ReleaseDate          *Date   `json:"release_date" swaggertype:"string" format:"date"`
+	ReleaseDatePrecision *string `json:"release_date_precision"`
+	Role                 string  `json:"role"`
+	Department           string  `json:"department"`
+	CharacterName        *string `json:"character_name"`
+	BillingOrder         uint    `json:"billing_order"`
+}
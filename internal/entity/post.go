@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Post is a review or a comment written by a user, TargetType tells which one it is
+type Post struct {
+	TargetType ReportTargetType `json:"target_type"`
+	ID         uint64           `json:"id"`
+	UserID     uint64           `json:"user_id"`
+	Content    string           `json:"content"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	MinRatingScore = 1
+	MaxRatingScore = 10
+)
+
+type Rating struct {
+	UserID    uint64    `json:"user_id"`
+	MovieID   uint64    `json:"movie_id"`
+	Score     uint8     `json:"score"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RatingDistribution holds the number of ratings per score, index 0 is the number of 1 star ratings
+type RatingDistribution [MaxRatingScore]uint64
+
+// MarshalJSON renders the distribution as an object keyed by score, e.g. {"1": 0, ..., "10": 3}
+func (d RatingDistribution) MarshalJSON() ([]byte, error) {
+	distribution := make(map[string]uint64, len(d))
+	for i, count := range d {
+		distribution[strconv.Itoa(i+MinRatingScore)] = count
+	}
+
+	return json.Marshal(distribution)
+}
@@ -0,0 +1,20 @@
+package entity
+
+const (
+	ReleaseTypeTheatrical = "theatrical"
+	ReleaseTypeDigital    = "digital"
+	ReleaseTypePhysical   = "physical"
+)
+
+// ReleaseDate is the release of a movie in a country, Country is an ISO 3166-1 alpha-2 code. Certification is the
+// local certification such as PG-13 or FSK 16 and MinimumAge is the age which it allows the movie from, both are
+// nil when the release is not certified
+type ReleaseDate struct {
+	ID            uint64  `json:"id"`
+	MovieID       uint64  `json:"movie_id"`
+	Country       string  `json:"country"`
+	ReleaseType   string  `json:"release_type"`
+	ReleaseDate   Date    `json:"release_date" swaggertype:"string" format:"date"`
+	Certification *string `json:"certification"`
+	MinimumAge    *uint8  `json:"minimum_age"`
+}
@@ -0,0 +1,74 @@
+package entity
+
+import "time"
+
+// Review is written by a user for a movie, a spoiler review or the ||inline ranges|| of a review are
+// masked unless the reader asks to reveal spoilers and SpoilersMasked tells whether anything is masked.
+// A review hidden by a moderator is only shown to its author and the moderators. Edited tells whether the
+// author changed the review after writing it, the previous versions are kept as ReviewRevision.
+// AuthorIsCritic tells whether the author is a verified critic
+type Review struct {
+	ID               uint64           `json:"id"`
+	MovieID          uint64           `json:"movie_id"`
+	UserID           uint64           `json:"user_id"`
+	Username         string           `json:"username"`
+	AuthorIsCritic   bool             `json:"author_is_critic"`
+	Title            string           `json:"title"`
+	Content          string           `json:"content"`
+	IsSpoiler        bool             `json:"is_spoiler"`
+	SpoilersMasked   bool             `json:"spoilers_masked"`
+	ModerationStatus ModerationStatus `json:"moderation_status"`
+	HelpfulCount     uint64           `json:"helpful_count"`
+	NotHelpfulCount  uint64           `json:"not_helpful_count"`
+	AuthorRating     *uint8           `json:"author_rating"`
+	Edited           bool             `json:"edited"`
+	EditedAt         *time.Time       `json:"edited_at"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// ReviewPage is a page of the reviews of a movie, NextCursor gets the next page and is nil on the last page
+type ReviewPage struct {
+	Reviews    []*Review `json:"reviews"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+// ReviewRevision is a version of a review, Number starts from 1 for the version which was written first and
+// the last revision is the current review. TitleDiff and ContentDiff are the changes from the previous
+// revision and are empty for the first one
+type ReviewRevision struct {
+	Number      uint          `json:"number"`
+	Title       string        `json:"title"`
+	Content     string        `json:"content"`
+	IsSpoiler   bool          `json:"is_spoiler"`
+	TitleDiff   []DiffSegment `json:"title_diff"`
+	ContentDiff []DiffSegment `json:"content_diff"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type ReviewRevisionHistory struct {
+	ReviewID  uint64            `json:"review_id"`
+	Revisions []*ReviewRevision `json:"revisions"`
+}
+
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+// DiffSegment is a piece of text which is kept, inserted or deleted by a revision
+type DiffSegment struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+type ReviewVote struct {
+	UserID    uint64    `json:"user_id"`
+	ReviewID  uint64    `json:"review_id"`
+	Helpful   bool      `json:"helpful"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
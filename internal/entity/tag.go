@@ -0,0 +1,34 @@
+package entity
+
+// MaxTagLength is the maximum number of characters of a normalized tag
+const MaxTagLength = 50
+
+// Tag is a free-form keyword which users apply to movies such as "time-travel", Slug is the normalized keyword.
+// Movies are the movies tagged with it ordered by how many users applied it
+type Tag struct {
+	ID     uint64         `json:"id"`
+	Slug   string         `json:"slug"`
+	Movies []*TaggedMovie `json:"movies,omitempty"`
+	// NextCursor gets the next page of Movies and is nil on the last page
+	NextCursor *string `json:"next_cursor"`
+}
+
+// TaggedMovie is a movie of a tag, UserCount is the number of the users who applied the tag to the movie
+type TaggedMovie struct {
+	UserCount uint   `json:"user_count"`
+	Movie     *Movie `json:"movie"`
+}
+
+// TagUsage is a tag of the tag cloud of a movie, UserCount is the number of the users who applied the tag to
+// the movie and Weight is UserCount relative to the most applied tag of the movie, from 0 to 1
+type TagUsage struct {
+	Slug      string  `json:"slug"`
+	UserCount uint    `json:"user_count"`
+	Weight    float64 `json:"weight"`
+}
+
+// MovieTagCloud lists the tags of a movie, the most applied tags come first
+type MovieTagCloud struct {
+	MovieID uint64      `json:"movie_id"`
+	Tags    []*TagUsage `json:"tags"`
+}
@@ -1,10 +1,37 @@
 package entity
 
+type UserRole string
+
+const (
+	UserRoleUser      UserRole = "user"
+	UserRoleModerator UserRole = "moderator"
+	// UserRoleAdmin manages the users, an admin can moderate the content as well
+	UserRoleAdmin UserRole = "admin"
+)
+
+// CanModerate tells whether the users of the role can moderate the reviews and comments
+func (r UserRole) CanModerate() bool {
+	return r == UserRoleModerator || r == UserRoleAdmin
+}
+
 type User struct {
-	ID             uint64 `json:"id"`
-	Username       string `json:"username"`
-	Email          string `json:"email"`
-	HashedPassword string `json:"hashed_password"`
+	ID             uint64   `json:"id"`
+	Username       string   `json:"username"`
+	Email          string   `json:"email"`
+	HashedPassword string   `json:"hashed_password"`
+	Role           UserRole `json:"role"`
+	RevealSpoilers bool     `json:"reveal_spoilers"`
+	// Country is an ISO 3166-1 alpha-2 code, the release filters of the movies use it when no country is given
+	Country *string `json:"country"`
+	// IsCritic is set by the admins for the verified critics, their ratings make the critic score of the movies
+	IsCritic bool `json:"is_critic"`
+}
+
+type UserPreferences struct {
+	// RevealSpoilers is used when the reveal_spoilers query parameter is not given
+	RevealSpoilers bool `json:"reveal_spoilers"`
+	// Country is used when the country query parameter is not given
+	Country *string `json:"country"`
 }
 
 type UserWithAccessToken struct {
@@ -22,48 +22,92 @@ const (
 func (mw *middlewareManager) AuthMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			authorizationHeader := c.Request().Header.Get(authorizationHeaderKey)
-			if len(authorizationHeader) == 0 {
-				err := errors.New("authorization header is not provided")
+			if err := mw.authenticate(c, tokenMaker); err != nil {
 				utils.LogResponseError(c, mw.logger, err)
-				return c.JSON(httperrors.ErrorResponse(httperrors.NewUnauthorizedError(err)))
+				return c.JSON(httperrors.ErrorResponse(err))
 			}
 
-			fields := strings.Fields(authorizationHeader)
-			if len(fields) < 2 {
-				err := errors.New("invalid authorization header format")
-				utils.LogResponseError(c, mw.logger, err)
-				return c.JSON(httperrors.ErrorResponse(httperrors.NewUnauthorizedError(err)))
+			return next(c)
+		}
+	}
+}
+
+// OptionalAuthMiddleware authenticates the user like AuthMiddleware when the authorization header is
+// provided and lets the request go on without current user otherwise
+func (mw *middlewareManager) OptionalAuthMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if len(c.Request().Header.Get(authorizationHeaderKey)) == 0 {
+				return next(c)
 			}
 
-			authorizationType := strings.ToLower(fields[0])
-			if authorizationType != authorizationTypeBearer {
-				err := fmt.Errorf("unsupported authorization type %s", authorizationType)
+			if err := mw.authenticate(c, tokenMaker); err != nil {
 				utils.LogResponseError(c, mw.logger, err)
-				return c.JSON(httperrors.ErrorResponse(httperrors.NewUnauthorizedError(err)))
+				return c.JSON(httperrors.ErrorResponse(err))
 			}
 
-			accessToken := fields[1]
-			payload, err := tokenMaker.VerifyToken(accessToken)
+			return next(c)
+		}
+	}
+}
+
+// RoleMiddleware lets only the users who have one of the given roles go on, it has to be used after
+// AuthMiddleware
+func (mw *middlewareManager) RoleMiddleware(roles ...entity.UserRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, err := mw.GetCurrentUser(c)
 			if err != nil {
-				utils.LogResponseError(c, mw.logger, err)
-				return c.JSON(httperrors.ErrorResponse(httperrors.NewUnauthorizedError(err)))
+				return c.JSON(httperrors.ErrorResponse(err))
 			}
 
-			user, err := mw.userUsecase.GetUserByEmail(c.Request().Context(), payload.Email)
-			if err != nil || user == nil {
-				utils.LogResponseError(c, mw.logger, err)
-				return c.JSON(httperrors.ErrorResponse(httperrors.NewUnauthorizedError(httperrors.ErrNotFound.Error())))
+			for _, role := range roles {
+				if user.Role == role {
+					return next(c)
+				}
 			}
 
-			c.Set(currentUserKey, user)
-			c.Set(authorizationPayloadKey, payload)
-
-			return next(c)
+			err = httperrors.NewForbiddenError(fmt.Errorf("user %d does not have role %v", user.ID, roles))
+			utils.LogResponseError(c, mw.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
 		}
 	}
 }
 
+// authenticate verifies the access token of authorization header and sets the current user to the context
+func (mw *middlewareManager) authenticate(c echo.Context, tokenMaker token.Maker) error {
+	authorizationHeader := c.Request().Header.Get(authorizationHeaderKey)
+	if len(authorizationHeader) == 0 {
+		return httperrors.NewUnauthorizedError(errors.New("authorization header is not provided"))
+	}
+
+	fields := strings.Fields(authorizationHeader)
+	if len(fields) < 2 {
+		return httperrors.NewUnauthorizedError(errors.New("invalid authorization header format"))
+	}
+
+	authorizationType := strings.ToLower(fields[0])
+	if authorizationType != authorizationTypeBearer {
+		return httperrors.NewUnauthorizedError(fmt.Errorf("unsupported authorization type %s", authorizationType))
+	}
+
+	accessToken := fields[1]
+	payload, err := tokenMaker.VerifyToken(accessToken)
+	if err != nil {
+		return httperrors.NewUnauthorizedError(err)
+	}
+
+	user, err := mw.userUsecase.GetUserByEmail(c.Request().Context(), payload.Email)
+	if err != nil || user == nil {
+		return httperrors.NewUnauthorizedError(httperrors.ErrNotFound.Error())
+	}
+
+	c.Set(currentUserKey, user)
+	c.Set(authorizationPayloadKey, payload)
+
+	return nil
+}
+
 func (mw *middlewareManager) GetCurrentUser(c echo.Context) (*entity.User, error) {
 	user, ok := c.Get(currentUserKey).(*entity.User)
 	if !ok || user == nil {
@@ -73,3 +117,10 @@ func (mw *middlewareManager) GetCurrentUser(c echo.Context) (*entity.User, error
 
 	return user, nil
 }
+
+// LookupCurrentUser returns the current user or nil when the request is not authenticated,
+// it is used by the handlers behind OptionalAuthMiddleware
+func (mw *middlewareManager) LookupCurrentUser(c echo.Context) *entity.User {
+	user, _ := c.Get(currentUserKey).(*entity.User)
+	return user
+}
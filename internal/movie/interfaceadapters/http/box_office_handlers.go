@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type boxOfficeHandlers struct {
+	cfg              *config.Config
+	boxOfficeUsecase handlersusecase.BoxOfficeUsecase
+	logger           logger.Logger
+}
+
+func NewBoxOfficeHandlers(cfg *config.Config, boxOfficeUsecase handlersusecase.BoxOfficeUsecase, log logger.Logger) *boxOfficeHandlers {
+	return &boxOfficeHandlers{cfg: cfg, boxOfficeUsecase: boxOfficeUsecase, logger: log}
+}
+
+type getBoxOfficeRequest struct {
+	MovieID uint64 `param:"id"`
+}
+
+// GetBoxOffice godoc
+// @Summary Get the box office of a movie.
+// @Description Get the budget, the revenue and the weekly grosses of a movie in every territory with their totals.
+// 							Every amount is in the minor unit of its currency such as cents of USD, the amounts of different currencies are never added up so every total has one amount per currency.
+// 							If the movie is not exist returns http.StatusNotFound.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Produce json
+// @Success 200 {object} entity.BoxOffice
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/box-office [get]
+func (h *boxOfficeHandlers) GetBoxOffice() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getBoxOfficeRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		boxOffice, err := h.boxOfficeUsecase.GetBoxOffice(ctx, req.MovieID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, boxOffice)
+	}
+}
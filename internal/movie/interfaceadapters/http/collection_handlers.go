@@ -0,0 +1,131 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type collectionHandlers struct {
+	cfg               *config.Config
+	collectionUsecase handlersusecase.CollectionUsecase
+	logger            logger.Logger
+	getCurrentUserFn  func(c echo.Context) (*entity.User, error)
+}
+
+func NewCollectionHandlers(cfg *config.Config, collectionUsecase handlersusecase.CollectionUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error)) *collectionHandlers {
+	return &collectionHandlers{cfg: cfg, collectionUsecase: collectionUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn}
+}
+
+type getCollectionRequest struct {
+	ID   uint64 `param:"id"`
+	Lang string `query:"lang"`
+	pageRequest
+}
+
+// GetCollection godoc
+// @Summary Get a collection with its movies.
+// @Description Get a collection such as a trilogy or a cinematic universe with its movies ordered by position.
+// 							The title and the overview of the movies are translated into the lang or the best language of Accept-Language.
+// 							If the collection is not exist returns http.StatusNotFound.
+// @Tags Collections
+// @Accept json
+// @Param id path uint64 true "collection id"
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of movies per page"
+// @Produce json
+// @Success 200 {object} entity.Collection
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /collections/{id} [get]
+func (h *collectionHandlers) GetCollection() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getCollectionRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		collection, err := h.collectionUsecase.GetCollection(ctx, usecase.GetCollectionParams{
+			CollectionID: req.ID,
+			Languages:    preferredLanguages(c, req.Lang),
+			Page:         usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, collection.NextCursor)
+		return c.JSON(http.StatusOK, collection)
+	}
+}
+
+type addFavoriteCollectionRequest struct {
+	CollectionID uint64 `param:"id"`
+}
+
+type addFavoriteCollectionResponse struct {
+	CollectionID uint64 `json:"collection_id"`
+	AddedCount   int64  `json:"added_count"`
+}
+
+// AddFavoriteCollection godoc
+// @Summary Add every movie of a collection to user's favorite list.
+// @Description Add every movie of a collection to user's favorite list, the movies which are already favorite are skipped.
+// 							added_count is the number of the movies which are added.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the collection is not exist returns http.StatusNotFound.
+// @Tags Collections
+// @Accept json
+// @Param id path uint64 true "collection id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} addFavoriteCollectionResponse
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /favorites/collections/{id} [post]
+func (h *collectionHandlers) AddFavoriteCollection() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &addFavoriteCollectionRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		added, err := h.collectionUsecase.AddFavoriteCollection(ctx, usecase.AddFavoriteCollectionParams{
+			UserID:       currentUser.ID,
+			CollectionID: req.CollectionID,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, addFavoriteCollectionResponse{CollectionID: req.CollectionID, AddedCount: added})
+	}
+}
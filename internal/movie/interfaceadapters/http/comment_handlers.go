@@ -0,0 +1,316 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type commentHandlers struct {
+	cfg                 *config.Config
+	commentUsecase      handlersusecase.CommentUsecase
+	logger              logger.Logger
+	getCurrentUserFn    func(c echo.Context) (*entity.User, error)
+	lookupCurrentUserFn func(c echo.Context) *entity.User
+}
+
+func NewCommentHandlers(cfg *config.Config, commentUsecase handlersusecase.CommentUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error),
+	lookupCurrentUserFn func(c echo.Context) *entity.User) *commentHandlers {
+	return &commentHandlers{cfg: cfg, commentUsecase: commentUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn, lookupCurrentUserFn: lookupCurrentUserFn}
+}
+
+type createCommentRequest struct {
+	ReviewID uint64  `param:"id"`
+	ParentID *uint64 `json:"parent_id"`
+	Content  string  `json:"content" validate:"required,lte=5000"`
+}
+
+// CreateComment godoc
+// @Summary Write a comment on a review.
+// @Description Write a comment on a review, or reply to a comment of the review when parent_id is given.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the reply is nested deeper than allowed returns http.StatusBadRequest.
+// 							A comment which the content filter sends to moderation is created as pending and shown only to its author until a moderator restores it.
+// 							If the content filter rejects the content returns http.StatusBadRequest, or http.StatusTooManyRequests when posting too often.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param createCommentRequest body createCommentRequest true "createCommentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.Comment
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 429 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/comments [post]
+func (h *commentHandlers) CreateComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &createCommentRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		comment, err := h.commentUsecase.CreateComment(ctx, usecase.CreateCommentParams{
+			ReviewID: req.ReviewID,
+			UserID:   currentUser.ID,
+			ParentID: req.ParentID,
+			Content:  req.Content,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, comment)
+	}
+}
+
+type listCommentsRequest struct {
+	ID uint64 `param:"id"`
+	pageRequest
+}
+
+// ListComments godoc
+// @Summary List comments on a review.
+// @Description List the comments written directly on a review, oldest first. The replies of a comment are listed by /comments/{id}/replies.
+// 							Comments hidden by a moderator are not listed.
+// 							If the review is not exist or is hidden returns http.StatusNotFound.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of comments per page"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.CommentPage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/comments [get]
+func (h *commentHandlers) ListComments() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listCommentsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		comments, err := h.commentUsecase.ListComments(ctx, usecase.ListCommentsParams{
+			ReviewID: req.ID,
+			Page:     usecase.PageParams(req.pageRequest),
+			Viewer:   viewerOf(h.lookupCurrentUserFn(c)),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, comments.NextCursor)
+		return c.JSON(http.StatusOK, comments)
+	}
+}
+
+// ListReplies godoc
+// @Summary List replies to a comment.
+// @Description List the replies to a comment, oldest first. Replies hidden by a moderator are not listed.
+// 							If the comment is not exist or is hidden returns http.StatusNotFound.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "comment id"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of replies per page"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.CommentPage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /comments/{id}/replies [get]
+func (h *commentHandlers) ListReplies() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listCommentsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		replies, err := h.commentUsecase.ListReplies(ctx, usecase.ListRepliesParams{
+			CommentID: req.ID,
+			Page:      usecase.PageParams(req.pageRequest),
+			Viewer:    viewerOf(h.lookupCurrentUserFn(c)),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, replies.NextCursor)
+		return c.JSON(http.StatusOK, replies)
+	}
+}
+
+type getCommentByIDRequest struct {
+	ID uint64 `param:"id"`
+}
+
+// GetCommentByID godoc
+// @Summary Get comment by its Id
+// @Description Get comment by its Id, if the id is not exist returns http.StatusNotFound.
+// 							A comment hidden by a moderator is returned only to its author and the moderators.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.Comment
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /comments/{id} [get]
+func (h *commentHandlers) GetCommentByID() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getCommentByIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		comment, err := h.commentUsecase.GetCommentByID(ctx, usecase.GetCommentByIDParams{
+			CommentID: req.ID,
+			Viewer:    viewerOf(h.lookupCurrentUserFn(c)),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, comment)
+	}
+}
+
+type updateCommentRequest struct {
+	ID      uint64 `param:"id"`
+	Content string `json:"content" validate:"required,lte=5000"`
+}
+
+// UpdateComment godoc
+// @Summary Edit a comment.
+// @Description Edit a comment, only the author of the comment can edit it. The edited content is checked by the content filter again.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not the author returns http.StatusForbidden.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param updateCommentRequest body updateCommentRequest true "updateCommentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Comment
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /comments/{id} [put]
+func (h *commentHandlers) UpdateComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &updateCommentRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		comment, err := h.commentUsecase.UpdateComment(ctx, usecase.UpdateCommentParams{
+			CommentID: req.ID,
+			UserID:    currentUser.ID,
+			Content:   req.Content,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, comment)
+	}
+}
+
+type deleteCommentRequest struct {
+	ID uint64 `param:"id"`
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment.
+// @Description Delete a comment, only the author of the comment can delete it. The replies to the comment are kept.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not the author returns http.StatusForbidden.
+// @Tags Comments
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /comments/{id} [delete]
+func (h *commentHandlers) DeleteComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &deleteCommentRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.commentUsecase.DeleteComment(ctx, usecase.DeleteCommentParams{
+			CommentID: req.ID,
+			UserID:    currentUser.ID,
+		}); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type creditHandlers struct {
+	cfg           *config.Config
+	creditUsecase handlersusecase.CreditUsecase
+	logger        logger.Logger
+}
+
+func NewCreditHandlers(cfg *config.Config, creditUsecase handlersusecase.CreditUsecase, log logger.Logger) *creditHandlers {
+	return &creditHandlers{cfg: cfg, creditUsecase: creditUsecase, logger: log}
+}
+
+type listMovieCreditsRequest struct {
+	MovieID uint64 `param:"id"`
+}
+
+// ListMovieCredits godoc
+// @Summary List the cast and the crew of a movie.
+// @Description List the cast and the crew of a movie ordered by billing order, the cast are the credits of the Acting department.
+// 							If the movie is not exist returns http.StatusNotFound.
+// @Tags Credits
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Produce json
+// @Success 200 {object} entity.MovieCredits
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/credits [get]
+func (h *creditHandlers) ListMovieCredits() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listMovieCreditsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		credits, err := h.creditUsecase.ListMovieCredits(ctx, req.MovieID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, credits)
+	}
+}
+
+type getPersonRequest struct {
+	ID uint64 `param:"id"`
+	pageRequest
+}
+
+// GetPerson godoc
+// @Summary Get a person with the filmography.
+// @Description Get a person with the filmography which lists the credits of the person, the latest released movies come first.
+// 							If the person is not exist returns http.StatusNotFound.
+// @Tags Credits
+// @Accept json
+// @Param id path uint64 true "person id"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of credits of the filmography per page"
+// @Produce json
+// @Success 200 {object} entity.Person
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /people/{id} [get]
+func (h *creditHandlers) GetPerson() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getPersonRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		person, err := h.creditUsecase.GetPerson(ctx, usecase.GetPersonParams{
+			PersonID: req.ID,
+			Page:     usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, person.NextCursor)
+		return c.JSON(http.StatusOK, person)
+	}
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type genreHandlers struct {
+	cfg          *config.Config
+	genreUsecase handlersusecase.GenreUsecase
+	logger       logger.Logger
+}
+
+func NewGenreHandlers(cfg *config.Config, genreUsecase handlersusecase.GenreUsecase, log logger.Logger) *genreHandlers {
+	return &genreHandlers{cfg: cfg, genreUsecase: genreUsecase, logger: log}
+}
+
+// ListGenres godoc
+// @Summary List all genres.
+// @Description List all genres ordered by name, the slug of a genre is used to filter the movies by genre.
+// @Tags Genres
+// @Accept json
+// @Produce json
+// @Success 200 {object} []entity.Genre
+// @Failure 500 {object} httperrors.RestError
+// @Router /genres [get]
+func (h *genreHandlers) ListGenres() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := utils.GetRequestCtx(c)
+		genres, err := h.genreUsecase.ListGenres(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, genres)
+	}
+}
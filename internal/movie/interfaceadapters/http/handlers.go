@@ -1,7 +1,9 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/samthehai/ml-backend-test-samthehai/config"
@@ -9,33 +11,43 @@ import (
 	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/language"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
 )
 
 type movieHandlers struct {
-	cfg              *config.Config
-	movieUsecase     handlersusecase.MovieUsecase
-	logger           logger.Logger
-	getCurrentUserFn func(c echo.Context) (*entity.User, error)
+	cfg                 *config.Config
+	movieUsecase        handlersusecase.MovieUsecase
+	logger              logger.Logger
+	getCurrentUserFn    func(c echo.Context) (*entity.User, error)
+	lookupCurrentUserFn func(c echo.Context) *entity.User
 }
 
 func NewMovieHandlers(cfg *config.Config, movieUsecase handlersusecase.MovieUsecase,
-	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error)) *movieHandlers {
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error),
+	lookupCurrentUserFn func(c echo.Context) *entity.User) *movieHandlers {
 	return &movieHandlers{cfg: cfg, movieUsecase: movieUsecase, logger: log,
-		getCurrentUserFn: getCurrentUserFn}
+		getCurrentUserFn: getCurrentUserFn, lookupCurrentUserFn: lookupCurrentUserFn}
 }
 
 type getByIDRequest struct {
-	ID uint64 `param:"id"`
+	ID   uint64 `param:"id"`
+	Lang string `query:"lang"`
 }
 
 // GetByID godoc
 // @Summary Get movie details information by its Id
-// @Description Get movie details information by its Id, if the id is not exist returns http.StatusNotFound
+// @Description Get movie details information by its Id, if the id is not exist returns http.StatusNotFound.
+// 							The title and the overview are translated into the lang or the best language of Accept-Language.
+// 							collection is the collection which the movie belongs to, it is null when the movie belongs to none.
+// 							release_dates are the releases of the movie in every country with the local certifications.
+// 							external_ids are the ids of the movie in the other systems keyed by the source such as imdb, tmdb and wikidata.
 // @Tags Movies
 // @Accept json
 // @Param id path uint64 true "id"
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
 // @Produce json
 // @Success 200 {object} entity.Movie
 // @Failure 400 {object} httperrors.RestError
@@ -51,7 +63,55 @@ func (h *movieHandlers) GetByID() echo.HandlerFunc {
 		}
 
 		ctx := utils.GetRequestCtx(c)
-		movie, err := h.movieUsecase.GetMovieByID(ctx, req.ID)
+		movie, err := h.movieUsecase.GetMovieByID(ctx, usecase.GetMovieByIDParams{
+			MovieID:   req.ID,
+			Languages: preferredLanguages(c, req.Lang),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, movie)
+	}
+}
+
+type getByExternalIDRequest struct {
+	Source     string `param:"source" validate:"oneof=imdb tmdb wikidata"`
+	ExternalID string `param:"id"`
+	Lang       string `query:"lang"`
+}
+
+// GetByExternalID godoc
+// @Summary Get movie details information by its id in another system such as IMDb.
+// @Description Get movie details information by its id in another system like /movies/{id}, such as tt0111161 of imdb, 278 of tmdb or Q172241 of wikidata.
+// 							If the id is not valid for the source returns http.StatusBadRequest, if no movie has the id returns http.StatusNotFound.
+// @Tags Movies
+// @Accept json
+// @Param source path string true "source of the id" Enums(imdb, tmdb, wikidata)
+// @Param id path string true "id of the movie in the source"
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
+// @Produce json
+// @Success 200 {object} entity.Movie
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/external/{source}/{id} [get]
+func (h *movieHandlers) GetByExternalID() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getByExternalIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		movie, err := h.movieUsecase.GetMovieByExternalID(ctx, usecase.GetMovieByExternalIDParams{
+			Source:     req.Source,
+			ExternalID: req.ExternalID,
+			Languages:  preferredLanguages(c, req.Lang),
+		})
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httperrors.ErrorResponse(err))
@@ -61,18 +121,94 @@ func (h *movieHandlers) GetByID() echo.HandlerFunc {
 	}
 }
 
+// pageRequest pages a list, the next page is got with the next_cursor of the previous page
+type pageRequest struct {
+	Cursor string `query:"cursor"`
+	Limit  uint   `query:"limit"`
+}
+
+// setNextLink sets the RFC 8288 Link header of the next page which is the request with the next cursor
+func setNextLink(c echo.Context, nextCursor *string) {
+	if nextCursor == nil {
+		return
+	}
+
+	next := *c.Request().URL
+	query := next.Query()
+	query.Set("cursor", *nextCursor)
+	next.RawQuery = query.Encode()
+
+	c.Response().Header().Set("Link",
+		fmt.Sprintf(`<%s://%s%s>; rel="next"`, c.Scheme(), c.Request().Host, next.RequestURI()))
+}
+
+// movieFilterRequest are the query parameters which filter the movies by their own fields, both ends of every
+// range are inclusive and the budget and revenue ranges are amounts in the minor unit of currency
+type movieFilterRequest struct {
+	OriginalLanguage string   `query:"original_language" validate:"omitempty,lte=255"`
+	Adult            *bool    `query:"adult"`
+	ReleaseYearFrom  *int     `query:"release_year_from" validate:"omitempty,min=1,max=9999"`
+	ReleaseYearTo    *int     `query:"release_year_to" validate:"omitempty,min=1,max=9999"`
+	Currency         string   `query:"currency" validate:"omitempty,iso4217"`
+	MinBudget        *uint64  `query:"min_budget"`
+	MaxBudget        *uint64  `query:"max_budget"`
+	MinRevenue       *int64   `query:"min_revenue"`
+	MaxRevenue       *int64   `query:"max_revenue"`
+	MinRating        *float64 `query:"min_rating" validate:"omitempty,min=1,max=10"`
+}
+
 type searchByKeywordRequest struct {
 	Keyword string `query:"search"`
+	Genre   string `query:"genre"`
+	Lang    string `query:"lang"`
+	Sort    string `query:"sort" validate:"omitempty,oneof=relevance release_date revenue popularity title"`
+	Order   string `query:"order" validate:"omitempty,oneof=asc desc"`
+	movieFilterRequest
+	releaseFilterRequest
+	pageRequest
 }
 
 // SearchByKeyword godoc
 // @Summary Search movies by specific keyword. If do not specify keyword will return a list of popular movies.
 // @Description Search movies by specific keyword. If do not specify keyword will return a list of popular movies.
+// 							The movies are ordered by sort, which is relevance when there is a search and popularity otherwise, the relevance requires a search.
+// 							The order is asc for title and desc for the others by default, the movies whose release date or revenue is not known are put last.
+// 							The filters combine with the search, a budget or revenue range requires currency. The cursor only continues the list of the same sort and order.
+// 							Every movie of a search has its relevance score and the highlights, the fragments of its original title and overview in which the matched terms are wrapped in the highlight tags.
+// 							The search query is made of terms separated by spaces which must all match: a word, a "quoted phrase", a prefix such as ali*,
+// 							an excluded term such as -remake and a term matched with the titles only such as title:alien or title:"blade runner".
+// 							Any other character separates the words, a malformed query returns http.StatusBadRequest.
+// 							The release filters match the movies which have a release satisfying all of them in the country,
+// 							the country of the login user is used when country is not given and any country matches when there is neither.
+// 							When only the dates are given a movie also matches when its own release date overlaps them, a release date known only to the year or to the month covers all of it.
 // @Tags Movies
 // @Accept json
-// @Param search query string false "search query"
+// @Param search query string false "search query, its syntax is in the description"
+// @Param genre query string false "comma separated genre slugs, only the movies of any of them are returned"
+// @Param country query string false "ISO 3166-1 alpha-2 country code of the release filters"
+// @Param release_type query string false "type of the release" Enums(theatrical, digital, physical)
+// @Param released_from query string false "the release is on or after the date, format: 2006-01-02, 2006-01 or 2006"
+// @Param released_until query string false "the release is on or before the date, format: 2006-01-02, 2006-01 or 2006"
+// @Param max_age query uint8 false "the release is certified for the age, uncertified releases do not match"
+// @Param original_language query string false "original language of the movie"
+// @Param adult query bool false "whether the movie is for adults"
+// @Param release_year_from query int false "the release date of the movie is in or after the year"
+// @Param release_year_to query int false "the release date of the movie is in or before the year"
+// @Param currency query string false "ISO 4217 currency code of the budget and revenue ranges"
+// @Param min_budget query uint64 false "minimum budget in the minor unit of currency"
+// @Param max_budget query uint64 false "maximum budget in the minor unit of currency"
+// @Param min_revenue query int64 false "minimum revenue in the minor unit of currency"
+// @Param max_revenue query int64 false "maximum revenue in the minor unit of currency"
+// @Param min_rating query number false "minimum average rating from 1 to 10"
+// @Param sort query string false "order of the movies" Enums(relevance, release_date, revenue, popularity, title)
+// @Param order query string false "direction of the order" Enums(asc, desc)
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of movies per page"
 // @Produce json
-// @Success 200 {object} []entity.Movie
+// @Success 200 {object} entity.MoviePage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
 // @Failure 400 {object} httperrors.RestError
 // @Failure 500 {object} httperrors.RestError
 // @Router /movies [get]
@@ -84,14 +220,29 @@ func (h *movieHandlers) SearchByKeyword() echo.HandlerFunc {
 			return c.JSON(httperrors.ErrorResponse(err))
 		}
 
+		release, err := req.toFilter(h.lookupCurrentUserFn(c))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
 		ctx := utils.GetRequestCtx(c)
-		movies, err := h.movieUsecase.SearchByKeyword(ctx, req.Keyword)
+		page, err := h.movieUsecase.SearchByKeyword(ctx, usecase.SearchByKeywordParams{
+			Keyword:   req.Keyword,
+			Filter:    usecase.MovieFilter(req.movieFilterRequest),
+			Genres:    splitGenres(req.Genre),
+			Release:   release,
+			Sort:      usecase.MovieSort{Field: req.Sort, Order: req.Order},
+			Languages: preferredLanguages(c, req.Lang),
+			Page:      usecase.PageParams(req.pageRequest),
+		})
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httperrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, movies)
+		setNextLink(c, page.NextCursor)
+		return c.JSON(http.StatusOK, page)
 	}
 }
 
@@ -143,35 +294,487 @@ func (h *movieHandlers) AddFavoriteMovie() echo.HandlerFunc {
 	}
 }
 
+type listFavoriteMoviesRequest struct {
+	Genre string `query:"genre"`
+	Lang  string `query:"lang"`
+	releaseFilterRequest
+	pageRequest
+}
+
 // ListFavoriteMovies godoc
 // @Summary List favorite movies of current login user.
 // @Description List favorite movies of current login user.
+// 							The release filters match the movies which have a release satisfying all of them in the country,
+// 							the country of the login user is used when country is not given and any country matches when there is neither.
+// 							When only the dates are given a movie also matches when its own release date overlaps them, a release date known only to the year or to the month covers all of it.
 // 							If user is not login returns http.StatusUnauthorized.
 // @Tags Movies
 // @Accept json
+// @Param genre query string false "comma separated genre slugs, only the movies of any of them are listed"
+// @Param country query string false "ISO 3166-1 alpha-2 country code of the release filters"
+// @Param release_type query string false "type of the release" Enums(theatrical, digital, physical)
+// @Param released_from query string false "the release is on or after the date, format: 2006-01-02, 2006-01 or 2006"
+// @Param released_until query string false "the release is on or before the date, format: 2006-01-02, 2006-01 or 2006"
+// @Param max_age query uint8 false "the release is certified for the age, uncertified releases do not match"
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of movies per page"
 // @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
 // @Produce json
 // @Security ApiKeyAuth
-// @Success 200 {object} []entity.Movie
+// @Success 200 {object} entity.MoviePage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
 // @Failure 400 {object} httperrors.RestError
 // @Failure 401 {object} httperrors.RestError
 // @Failure 500 {object} httperrors.RestError
 // @Router /favorites [get]
 func (h *movieHandlers) ListFavoriteMovies() echo.HandlerFunc {
 	return func(c echo.Context) error {
+		req := &listFavoriteMoviesRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
 		currentUser, err := h.getCurrentUserFn(c)
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httperrors.ErrorResponse(err))
 		}
 
+		release, err := req.toFilter(currentUser)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		page, err := h.movieUsecase.ListFavoriteMoviesByUserID(ctx, usecase.ListFavoriteMoviesByUserIDParams{
+			UserID:    currentUser.ID,
+			Genres:    splitGenres(req.Genre),
+			Release:   release,
+			Languages: preferredLanguages(c, req.Lang),
+			Page:      usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, page.NextCursor)
+		return c.JSON(http.StatusOK, page)
+	}
+}
+
+// splitGenres returns the genre slugs of the comma separated genre query
+func splitGenres(genre string) []string {
+	var slugs []string
+	for _, slug := range strings.Split(genre, ",") {
+		if slug = strings.ToLower(strings.TrimSpace(slug)); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+
+	return slugs
+}
+
+// releaseFilterRequest are the query parameters which filter the movies by their releases, the dates are
+// formatted as 2006-01-02, 2006-01 or 2006 and both of them are inclusive, so released_until=2022 is until the end
+// of 2022
+type releaseFilterRequest struct {
+	Country       string `query:"country" validate:"omitempty,len=2,alpha"`
+	ReleaseType   string `query:"release_type" validate:"omitempty,oneof=theatrical digital physical"`
+	ReleasedFrom  string `query:"released_from"`
+	ReleasedUntil string `query:"released_until"`
+	MaxAge        *uint8 `query:"max_age"`
+}
+
+// toFilter returns the release filter of the request, the country of the current user is used when the country
+// query parameter is not given
+func (r releaseFilterRequest) toFilter(currentUser *entity.User) (usecase.ReleaseFilter, error) {
+	filter := usecase.ReleaseFilter{
+		Country:       strings.ToUpper(r.Country),
+		ReleaseType:   r.ReleaseType,
+		MaxMinimumAge: r.MaxAge,
+	}
+
+	if filter.Country == "" && currentUser != nil && currentUser.Country != nil {
+		filter.Country = *currentUser.Country
+	}
+
+	if r.ReleasedFrom != "" {
+		releasedFrom, precision, err := entity.ParseDate(r.ReleasedFrom)
+		if err != nil {
+			return usecase.ReleaseFilter{}, httperrors.NewBadRequestError(fmt.Errorf("released_from: %w", err))
+		}
+		from := releasedFrom.Truncate(precision).Time()
+		filter.ReleasedFrom = &from
+	}
+
+	if r.ReleasedUntil != "" {
+		releasedUntil, precision, err := entity.ParseDate(r.ReleasedUntil)
+		if err != nil {
+			return usecase.ReleaseFilter{}, httperrors.NewBadRequestError(fmt.Errorf("released_until: %w", err))
+		}
+		releasedBefore := releasedUntil.End(precision).Time()
+		filter.ReleasedBefore = &releasedBefore
+	}
+
+	return filter, nil
+}
+
+// preferredLanguages returns the languages of the translation ordered by preference, lang comes before the
+// languages of the Accept-Language header
+func preferredLanguages(c echo.Context, lang string) []string {
+	languages := language.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+	if lang = language.Normalize(lang); lang != "" {
+		return append([]string{lang}, languages...)
+	}
+
+	return languages
+}
+
+type movieRequest struct {
+	OriginalTitle    string  `json:"original_title" validate:"required,lte=255"`
+	OriginalLanguage string  `json:"original_language" validate:"required,lte=255"`
+	Overview         *string `json:"overview" validate:"omitempty,lte=1000"`
+	PosterPath       *string `json:"poster_path" validate:"omitempty,lte=2048"`
+	BackdropPath     *string `json:"backdrop_path" validate:"omitempty,lte=2048"`
+	Adult            bool    `json:"adult"`
+	// ReleaseDate is formatted as 2006-01-02, 2006-01 or 2006, its precision is the one of the format unless
+	// ReleaseDatePrecision is given
+	ReleaseDate          *string `json:"release_date"`
+	ReleaseDatePrecision *string `json:"release_date_precision" validate:"omitempty,oneof=year month day"`
+	Budget               *uint64 `json:"budget"`
+	Revenue              *int64  `json:"revenue"`
+	Currency             *string `json:"currency" validate:"omitempty,iso4217"`
+}
+
+func (r movieRequest) toParams() (usecase.MovieParams, error) {
+	releaseDate, releaseDatePrecision, err := parseReleaseDate(r.ReleaseDate, r.ReleaseDatePrecision)
+	if err != nil {
+		return usecase.MovieParams{}, err
+	}
+
+	return usecase.MovieParams{
+		OriginalTitle:        r.OriginalTitle,
+		OriginalLanguage:     r.OriginalLanguage,
+		Overview:             r.Overview,
+		PosterPath:           r.PosterPath,
+		BackdropPath:         r.BackdropPath,
+		Adult:                r.Adult,
+		ReleaseDate:          releaseDate,
+		ReleaseDatePrecision: releaseDatePrecision,
+		Budget:               r.Budget,
+		Revenue:              r.Revenue,
+		Currency:             r.Currency,
+	}, nil
+}
+
+// parseReleaseDate parses the release date formatted as 2006-01-02, 2006-01 or 2006, its precision is the one of
+// the format when precision is nil
+func parseReleaseDate(value *string, precision *string) (*entity.Date, *string, error) {
+	if value == nil {
+		return nil, precision, nil
+	}
+
+	releaseDate, parsedPrecision, err := entity.ParseDate(*value)
+	if err != nil {
+		return nil, nil, httperrors.NewBadRequestError(fmt.Errorf("release_date: %w", err))
+	}
+
+	if precision == nil {
+		precision = &parsedPrecision
+	}
+
+	return &releaseDate, precision, nil
+}
+
+// CreateMovie godoc
+// @Summary Create a movie.
+// @Description Create a movie of the catalog.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param movieRequest body movieRequest true "movieRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.Movie
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies [post]
+func (h *movieHandlers) CreateMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &movieRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		params, err := req.toParams()
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		movie, err := h.movieUsecase.CreateMovie(ctx, params)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, movie)
+	}
+}
+
+type updateMovieRequest struct {
+	ID uint64 `param:"id"`
+	movieRequest
+}
+
+// UpdateMovie godoc
+// @Summary Replace a movie.
+// @Description Replace every field of a movie, the fields which are not given are cleared.
+// 							If the movie is not exist or is deleted returns http.StatusNotFound.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param movieRequest body movieRequest true "movieRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Movie
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id} [put]
+func (h *movieHandlers) UpdateMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &updateMovieRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		params, err := req.movieRequest.toParams()
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		movie, err := h.movieUsecase.UpdateMovie(ctx, usecase.UpdateMovieParams{
+			MovieID:     req.ID,
+			MovieParams: params,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, movie)
+	}
+}
+
+type patchMovieRequest struct {
+	ID               uint64  `param:"id"`
+	OriginalTitle    *string `json:"original_title" validate:"omitempty,gte=1,lte=255"`
+	OriginalLanguage *string `json:"original_language" validate:"omitempty,gte=1,lte=255"`
+	Overview         *string `json:"overview" validate:"omitempty,lte=1000"`
+	PosterPath       *string `json:"poster_path" validate:"omitempty,lte=2048"`
+	BackdropPath     *string `json:"backdrop_path" validate:"omitempty,lte=2048"`
+	Adult            *bool   `json:"adult"`
+	// ReleaseDatePrecision alone changes the precision of the current release date
+	ReleaseDate          *string `json:"release_date"`
+	ReleaseDatePrecision *string `json:"release_date_precision" validate:"omitempty,oneof=year month day"`
+	Budget               *uint64 `json:"budget"`
+	Revenue              *int64  `json:"revenue"`
+	Currency             *string `json:"currency" validate:"omitempty,iso4217"`
+}
+
+// PatchMovie godoc
+// @Summary Update some fields of a movie.
+// @Description Update only the given fields of a movie, use PUT to clear a field.
+// 							If the movie is not exist or is deleted returns http.StatusNotFound.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param patchMovieRequest body patchMovieRequest true "patchMovieRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Movie
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id} [patch]
+func (h *movieHandlers) PatchMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &patchMovieRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		releaseDate, releaseDatePrecision, err := parseReleaseDate(req.ReleaseDate, req.ReleaseDatePrecision)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		movie, err := h.movieUsecase.PatchMovie(ctx, usecase.PatchMovieParams{
+			MovieID:              req.ID,
+			OriginalTitle:        req.OriginalTitle,
+			OriginalLanguage:     req.OriginalLanguage,
+			Overview:             req.Overview,
+			PosterPath:           req.PosterPath,
+			BackdropPath:         req.BackdropPath,
+			Adult:                req.Adult,
+			ReleaseDate:          releaseDate,
+			ReleaseDatePrecision: releaseDatePrecision,
+			Budget:               req.Budget,
+			Revenue:              req.Revenue,
+			Currency:             req.Currency,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, movie)
+	}
+}
+
+// DeleteMovie godoc
+// @Summary Delete a movie.
+// @Description Soft delete a movie, the deleted movie is not returned by any api until it is restored.
+// 							If the movie is not exist or is already deleted returns http.StatusNotFound.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id} [delete]
+func (h *movieHandlers) DeleteMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getByIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.movieUsecase.DeleteMovie(ctx, req.ID); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// RestoreMovie godoc
+// @Summary Restore a deleted movie.
+// @Description Restore a deleted movie, if there is no deleted movie of the id returns http.StatusNotFound.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Movie
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/restore [post]
+func (h *movieHandlers) RestoreMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getByIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		movie, err := h.movieUsecase.RestoreMovie(ctx, req.ID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, movie)
+	}
+}
+
+type setExternalIDRequest struct {
+	MovieID    uint64 `param:"id"`
+	Source     string `param:"source" validate:"oneof=imdb tmdb wikidata"`
+	ExternalID string `json:"external_id" validate:"required,max=50"`
+}
+
+// SetExternalID godoc
+// @Summary Set the id of a movie in another system such as IMDb.
+// @Description Set the id of a movie in another system, the current id of the source is replaced. Returns all the external ids of the movie.
+// 							If the id is not valid for the source returns http.StatusBadRequest, if the id already identifies another movie returns http.StatusConflict.
+// 							Only the admins can call it, returns http.StatusUnauthorized when user is not login and http.StatusForbidden when user is not an admin.
+// @Tags Movies
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param source path string true "source of the id" Enums(imdb, tmdb, wikidata)
+// @Param setExternalIDRequest body setExternalIDRequest true "setExternalIDRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 409 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/external_ids/{source} [put]
+func (h *movieHandlers) SetExternalID() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &setExternalIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
 		ctx := utils.GetRequestCtx(c)
-		movies, err := h.movieUsecase.ListFavoriteMoviesByUserID(ctx, currentUser.ID)
+		externalIDs, err := h.movieUsecase.SetMovieExternalID(ctx, usecase.SetMovieExternalIDParams{
+			MovieID:    req.MovieID,
+			Source:     req.Source,
+			ExternalID: req.ExternalID,
+		})
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httperrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, movies)
+		return c.JSON(http.StatusOK, externalIDs)
 	}
 }
@@ -0,0 +1,249 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type moderationHandlers struct {
+	cfg               *config.Config
+	moderationUsecase handlersusecase.ModerationUsecase
+	logger            logger.Logger
+	getCurrentUserFn  func(c echo.Context) (*entity.User, error)
+}
+
+func NewModerationHandlers(cfg *config.Config, moderationUsecase handlersusecase.ModerationUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error)) *moderationHandlers {
+	return &moderationHandlers{cfg: cfg, moderationUsecase: moderationUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn}
+}
+
+// viewerOf returns the viewer of reviews and comments, currentUser is nil for anonymous users
+func viewerOf(currentUser *entity.User) usecase.Viewer {
+	if currentUser == nil {
+		return usecase.Viewer{}
+	}
+
+	return usecase.Viewer{UserID: currentUser.ID, IsModerator: currentUser.Role.CanModerate()}
+}
+
+type reportContentRequest struct {
+	ID     uint64 `param:"id"`
+	Reason string `json:"reason" validate:"required,lte=1000"`
+}
+
+// ReportReview godoc
+// @Summary Report an abusive review.
+// @Description Report an abusive review to the moderators with a reason, each user can report a review only once.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the review is written by the user or is already reported by the user returns http.StatusBadRequest.
+// @Tags Moderation
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param reportContentRequest body reportContentRequest true "reportContentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.Report
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/reports [post]
+func (h *moderationHandlers) ReportReview() echo.HandlerFunc {
+	return h.reportContent(h.moderationUsecase.ReportReview)
+}
+
+// ReportComment godoc
+// @Summary Report an abusive comment.
+// @Description Report an abusive comment to the moderators with a reason, each user can report a comment only once.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the comment is written by the user or is already reported by the user returns http.StatusBadRequest.
+// @Tags Moderation
+// @Accept json
+// @Param id path uint64 true "comment id"
+// @Param reportContentRequest body reportContentRequest true "reportContentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.Report
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /comments/{id}/reports [post]
+func (h *moderationHandlers) ReportComment() echo.HandlerFunc {
+	return h.reportContent(h.moderationUsecase.ReportComment)
+}
+
+func (h *moderationHandlers) reportContent(
+	reportFn func(ctx context.Context, args usecase.ReportContentParams) (*entity.Report, error)) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &reportContentRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		report, err := reportFn(ctx, usecase.ReportContentParams{
+			UserID:   currentUser.ID,
+			TargetID: req.ID,
+			Reason:   req.Reason,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, report)
+	}
+}
+
+type listReportsRequest struct {
+	Status string `query:"status" validate:"omitempty,oneof=open resolved"`
+	pageRequest
+}
+
+// ListReports godoc
+// @Summary List the moderation queue.
+// @Description List the reports of reviews and comments, the oldest first. Only the open reports are listed by default.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not a moderator returns http.StatusForbidden.
+// @Tags Moderation
+// @Accept json
+// @Param status query string false "open or resolved" Enums(open, resolved)
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of reports per page"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.ReportPage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /moderation/reports [get]
+func (h *moderationHandlers) ListReports() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listReportsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		reports, err := h.moderationUsecase.ListReports(ctx, usecase.ListReportsParams{
+			Status: req.Status,
+			Page:   usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, reports.NextCursor)
+		return c.JSON(http.StatusOK, reports)
+	}
+}
+
+type moderateContentRequest struct {
+	ID     uint64 `param:"id"`
+	Action string `json:"action" validate:"required,oneof=hide restore delete"`
+	Note   string `json:"note" validate:"required,lte=1000"`
+}
+
+// ModerateReview godoc
+// @Summary Hide, restore or delete a review.
+// @Description Hide, restore or delete a review and record the decision note, the open reports of the review are resolved.
+// 							A hidden review is not listed publicly but is still shown to its author with its moderation status.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not a moderator returns http.StatusForbidden.
+// @Tags Moderation
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param moderateContentRequest body moderateContentRequest true "moderateContentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.ModerationAction
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /moderation/reviews/{id} [post]
+func (h *moderationHandlers) ModerateReview() echo.HandlerFunc {
+	return h.moderateContent(h.moderationUsecase.ModerateReview)
+}
+
+// ModerateComment godoc
+// @Summary Hide, restore or delete a comment.
+// @Description Hide, restore or delete a comment and record the decision note, the open reports of the comment are resolved.
+// 							A hidden comment is not listed publicly but is still shown to its author with its moderation status.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not a moderator returns http.StatusForbidden.
+// @Tags Moderation
+// @Accept json
+// @Param id path uint64 true "comment id"
+// @Param moderateContentRequest body moderateContentRequest true "moderateContentRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.ModerationAction
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /moderation/comments/{id} [post]
+func (h *moderationHandlers) ModerateComment() echo.HandlerFunc {
+	return h.moderateContent(h.moderationUsecase.ModerateComment)
+}
+
+func (h *moderationHandlers) moderateContent(
+	moderateFn func(ctx context.Context, args usecase.ModerateContentParams) (*entity.ModerationAction, error)) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &moderateContentRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		action, err := moderateFn(ctx, usecase.ModerateContentParams{
+			ModeratorID: currentUser.ID,
+			TargetID:    req.ID,
+			Action:      req.Action,
+			Note:        req.Note,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, action)
+	}
+}
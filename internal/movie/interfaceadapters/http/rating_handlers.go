@@ -0,0 +1,169 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type ratingHandlers struct {
+	cfg              *config.Config
+	ratingUsecase    handlersusecase.RatingUsecase
+	logger           logger.Logger
+	getCurrentUserFn func(c echo.Context) (*entity.User, error)
+}
+
+func NewRatingHandlers(cfg *config.Config, ratingUsecase handlersusecase.RatingUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error)) *ratingHandlers {
+	return &ratingHandlers{cfg: cfg, ratingUsecase: ratingUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn}
+}
+
+type rateMovieRequest struct {
+	MovieID uint64 `param:"id"`
+	Score   uint8  `json:"score" validate:"required,gte=1,lte=10"`
+}
+
+// RateMovie godoc
+// @Summary Rate a movie.
+// @Description Rate a movie with a score from 1 to 10, rating the movie again changes the previous score.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Ratings
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param rateMovieRequest body rateMovieRequest true "rateMovieRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Rating
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/rating [put]
+func (h *ratingHandlers) RateMovie() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &rateMovieRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		rating, err := h.ratingUsecase.RateMovie(ctx, usecase.RateMovieParams{
+			UserID:  currentUser.ID,
+			MovieID: req.MovieID,
+			Score:   req.Score,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, rating)
+	}
+}
+
+type ratingRequest struct {
+	MovieID uint64 `param:"id"`
+}
+
+// GetRating godoc
+// @Summary Get the rating of current login user for a movie.
+// @Description Get the rating of current login user for a movie, if the movie is not rated returns http.StatusNotFound.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Ratings
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Rating
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/rating [get]
+func (h *ratingHandlers) GetRating() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &ratingRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		rating, err := h.ratingUsecase.GetRating(ctx, usecase.GetRatingParams{
+			UserID:  currentUser.ID,
+			MovieID: req.MovieID,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, rating)
+	}
+}
+
+// DeleteRating godoc
+// @Summary Remove the rating of current login user for a movie.
+// @Description Remove the rating of current login user for a movie, if the movie is not rated returns http.StatusNotFound.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Ratings
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/rating [delete]
+func (h *ratingHandlers) DeleteRating() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &ratingRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.ratingUsecase.DeleteRating(ctx, usecase.DeleteRatingParams{
+			UserID:  currentUser.ID,
+			MovieID: req.MovieID,
+		}); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,359 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type reviewHandlers struct {
+	cfg                 *config.Config
+	reviewUsecase       handlersusecase.ReviewUsecase
+	logger              logger.Logger
+	getCurrentUserFn    func(c echo.Context) (*entity.User, error)
+	lookupCurrentUserFn func(c echo.Context) *entity.User
+}
+
+func NewReviewHandlers(cfg *config.Config, reviewUsecase handlersusecase.ReviewUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error),
+	lookupCurrentUserFn func(c echo.Context) *entity.User) *reviewHandlers {
+	return &reviewHandlers{cfg: cfg, reviewUsecase: reviewUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn, lookupCurrentUserFn: lookupCurrentUserFn}
+}
+
+// revealSpoilers returns the reveal_spoilers query parameter when it is given, otherwise the preference
+// of the current user, spoilers are masked for anonymous users
+func revealSpoilers(revealSpoilersParam *bool, currentUser *entity.User) bool {
+	if revealSpoilersParam != nil {
+		return *revealSpoilersParam
+	}
+
+	if currentUser != nil {
+		return currentUser.RevealSpoilers
+	}
+
+	return false
+}
+
+type createReviewRequest struct {
+	MovieID   uint64 `param:"id"`
+	Title     string `json:"title" validate:"required,lte=255"`
+	Content   string `json:"content" validate:"required,lte=10000"`
+	IsSpoiler bool   `json:"is_spoiler"`
+}
+
+// CreateReview godoc
+// @Summary Write a review for a movie.
+// @Description Write a review for a movie, each user can write only one review per movie.
+// 							Set is_spoiler to mark the whole review as spoiler or enclose spoilers with || in title and content, e.g. "the hero ||dies||".
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the movie is already reviewed by user returns http.StatusBadRequest.
+// 							A review which the content filter sends to moderation is created as pending and shown only to its author until a moderator restores it.
+// 							If the content filter rejects the content returns http.StatusBadRequest, or http.StatusTooManyRequests when posting too often.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param createReviewRequest body createReviewRequest true "createReviewRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 201 {object} entity.Review
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 429 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/reviews [post]
+func (h *reviewHandlers) CreateReview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &createReviewRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		review, err := h.reviewUsecase.CreateReview(ctx, usecase.CreateReviewParams{
+			UserID:    currentUser.ID,
+			MovieID:   req.MovieID,
+			Title:     req.Title,
+			Content:   req.Content,
+			IsSpoiler: req.IsSpoiler,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, review)
+	}
+}
+
+type listReviewsRequest struct {
+	MovieID        uint64 `param:"id"`
+	Sort           string `query:"sort" validate:"omitempty,oneof=helpful newest rating"`
+	AuthorType     string `query:"author_type" validate:"omitempty,oneof=critic audience"`
+	RevealSpoilers *bool  `query:"reveal_spoilers"`
+	pageRequest
+}
+
+// ListReviews godoc
+// @Summary List reviews of a movie.
+// @Description List reviews of a movie, if the movie is not exist returns http.StatusNotFound.
+// 							The reviews are sorted by helpfulness (lower bound of wilson score of helpful votes) by default,
+// 							newest sorts the latest reviews first and rating sorts the reviews whose author rated the movie highest first.
+// 							Reviews hidden by a moderator are not listed.
+// 							author_type critic lists only the reviews of the verified critics and audience lists the reviews of the other users.
+// 							Spoilers are masked unless reveal_spoilers is true, the preference of login user is used when it is not given.
+// 							The reviews are paged, the cursor of a page only continues the list of the same sort.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param sort query string false "helpful, newest or rating" Enums(helpful, newest, rating)
+// @Param author_type query string false "critic or audience, every review is listed when it is not given" Enums(critic, audience)
+// @Param reveal_spoilers query bool false "returns the full text of spoilers"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of reviews per page"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.ReviewPage
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/reviews [get]
+func (h *reviewHandlers) ListReviews() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listReviewsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		page, err := h.reviewUsecase.ListReviewsByMovieID(ctx, usecase.ListReviewsByMovieIDParams{
+			MovieID:        req.MovieID,
+			Sort:           req.Sort,
+			AuthorType:     req.AuthorType,
+			RevealSpoilers: revealSpoilers(req.RevealSpoilers, h.lookupCurrentUserFn(c)),
+			Page:           usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, page.NextCursor)
+		return c.JSON(http.StatusOK, page)
+	}
+}
+
+type getReviewByIDRequest struct {
+	ID             uint64 `param:"id"`
+	RevealSpoilers *bool  `query:"reveal_spoilers"`
+}
+
+// GetReviewByID godoc
+// @Summary Get review by its Id
+// @Description Get review by its Id, if the id is not exist returns http.StatusNotFound.
+// 							Spoilers are masked unless reveal_spoilers is true, the preference of login user is used when it is not given.
+// 							A review hidden by a moderator is returned only to its author and the moderators.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param reveal_spoilers query bool false "returns the full text of spoilers"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.Review
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id} [get]
+func (h *reviewHandlers) GetReviewByID() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getReviewByIDRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		review, err := h.reviewUsecase.GetReviewByID(ctx, usecase.GetReviewByIDParams{
+			ReviewID:       req.ID,
+			RevealSpoilers: revealSpoilers(req.RevealSpoilers, h.lookupCurrentUserFn(c)),
+			Viewer:         viewerOf(h.lookupCurrentUserFn(c)),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, review)
+	}
+}
+
+type updateReviewRequest struct {
+	ID        uint64 `param:"id"`
+	Title     string `json:"title" validate:"required,lte=255"`
+	Content   string `json:"content" validate:"required,lte=10000"`
+	IsSpoiler bool   `json:"is_spoiler"`
+}
+
+// UpdateReview godoc
+// @Summary Edit a review.
+// @Description Edit a review, only the author of the review can edit it. The edited content is checked by the content filter again.
+// 							The previous version is kept and the review is marked as edited, the versions can be read with /reviews/{id}/revisions.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not the author returns http.StatusForbidden.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param updateReviewRequest body updateReviewRequest true "updateReviewRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Review
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id} [put]
+func (h *reviewHandlers) UpdateReview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &updateReviewRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		review, err := h.reviewUsecase.UpdateReview(ctx, usecase.UpdateReviewParams{
+			ReviewID:  req.ID,
+			UserID:    currentUser.ID,
+			Title:     req.Title,
+			Content:   req.Content,
+			IsSpoiler: req.IsSpoiler,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, review)
+	}
+}
+
+type listReviewRevisionsRequest struct {
+	ID             uint64 `param:"id"`
+	RevealSpoilers *bool  `query:"reveal_spoilers"`
+}
+
+// ListReviewRevisions godoc
+// @Summary List the versions of a review.
+// @Description List every version of a review, the oldest first and the current review last, with the word by word changes from the version before it.
+// 							Spoilers are masked unless reveal_spoilers is true, the preference of login user is used when it is not given.
+// 							The versions of a review hidden by a moderator are returned only to its author and the moderators.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param reveal_spoilers query bool false "returns the full text of spoilers"
+// @Param Authorization header string false "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Success 200 {object} entity.ReviewRevisionHistory
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/revisions [get]
+func (h *reviewHandlers) ListReviewRevisions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listReviewRevisionsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser := h.lookupCurrentUserFn(c)
+
+		ctx := utils.GetRequestCtx(c)
+		history, err := h.reviewUsecase.ListReviewRevisions(ctx, usecase.ListReviewRevisionsParams{
+			ReviewID:       req.ID,
+			RevealSpoilers: revealSpoilers(req.RevealSpoilers, currentUser),
+			Viewer:         viewerOf(currentUser),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, history)
+	}
+}
+
+type deleteReviewRequest struct {
+	ID uint64 `param:"id"`
+}
+
+// DeleteReview godoc
+// @Summary Delete a review.
+// @Description Delete a review, only the author of the review can delete it.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is not the author returns http.StatusForbidden.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id} [delete]
+func (h *reviewHandlers) DeleteReview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &deleteReviewRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.reviewUsecase.DeleteReview(ctx, usecase.DeleteReviewParams{
+			ReviewID: req.ID,
+			UserID:   currentUser.ID,
+		}); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
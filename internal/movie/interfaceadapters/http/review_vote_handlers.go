@@ -0,0 +1,135 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type reviewVoteHandlers struct {
+	cfg               *config.Config
+	reviewVoteUsecase handlersusecase.ReviewVoteUsecase
+	logger            logger.Logger
+	getCurrentUserFn  func(c echo.Context) (*entity.User, error)
+}
+
+func NewReviewVoteHandlers(cfg *config.Config, reviewVoteUsecase handlersusecase.ReviewVoteUsecase,
+	log logger.Logger, getCurrentUserFn func(c echo.Context) (*entity.User, error)) *reviewVoteHandlers {
+	return &reviewVoteHandlers{cfg: cfg, reviewVoteUsecase: reviewVoteUsecase, logger: log,
+		getCurrentUserFn: getCurrentUserFn}
+}
+
+type voteReviewRequest struct {
+	ReviewID       uint64 `param:"id"`
+	Helpful        *bool  `json:"helpful" validate:"required"`
+	RevealSpoilers *bool  `query:"reveal_spoilers"`
+}
+
+// VoteReview godoc
+// @Summary Vote a review helpful or not helpful.
+// @Description Vote a review helpful or not helpful, voting the review again changes the previous vote.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If user is the author of the review returns http.StatusBadRequest.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param voteReviewRequest body voteReviewRequest true "voteReviewRequest body"
+// @Param reveal_spoilers query bool false "returns the full text of spoilers"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.Review
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/vote [put]
+func (h *reviewVoteHandlers) VoteReview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &voteReviewRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		// echo binds query parameters only for GET and DELETE requests
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		review, err := h.reviewVoteUsecase.VoteReview(ctx, usecase.VoteReviewParams{
+			ReviewID:       req.ReviewID,
+			UserID:         currentUser.ID,
+			Helpful:        *req.Helpful,
+			RevealSpoilers: revealSpoilers(req.RevealSpoilers, currentUser),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, review)
+	}
+}
+
+type deleteReviewVoteRequest struct {
+	ReviewID uint64 `param:"id"`
+}
+
+// DeleteReviewVote godoc
+// @Summary Remove the vote of current login user for a review.
+// @Description Remove the vote of current login user for a review, if the review is not voted returns http.StatusNotFound.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Reviews
+// @Accept json
+// @Param id path uint64 true "review id"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /reviews/{id}/vote [delete]
+func (h *reviewVoteHandlers) DeleteReviewVote() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &deleteReviewVoteRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.reviewVoteUsecase.DeleteReviewVote(ctx, usecase.DeleteReviewVoteParams{
+			ReviewID: req.ReviewID,
+			UserID:   currentUser.ID,
+		}); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
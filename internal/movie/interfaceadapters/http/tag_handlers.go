@@ -0,0 +1,215 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/http/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+)
+
+type tagHandlers struct {
+	cfg              *config.Config
+	tagUsecase       handlersusecase.TagUsecase
+	logger           logger.Logger
+	getCurrentUserFn func(c echo.Context) (*entity.User, error)
+}
+
+func NewTagHandlers(cfg *config.Config, tagUsecase handlersusecase.TagUsecase, log logger.Logger,
+	getCurrentUserFn func(c echo.Context) (*entity.User, error)) *tagHandlers {
+	return &tagHandlers{cfg: cfg, tagUsecase: tagUsecase, logger: log, getCurrentUserFn: getCurrentUserFn}
+}
+
+type getTagRequest struct {
+	Slug string `param:"slug"`
+	Lang string `query:"lang"`
+	pageRequest
+}
+
+// GetTag godoc
+// @Summary Get a tag with its movies.
+// @Description Get a tag with the movies which users applied it to, the movies which most users applied the tag to come first.
+// 							The title and the overview of the movies are translated into the lang or the best language of Accept-Language.
+// 							If the tag is not exist returns http.StatusNotFound.
+// @Tags Tags
+// @Accept json
+// @Param slug path string true "tag slug such as time-travel"
+// @Param lang query string false "language tag of the translation, it is preferred to Accept-Language"
+// @Param Accept-Language header string false "languages of the translation"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned when it is empty"
+// @Param limit query uint false "number of movies per page"
+// @Produce json
+// @Success 200 {object} entity.Tag
+// @Header 200 {string} Link "RFC 8288 link of the next page, it is not set on the last page"
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /tags/{slug} [get]
+func (h *tagHandlers) GetTag() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &getTagRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		tag, err := h.tagUsecase.GetTag(ctx, usecase.GetTagParams{
+			Slug:      req.Slug,
+			Languages: preferredLanguages(c, req.Lang),
+			Page:      usecase.PageParams(req.pageRequest),
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		setNextLink(c, tag.NextCursor)
+		return c.JSON(http.StatusOK, tag)
+	}
+}
+
+type listMovieTagsRequest struct {
+	MovieID uint64 `param:"id"`
+}
+
+// ListMovieTags godoc
+// @Summary Get the tag cloud of a movie.
+// @Description Get the tags of a movie weighted by the number of the users who applied them, the most applied tags come first.
+// 							The weight of the most applied tag is 1.
+// 							If the movie is not exist returns http.StatusNotFound.
+// @Tags Tags
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Produce json
+// @Success 200 {object} entity.MovieTagCloud
+// @Failure 400 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/tags [get]
+func (h *tagHandlers) ListMovieTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &listMovieTagsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		cloud, err := h.tagUsecase.ListMovieTags(ctx, req.MovieID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, cloud)
+	}
+}
+
+type addMovieTagsRequest struct {
+	MovieID uint64   `param:"id"`
+	Tags    []string `json:"tags" validate:"required,min=1,max=10,dive,required,max=100"`
+}
+
+// AddMovieTags godoc
+// @Summary Tag a movie.
+// @Description Apply free-form tags such as "time travel" to a movie, the tags are normalized into lower case words joined by "-".
+// 							The tags which user already applied to the movie are skipped. Returns the tag cloud of the movie.
+// 							If user is not login returns http.StatusUnauthorized.
+// 							If the movie is not exist returns http.StatusNotFound.
+// @Tags Tags
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param addMovieTagsRequest body addMovieTagsRequest true "addMovieTagsRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.MovieTagCloud
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/tags [post]
+func (h *tagHandlers) AddMovieTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &addMovieTagsRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		cloud, err := h.tagUsecase.AddMovieTags(ctx, usecase.AddMovieTagsParams{
+			UserID:  currentUser.ID,
+			MovieID: req.MovieID,
+			Tags:    req.Tags,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, cloud)
+	}
+}
+
+type deleteMovieTagRequest struct {
+	MovieID uint64 `param:"id"`
+	Slug    string `param:"slug"`
+}
+
+// DeleteMovieTag godoc
+// @Summary Remove a tag which current login user applied to a movie.
+// @Description Remove a tag which current login user applied to a movie, if user did not apply the tag returns http.StatusNotFound.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Tags
+// @Accept json
+// @Param id path uint64 true "movie id"
+// @Param slug path string true "tag slug such as time-travel"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /movies/{id}/tags/{slug} [delete]
+func (h *tagHandlers) DeleteMovieTag() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &deleteMovieTagRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		if err := h.tagUsecase.DeleteMovieTag(ctx, usecase.DeleteMovieTagParams{
+			UserID:  currentUser.ID,
+			MovieID: req.MovieID,
+			Slug:    req.Slug,
+		}); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type BoxOfficeUsecase interface {
+	GetBoxOffice(ctx context.Context, movieID uint64) (*entity.BoxOffice, error)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type CollectionUsecase interface {
+	GetCollection(ctx context.Context, args usecase.GetCollectionParams) (*entity.Collection, error)
+	AddFavoriteCollection(ctx context.Context, args usecase.AddFavoriteCollectionParams) (int64, error)
+}
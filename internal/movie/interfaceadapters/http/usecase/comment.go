@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type CommentUsecase interface {
+	CreateComment(ctx context.Context, args usecase.CreateCommentParams) (*entity.Comment, error)
+	GetCommentByID(ctx context.Context, args usecase.GetCommentByIDParams) (*entity.Comment, error)
+	ListComments(ctx context.Context, args usecase.ListCommentsParams) (*entity.CommentPage, error)
+	ListReplies(ctx context.Context, args usecase.ListRepliesParams) (*entity.CommentPage, error)
+	UpdateComment(ctx context.Context, args usecase.UpdateCommentParams) (*entity.Comment, error)
+	DeleteComment(ctx context.Context, args usecase.DeleteCommentParams) error
+}
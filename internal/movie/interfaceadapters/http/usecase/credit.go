@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type CreditUsecase interface {
+	ListMovieCredits(ctx context.Context, movieID uint64) (*entity.MovieCredits, error)
+	GetPerson(ctx context.Context, args usecase.GetPersonParams) (*entity.Person, error)
+}
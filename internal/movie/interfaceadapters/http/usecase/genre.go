@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type GenreUsecase interface {
+	ListGenres(ctx context.Context) ([]*entity.Genre, error)
+}
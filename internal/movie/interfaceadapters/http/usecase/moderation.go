@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type ModerationUsecase interface {
+	ReportReview(ctx context.Context, args usecase.ReportContentParams) (*entity.Report, error)
+	ReportComment(ctx context.Context, args usecase.ReportContentParams) (*entity.Report, error)
+	ListReports(ctx context.Context, args usecase.ListReportsParams) (*entity.ReportPage, error)
+	ModerateReview(ctx context.Context, args usecase.ModerateContentParams) (*entity.ModerationAction, error)
+	ModerateComment(ctx context.Context, args usecase.ModerateContentParams) (*entity.ModerationAction, error)
+}
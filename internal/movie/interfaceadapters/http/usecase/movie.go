@@ -8,8 +8,15 @@ import (
 )
 
 type MovieUsecase interface {
-	GetMovieByID(ctx context.Context, movieID uint64) (*entity.Movie, error)
-	SearchByKeyword(ctx context.Context, keyword string) ([]*entity.Movie, error)
+	GetMovieByID(ctx context.Context, args usecase.GetMovieByIDParams) (*entity.Movie, error)
+	GetMovieByExternalID(ctx context.Context, args usecase.GetMovieByExternalIDParams) (*entity.Movie, error)
+	SearchByKeyword(ctx context.Context, args usecase.SearchByKeywordParams) (*entity.MoviePage, error)
 	AddFavoriteMovie(ctx context.Context, args usecase.AddFavoriteMovieParams) error
-	ListFavoriteMoviesByUserID(ctx context.Context, userID uint64) ([]*entity.Movie, error)
+	ListFavoriteMoviesByUserID(ctx context.Context, args usecase.ListFavoriteMoviesByUserIDParams) (*entity.MoviePage, error)
+	CreateMovie(ctx context.Context, args usecase.MovieParams) (*entity.Movie, error)
+	UpdateMovie(ctx context.Context, args usecase.UpdateMovieParams) (*entity.Movie, error)
+	PatchMovie(ctx context.Context, args usecase.PatchMovieParams) (*entity.Movie, error)
+	DeleteMovie(ctx context.Context, movieID uint64) error
+	RestoreMovie(ctx context.Context, movieID uint64) (*entity.Movie, error)
+	SetMovieExternalID(ctx context.Context, args usecase.SetMovieExternalIDParams) (entity.ExternalIDs, error)
 }
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type RatingUsecase interface {
+	RateMovie(ctx context.Context, args usecase.RateMovieParams) (*entity.Rating, error)
+	GetRating(ctx context.Context, args usecase.GetRatingParams) (*entity.Rating, error)
+	DeleteRating(ctx context.Context, args usecase.DeleteRatingParams) error
+}
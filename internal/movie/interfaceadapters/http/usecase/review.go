@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type ReviewUsecase interface {
+	CreateReview(ctx context.Context, args usecase.CreateReviewParams) (*entity.Review, error)
+	GetReviewByID(ctx context.Context, args usecase.GetReviewByIDParams) (*entity.Review, error)
+	ListReviewsByMovieID(ctx context.Context, args usecase.ListReviewsByMovieIDParams) (*entity.ReviewPage, error)
+	UpdateReview(ctx context.Context, args usecase.UpdateReviewParams) (*entity.Review, error)
+	ListReviewRevisions(ctx context.Context, args usecase.ListReviewRevisionsParams) (*entity.ReviewRevisionHistory, error)
+	DeleteReview(ctx context.Context, args usecase.DeleteReviewParams) error
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type ReviewVoteUsecase interface {
+	VoteReview(ctx context.Context, args usecase.VoteReviewParams) (*entity.Review, error)
+	DeleteReviewVote(ctx context.Context, args usecase.DeleteReviewVoteParams) error
+}
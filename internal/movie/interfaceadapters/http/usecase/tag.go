@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+)
+
+type TagUsecase interface {
+	GetTag(ctx context.Context, args usecase.GetTagParams) (*entity.Tag, error)
+	ListMovieTags(ctx context.Context, movieID uint64) (*entity.MovieTagCloud, error)
+	AddMovieTags(ctx context.Context, args usecase.AddMovieTagsParams) (*entity.MovieTagCloud, error)
+	DeleteMovieTag(ctx context.Context, args usecase.DeleteMovieTagParams) error
+}
@@ -0,0 +1,537 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ar"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ckb"
+	"github.com/blevesearch/bleve/v2/analysis/lang/da"
+	"github.com/blevesearch/bleve/v2/analysis/lang/de"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/analysis/lang/es"
+	"github.com/blevesearch/bleve/v2/analysis/lang/fa"
+	"github.com/blevesearch/bleve/v2/analysis/lang/fi"
+	"github.com/blevesearch/bleve/v2/analysis/lang/fr"
+	"github.com/blevesearch/bleve/v2/analysis/lang/hi"
+	"github.com/blevesearch/bleve/v2/analysis/lang/hr"
+	"github.com/blevesearch/bleve/v2/analysis/lang/hu"
+	"github.com/blevesearch/bleve/v2/analysis/lang/it"
+	"github.com/blevesearch/bleve/v2/analysis/lang/nl"
+	"github.com/blevesearch/bleve/v2/analysis/lang/no"
+	"github.com/blevesearch/bleve/v2/analysis/lang/pt"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ro"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ru"
+	"github.com/blevesearch/bleve/v2/analysis/lang/sv"
+	"github.com/blevesearch/bleve/v2/analysis/lang/tr"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/language"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
+)
+
+// languageAnalyzers are the analyzers which stem the texts of a language and leave out its stop words by the primary
+// language subtag, Chinese, Japanese and Korean share the CJK analyzer. The texts of the other languages are analyzed
+// by the standard analyzer which lower cases their words and leaves out the English stop words without stemming
+var languageAnalyzers = map[string]string{
+	"ar":  ar.AnalyzerName,
+	"ckb": ckb.AnalyzerName,
+	"da":  da.AnalyzerName,
+	"de":  de.AnalyzerName,
+	"en":  en.AnalyzerName,
+	"es":  es.AnalyzerName,
+	"fa":  fa.AnalyzerName,
+	"fi":  fi.AnalyzerName,
+	"fr":  fr.AnalyzerName,
+	"hi":  hi.AnalyzerName,
+	"hr":  hr.AnalyzerName,
+	"hu":  hu.AnalyzerName,
+	"it":  it.AnalyzerName,
+	"ja":  cjk.AnalyzerName,
+	"ko":  cjk.AnalyzerName,
+	"nl":  nl.AnalyzerName,
+	"no":  no.AnalyzerName,
+	"pt":  pt.AnalyzerName,
+	"ro":  ro.AnalyzerName,
+	"ru":  ru.AnalyzerName,
+	"sv":  sv.AnalyzerName,
+	"tr":  tr.AnalyzerName,
+	"zh":  cjk.AnalyzerName,
+}
+
+// textAnalyzers are the analyzers of the titles and the overviews, every text is indexed in the field of the
+// analyzer of its language
+var textAnalyzers = func() []string {
+	analyzers := []string{standard.Name}
+	for _, analyzer := range languageAnalyzers {
+		if !containsString(analyzers, analyzer) {
+			analyzers = append(analyzers, analyzer)
+		}
+	}
+	sort.Strings(analyzers)
+
+	return analyzers
+}()
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// analyzerOf returns the analyzer of the texts of the language tag, e.g. "en" for "en-US"
+func analyzerOf(languageTag string) string {
+	primary := strings.SplitN(language.Normalize(languageTag), "-", 2)[0]
+	if analyzer, ok := languageAnalyzers[primary]; ok {
+		return analyzer
+	}
+
+	return standard.Name
+}
+
+const (
+	movieTitlesField    = "titles"
+	movieOverviewsField = "overviews"
+	movieAnalyzersField = "analyzers"
+	moviePeopleField    = "people"
+	movieTagsField      = "tags"
+)
+
+// movieDocument is what the index keeps of a movie, the original title and overview and the translated ones are
+// keyed by the analyzer of their language. Analyzers are the analyzers which the movie has a title of
+type movieDocument struct {
+	Titles    map[string][]string `json:"titles"`
+	Overviews map[string][]string `json:"overviews"`
+	Analyzers []string            `json:"analyzers"`
+	People    []string            `json:"people"`
+	Tags      []string            `json:"tags"`
+}
+
+func newMovieDocument() *movieDocument {
+	return &movieDocument{
+		Titles:    make(map[string][]string),
+		Overviews: make(map[string][]string),
+		Analyzers: make([]string, 0),
+		People:    make([]string, 0),
+		Tags:      make([]string, 0),
+	}
+}
+
+func (d *movieDocument) addText(languageTag string, title string, overview *string) {
+	analyzer := analyzerOf(languageTag)
+	if !containsString(d.Analyzers, analyzer) {
+		d.Analyzers = append(d.Analyzers, analyzer)
+	}
+
+	d.Titles[analyzer] = append(d.Titles[analyzer], title)
+	if overview != nil {
+		d.Overviews[analyzer] = append(d.Overviews[analyzer], *overview)
+	}
+}
+
+// NewMovieIndexMapping maps the documents of the movies, a title or an overview is analyzed by the analyzer of the
+// field where it is kept, the analyzers of the movie are kept as they are and the names of the people and the tag
+// slugs are analyzed by the standard analyzer
+func NewMovieIndexMapping() mapping.IndexMapping {
+	titles, overviews := bleve.NewDocumentStaticMapping(), bleve.NewDocumentStaticMapping()
+	for _, analyzer := range textAnalyzers {
+		titles.AddFieldMappingsAt(analyzer, textFieldMapping(analyzer))
+		overviews.AddFieldMappingsAt(analyzer, textFieldMapping(analyzer))
+	}
+
+	movie := bleve.NewDocumentStaticMapping()
+	movie.AddSubDocumentMapping(movieTitlesField, titles)
+	movie.AddSubDocumentMapping(movieOverviewsField, overviews)
+	movie.AddFieldMappingsAt(movieAnalyzersField, textFieldMapping(keyword.Name))
+	movie.AddFieldMappingsAt(moviePeopleField, textFieldMapping(standard.Name))
+	movie.AddFieldMappingsAt(movieTagsField, textFieldMapping(standard.Name))
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = movie
+
+	return indexMapping
+}
+
+// textFieldMapping indexes the positions of the words which the phrases are matched by, nothing is stored since the
+// movies are read from the database
+func textFieldMapping(analyzer string) *mapping.FieldMapping {
+	field := bleve.NewTextFieldMapping()
+	field.Analyzer = analyzer
+	field.Store = false
+	field.IncludeInAll = false
+	field.DocValues = false
+
+	return field
+}
+
+// OpenMovieIndex opens the index of the movies in the directory, an empty index is created when there is none
+func OpenMovieIndex(path string) (bleve.Index, error) {
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		return bleve.New(path, NewMovieIndexMapping())
+	}
+
+	return index, err
+}
+
+// MovieFieldBoosts weigh a match in the titles, the overviews, the names of the people credited in a movie and its
+// tags
+type MovieFieldBoosts struct {
+	Title    float64
+	Overview float64
+	Person   float64
+	Tag      float64
+}
+
+// bleveMovieSearcher searches the movies with a Bleve index and lists the movies of the hits with the movie
+// repository, which filters, orders and pages them like a search of MySQL
+type bleveMovieSearcher struct {
+	connManager     ConnManager
+	index           bleve.Index
+	movieRepository repository.MovieRepository
+	maxHits         int
+	boosts          MovieFieldBoosts
+}
+
+// defaultMaxHits is used when maxHits is not positive, a boost which is not positive is defaulted to 1
+const defaultMaxHits = 1000
+
+func NewBleveMovieSearcher(connManager ConnManager, index bleve.Index, movieRepository repository.MovieRepository,
+	maxHits int, boosts MovieFieldBoosts) *bleveMovieSearcher {
+	if maxHits <= 0 {
+		maxHits = defaultMaxHits
+	}
+
+	for _, boost := range []*float64{&boosts.Title, &boosts.Overview, &boosts.Person, &boosts.Tag} {
+		if *boost <= 0 {
+			*boost = 1
+		}
+	}
+
+	return &bleveMovieSearcher{connManager: connManager, index: index, movieRepository: movieRepository,
+		maxHits: maxHits, boosts: boosts}
+}
+
+// SearchMovies lists the movies of the best hits of the query, every movie is listed without the index when there
+// is no query
+func (s *bleveMovieSearcher) SearchMovies(ctx context.Context, args repository.FindMoviesParams) (*repository.MoviePage, error) {
+	if args.Query != nil {
+		request := bleve.NewSearchRequestOptions(s.searchQuery(*args.Query), s.maxHits, 0, false)
+		result, err := s.index.SearchInContext(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("SearchInContext: %w", err)
+		}
+
+		hits := make([]repository.MovieHit, len(result.Hits))
+		for i, hit := range result.Hits {
+			movieID, err := strconv.ParseUint(hit.ID, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("ParseUint: %w", err)
+			}
+
+			hits[i] = repository.MovieHit{MovieID: movieID, Score: hit.Score}
+		}
+
+		args.Query, args.Hits = nil, hits
+	}
+
+	page, err := s.movieRepository.FindMovies(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("movieRepository.FindMovies: %w", err)
+	}
+
+	return page, nil
+}
+
+// searchQuery matches every term of the search which is not excluded and none of the excluded ones. A stop word
+// which every analyzer leaves out is left out of the search since it matches no text
+func (s *bleveMovieSearcher) searchQuery(search searchquery.Query) query.Query {
+	must, mustNot := make([]query.Query, 0), make([]query.Query, 0)
+	for _, term := range search.Terms {
+		if s.isStopWord(term) {
+			continue
+		}
+
+		if term.Exclude {
+			mustNot = append(mustNot, s.termQuery(term))
+		} else {
+			must = append(must, s.termQuery(term))
+		}
+	}
+
+	return query.NewBooleanQuery(must, nil, mustNot)
+}
+
+// isStopWord tells whether the term is a single word which every analyzer of the search leaves out
+func (s *bleveMovieSearcher) isStopWord(term searchquery.Term) bool {
+	for _, analyzer := range textAnalyzers {
+		if !s.leavesOut(analyzer, term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// leavesOut tells whether the term is a single word which the analyzer leaves out as a stop word
+func (s *bleveMovieSearcher) leavesOut(name string, term searchquery.Term) bool {
+	if term.Phrase || term.Prefix {
+		return false
+	}
+
+	analyzer := s.index.Mapping().AnalyzerNamed(name)
+	return analyzer != nil && len(analyzer.Analyze([]byte(term.Words[0]))) == 0
+}
+
+// termQuery matches the term with any field of the movie, a term of the title field is matched with the titles only.
+// The words are analyzed by the analyzer of every field except the word of a prefix term which is lower cased only,
+// so it is matched with the stemmed words of a language. A word which the analyzer of a language leaves out is
+// satisfied by every movie having a title of the language, so "die" of "die hard" is required in the English texts
+// but not in the German ones, and it excludes none of them when the term is excluded
+func (s *bleveMovieSearcher) termQuery(term searchquery.Term) query.Query {
+	queries := make([]query.Query, 0)
+	for _, analyzer := range textAnalyzers {
+		if s.leavesOut(analyzer, term) {
+			if term.Exclude {
+				continue
+			}
+
+			analyzerQuery := bleve.NewTermQuery(analyzer)
+			analyzerQuery.SetField(movieAnalyzersField)
+			queries = append(queries, analyzerQuery)
+			continue
+		}
+
+		queries = append(queries, fieldQuery(term, movieTitlesField+"."+analyzer, s.boosts.Title))
+		if term.Field != searchquery.FieldTitle {
+			queries = append(queries, fieldQuery(term, movieOverviewsField+"."+analyzer, s.boosts.Overview))
+		}
+	}
+
+	if term.Field != searchquery.FieldTitle && !s.leavesOut(standard.Name, term) {
+		queries = append(queries, fieldQuery(term, moviePeopleField, s.boosts.Person),
+			fieldQuery(term, movieTagsField, s.boosts.Tag))
+	}
+
+	return bleve.NewDisjunctionQuery(queries...)
+}
+
+func fieldQuery(term searchquery.Term, field string, boost float64) query.Query {
+	switch {
+	case term.Phrase:
+		phraseQuery := bleve.NewMatchPhraseQuery(strings.Join(term.Words, " "))
+		phraseQuery.SetField(field)
+		phraseQuery.SetBoost(boost)
+		return phraseQuery
+	case term.Prefix:
+		prefixQuery := bleve.NewPrefixQuery(strings.ToLower(term.Words[0]))
+		prefixQuery.SetField(field)
+		prefixQuery.SetBoost(boost)
+		return prefixQuery
+	}
+
+	// a word may be split into several tokens, e.g. by the CJK analyzer, which must all match
+	matchQuery := bleve.NewMatchQuery(term.Words[0])
+	matchQuery.SetField(field)
+	matchQuery.SetBoost(boost)
+	matchQuery.SetOperator(query.MatchQueryOperatorAnd)
+
+	return matchQuery
+}
+
+func documentID(movieID uint64) string {
+	return strconv.FormatUint(movieID, 10)
+}
+
+const findMovieDocumentQuery = `SELECT id, original_title, original_language, overview
+FROM movies
+WHERE id = ? AND deleted_at IS NULL`
+
+// IndexMovie reads the movie from the writer so that the index gets what has just been written
+func (s *bleveMovieSearcher) IndexMovie(ctx context.Context, movieID uint64) error {
+	db := s.connManager.GetWriter()
+	movie := &Movie{}
+	if err := db.QueryRowxContext(ctx, findMovieDocumentQuery, movieID).StructScan(movie); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.RemoveMovie(ctx, movieID)
+		}
+
+		return fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	documents, err := movieDocuments(ctx, db, []*Movie{movie})
+	if err != nil {
+		return fmt.Errorf("movieDocuments: %w", err)
+	}
+
+	if err := s.index.Index(documentID(movieID), documents[movieID]); err != nil {
+		return fmt.Errorf("Index: %w", err)
+	}
+
+	return nil
+}
+
+func (s *bleveMovieSearcher) RemoveMovie(ctx context.Context, movieID uint64) error {
+	if err := s.index.Delete(documentID(movieID)); err != nil {
+		return fmt.Errorf("Delete: %w", err)
+	}
+
+	return nil
+}
+
+const indexBatchSize = 500
+
+const findMovieDocumentsQuery = `SELECT id, original_title, original_language, overview
+FROM movies
+WHERE id > ? AND deleted_at IS NULL
+ORDER BY id ASC
+LIMIT ?`
+
+// IndexAll indexes every movie of the catalog by batches and returns the number of the movies, it rebuilds the
+// index when it is run on a new one
+func (s *bleveMovieSearcher) IndexAll(ctx context.Context) (uint64, error) {
+	db := s.connManager.GetReader()
+	count, afterID := uint64(0), uint64(0)
+
+	for {
+		movies := make([]*Movie, 0, indexBatchSize)
+		rows, err := db.QueryxContext(ctx, findMovieDocumentsQuery, afterID, indexBatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("QueryxContext: %w", err)
+		}
+
+		for rows.Next() {
+			movie := &Movie{}
+			if err = rows.StructScan(movie); err != nil {
+				rows.Close()
+				return 0, fmt.Errorf("StructScan: %w", err)
+			}
+
+			movies = append(movies, movie)
+		}
+		rows.Close()
+
+		if len(movies) == 0 {
+			return count, nil
+		}
+
+		documents, err := movieDocuments(ctx, db, movies)
+		if err != nil {
+			return 0, fmt.Errorf("movieDocuments: %w", err)
+		}
+
+		batch := s.index.NewBatch()
+		for _, movie := range movies {
+			if err := batch.Index(documentID(movie.ID), documents[movie.ID]); err != nil {
+				return 0, fmt.Errorf("Batch.Index: %w", err)
+			}
+		}
+
+		if err := s.index.Batch(batch); err != nil {
+			return 0, fmt.Errorf("Batch: %w", err)
+		}
+
+		count += uint64(len(movies))
+		afterID = movies[len(movies)-1].ID
+	}
+}
+
+const findMoviePersonNamesQuery = `SELECT credits.movie_id, people.name
+FROM credits
+INNER JOIN people
+ON credits.person_id = people.id
+WHERE credits.movie_id IN (?)`
+
+const findMovieTagSlugsQuery = `SELECT DISTINCT movie_tags.movie_id, tags.slug
+FROM movie_tags
+INNER JOIN tags
+ON movie_tags.tag_id = tags.id
+WHERE movie_tags.movie_id IN (?)`
+
+// movieDocuments builds the documents of the movies with their translations, the names of the people credited in
+// them and their tags by movie id
+func movieDocuments(ctx context.Context, db *sqlx.DB, movies []*Movie) (map[uint64]*movieDocument, error) {
+	movieIDs := make([]uint64, len(movies))
+	documents := make(map[uint64]*movieDocument, len(movies))
+	for i, movie := range movies {
+		movieIDs[i] = movie.ID
+		documents[movie.ID] = newMovieDocument()
+		documents[movie.ID].addText(movie.OriginalLanguage, movie.OriginalTitle, movie.Overview)
+	}
+
+	if err := queryByMovieIDs(ctx, db, findMovieTranslationsByMovieIDsQuery, movieIDs, func(rows *sqlx.Rows) error {
+		translation := &MovieTranslation{}
+		if err := rows.StructScan(translation); err != nil {
+			return err
+		}
+
+		documents[translation.MovieID].addText(translation.Language, translation.Title, translation.Overview)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("translations: %w", err)
+	}
+
+	if err := queryByMovieIDs(ctx, db, findMoviePersonNamesQuery, movieIDs, func(rows *sqlx.Rows) error {
+		person := &MoviePersonName{}
+		if err := rows.StructScan(person); err != nil {
+			return err
+		}
+
+		documents[person.MovieID].People = append(documents[person.MovieID].People, person.Name)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("people: %w", err)
+	}
+
+	if err := queryByMovieIDs(ctx, db, findMovieTagSlugsQuery, movieIDs, func(rows *sqlx.Rows) error {
+		tag := &MovieTagSlug{}
+		if err := rows.StructScan(tag); err != nil {
+			return err
+		}
+
+		documents[tag.MovieID].Tags = append(documents[tag.MovieID].Tags, tag.Slug)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("tags: %w", err)
+	}
+
+	return documents, nil
+}
+
+// queryByMovieIDs runs the query whose "IN (?)" is expanded to the movie ids and scans every row
+func queryByMovieIDs(ctx context.Context, db *sqlx.DB, query string, movieIDs []uint64,
+	scan func(rows *sqlx.Rows) error) error {
+	query, args, err := sqlx.In(query, movieIDs)
+	if err != nil {
+		return fmt.Errorf("sqlx.In: %w", err)
+	}
+
+	rows, err := db.QueryxContext(ctx, db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			return fmt.Errorf("StructScan: %w", err)
+		}
+	}
+
+	return nil
+}
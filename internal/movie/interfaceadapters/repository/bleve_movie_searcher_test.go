@@ -0,0 +1,349 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBleveMovieSearcherSuite struct {
+	suite.Suite
+}
+
+func TestBleveMovieSearcherSuite(t *testing.T) {
+	suite.Run(t, &testBleveMovieSearcherSuite{})
+}
+
+var movieDocumentsTableRows []string = []string{"id", "original_title", "original_language", "overview"}
+var moviePersonNamesTableRows []string = []string{"movie_id", "name"}
+var movieTagSlugsTableRows []string = []string{"movie_id", "slug"}
+
+const movieDocumentsQuery = `SELECT id, original_title, original_language, overview
+FROM movies
+WHERE id > ? AND deleted_at IS NULL
+ORDER BY id ASC
+LIMIT ?`
+
+const movieDocumentQuery = `SELECT id, original_title, original_language, overview
+FROM movies
+WHERE id = ? AND deleted_at IS NULL`
+
+const movieTranslationsQuery = `SELECT movie_id, language, title, overview
+FROM movie_translations
+WHERE movie_id IN (`
+
+const moviePersonNamesQuery = `SELECT credits.movie_id, people.name
+FROM credits
+INNER JOIN people
+ON credits.person_id = people.id
+WHERE credits.movie_id IN (`
+
+const movieTagSlugsQuery = `SELECT DISTINCT movie_tags.movie_id, tags.slug
+FROM movie_tags
+INNER JOIN tags
+ON movie_tags.tag_id = tags.id
+WHERE movie_tags.movie_id IN (`
+
+var testBoosts = repository.MovieFieldBoosts{Title: 3, Overview: 1, Person: 2, Tag: 1.5}
+
+// expectCatalog expects the queries of IndexAll which read a catalog of six movies in one batch
+func expectCatalog(mock sqlmock.Sqlmock) {
+	mock.
+		ExpectQuery(regexp.QuoteMeta(movieDocumentsQuery)).
+		WithArgs(0, 500).
+		WillReturnRows(sqlmock.NewRows(movieDocumentsTableRows).
+			AddRow(1, "The Running Man", "en", "A convict runs for his life in a deadly game show.").
+			AddRow(2, "Alien", "en-US", "The crew of a spaceship meets a deadly creature.").
+			AddRow(3, "Les Choristes", "fr", "Un professeur de musique transforme la vie des enfants.").
+			AddRow(4, "Paul", "en", "Two comic book fans meet an alien on a road trip.").
+			AddRow(5, "Die Hard", "en", "A New York cop fights terrorists in a tower.").
+			AddRow(6, "Hard Target", "en", "A drifter protects a woman from hunters in New Orleans."))
+	mock.
+		ExpectQuery(regexp.QuoteMeta(movieTranslationsQuery)).
+		WithArgs(1, 2, 3, 4, 5, 6).
+		WillReturnRows(sqlmock.NewRows(movieTranslationsTableRows).
+			AddRow(2, "fr", "Alien, le huitième passager", "L'équipage d'un vaisseau rencontre des créatures mortelles.").
+			AddRow(3, "en", "The Chorus", nil))
+	mock.
+		ExpectQuery(regexp.QuoteMeta(moviePersonNamesQuery)).
+		WithArgs(1, 2, 3, 4, 5, 6).
+		WillReturnRows(sqlmock.NewRows(moviePersonNamesTableRows).
+			AddRow(1, "Arnold Schwarzenegger").
+			AddRow(2, "Sigourney Weaver"))
+	mock.
+		ExpectQuery(regexp.QuoteMeta(movieTagSlugsQuery)).
+		WithArgs(1, 2, 3, 4, 5, 6).
+		WillReturnRows(sqlmock.NewRows(movieTagSlugsTableRows).
+			AddRow(2, "space-horror").
+			AddRow(4, "road-movie"))
+	mock.
+		ExpectQuery(regexp.QuoteMeta(movieDocumentsQuery)).
+		WithArgs(6, 500).
+		WillReturnRows(sqlmock.NewRows(movieDocumentsTableRows))
+}
+
+func newMemOnlyMovieIndex(t *testing.T) bleve.Index {
+	t.Helper()
+	index, err := bleve.NewMemOnly(repository.NewMovieIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create the index: %v", err)
+	}
+
+	return index
+}
+
+// hitMovieIDs returns the movie ids of the hits of the search in their order
+func hitMovieIDs(t *testing.T, searcher usecaserepository.MovieSearcher, mockMovieRepository *mock_repository.MockMovieRepository,
+	keyword string) []uint64 {
+	t.Helper()
+	query, err := searchquery.Parse(keyword)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", keyword, err)
+	}
+
+	movieIDs := make([]uint64, 0)
+	mockMovieRepository.EXPECT().FindMovies(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args usecaserepository.FindMoviesParams) (*usecaserepository.MoviePage, error) {
+			assert.Nil(t, args.Query)
+			for _, hit := range args.Hits {
+				movieIDs = append(movieIDs, hit.MovieID)
+			}
+
+			return &usecaserepository.MoviePage{}, nil
+		})
+
+	if _, err := searcher.SearchMovies(context.Background(), usecaserepository.FindMoviesParams{Query: &query}); err != nil {
+		t.Fatalf("failed to search %q: %v", keyword, err)
+	}
+
+	return movieIDs
+}
+
+func (s *testBleveMovieSearcherSuite) TestSearchMovies() {
+	cases := []struct {
+		name     string
+		keyword  string
+		expected []uint64
+	}{
+		{
+			name:     "stems_words_by_language",
+			keyword:  "running",
+			expected: []uint64{1},
+		},
+		{
+			name:     "matches_translation_by_analyzer_of_its_language",
+			keyword:  "créature",
+			expected: []uint64{2},
+		},
+		{
+			name:     "ranks_match_in_title_before_match_in_overview",
+			keyword:  "alien",
+			expected: []uint64{2, 4},
+		},
+		{
+			name:     "matches_title_only_with_title_field",
+			keyword:  "title:alien",
+			expected: []uint64{2},
+		},
+		{
+			name:     "matches_people_and_tags",
+			keyword:  "weaver horror",
+			expected: []uint64{2},
+		},
+		{
+			name:     "leaves_out_excluded_term",
+			keyword:  "alien -weaver",
+			expected: []uint64{4},
+		},
+		{
+			name:     "matches_phrase_and_prefix",
+			keyword:  `"game show" schwarz*`,
+			expected: []uint64{1},
+		},
+		{
+			name:     "does_not_require_stop_word",
+			keyword:  "the chorus",
+			expected: []uint64{3},
+		},
+		{
+			name:     "requires_word_which_is_stop_word_of_other_language",
+			keyword:  "die hard",
+			expected: []uint64{5},
+		},
+		{
+			name:     "does_not_exclude_stop_word",
+			keyword:  "hard -the",
+			expected: []uint64{5, 6},
+		},
+		{
+			name:     "returns_no_hit",
+			keyword:  "predator",
+			expected: []uint64{},
+		},
+	}
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	manager, clean := initMockConnManager(s.T(), expectCatalog)
+	defer clean()
+
+	index := newMemOnlyMovieIndex(s.T())
+	defer index.Close()
+
+	mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+	searcher := repository.NewBleveMovieSearcher(manager, index, mockMovieRepository, 100, testBoosts)
+	count, err := searcher.IndexAll(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), count)
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, hitMovieIDs(t, searcher, mockMovieRepository, c.keyword))
+		})
+	}
+}
+
+func (s *testBleveMovieSearcherSuite) TestSearchMoviesWithDefaultMaxHitsAndBoosts() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	manager, clean := initMockConnManager(s.T(), expectCatalog)
+	defer clean()
+
+	index := newMemOnlyMovieIndex(s.T())
+	defer index.Close()
+
+	mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+	searcher := repository.NewBleveMovieSearcher(manager, index, mockMovieRepository, 0, repository.MovieFieldBoosts{})
+	_, err := searcher.IndexAll(context.Background())
+	s.Require().NoError(err)
+
+	assert.Equal(s.T(), []uint64{2, 4}, hitMovieIDs(s.T(), searcher, mockMovieRepository, "alien"))
+}
+
+func (s *testBleveMovieSearcherSuite) TestSearchMoviesWithoutQuery() {
+	type testInput struct {
+		args                usecaserepository.FindMoviesParams
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.MoviePage
+		err  error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "lists_movies_without_hits",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Page: usecaserepository.PageParams{Limit: 20}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindMovies(gomock.Any(), usecaserepository.FindMoviesParams{
+						Page: usecaserepository.PageParams{Limit: 20},
+					}).Return(&usecaserepository.MoviePage{}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{},
+			},
+		},
+		{
+			name: "returns_error_of_FindMovies",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Page: usecaserepository.PageParams{Limit: 20}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindMovies(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("movieRepository.FindMovies: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			index := newMemOnlyMovieIndex(t)
+			defer index.Close()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+
+			searcher := repository.NewBleveMovieSearcher(nil, index, mockMovieRepository, 100, testBoosts)
+			res, err := searcher.SearchMovies(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testBleveMovieSearcherSuite) TestIndexMovie() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	manager, clean := initMockConnManager(s.T(), func(mock sqlmock.Sqlmock) {
+		mock.
+			ExpectQuery(regexp.QuoteMeta(movieDocumentQuery)).
+			WithArgs(5).
+			WillReturnRows(sqlmock.NewRows(movieDocumentsTableRows).
+				AddRow(5, "Le Fabuleux Destin d'Amélie Poulain", "fr", utils.StringPtr("Amélie aide les gens autour d'elle.")))
+		mock.
+			ExpectQuery(regexp.QuoteMeta(movieTranslationsQuery)).
+			WithArgs(5).
+			WillReturnRows(sqlmock.NewRows(movieTranslationsTableRows).AddRow(5, "en", "Amelie", nil))
+		mock.
+			ExpectQuery(regexp.QuoteMeta(moviePersonNamesQuery)).
+			WithArgs(5).
+			WillReturnRows(sqlmock.NewRows(moviePersonNamesTableRows).AddRow(5, "Audrey Tautou"))
+		mock.
+			ExpectQuery(regexp.QuoteMeta(movieTagSlugsQuery)).
+			WithArgs(5).
+			WillReturnRows(sqlmock.NewRows(movieTagSlugsTableRows))
+		mock.
+			ExpectQuery(regexp.QuoteMeta(movieDocumentQuery)).
+			WithArgs(5).
+			WillReturnError(sql.ErrNoRows)
+		mock.
+			ExpectQuery(regexp.QuoteMeta(movieDocumentQuery)).
+			WithArgs(6).
+			WillReturnError(fmt.Errorf("dummy error"))
+	})
+	defer clean()
+
+	index := newMemOnlyMovieIndex(s.T())
+	defer index.Close()
+
+	mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+	searcher := repository.NewBleveMovieSearcher(manager, index, mockMovieRepository, 100, testBoosts)
+
+	s.Require().NoError(searcher.IndexMovie(context.Background(), 5))
+	assert.Equal(s.T(), []uint64{5}, hitMovieIDs(s.T(), searcher, mockMovieRepository, "tautou fabuleux"))
+
+	// the movie is deleted from the catalog
+	s.Require().NoError(searcher.IndexMovie(context.Background(), 5))
+	assert.Equal(s.T(), []uint64{}, hitMovieIDs(s.T(), searcher, mockMovieRepository, "tautou"))
+
+	err := searcher.IndexMovie(context.Background(), 6)
+	assert.Equal(s.T(), fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")), err)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type boxOfficeRepository struct {
+	connManager ConnManager
+}
+
+func NewBoxOfficeRepository(connManager ConnManager) *boxOfficeRepository {
+	return &boxOfficeRepository{connManager: connManager}
+}
+
+const findGrossesByMovieIDQuery = `SELECT id, movie_id, territory, week_start, gross, currency
+FROM box_office_grosses
+WHERE movie_id = ?
+ORDER BY territory ASC, week_start ASC, id ASC`
+
+func (r *boxOfficeRepository) FindGrossesByMovieID(ctx context.Context,
+	movieID uint64) ([]*entity.BoxOfficeGross, error) {
+	grosses := make([]*entity.BoxOfficeGross, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findGrossesByMovieIDQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		gross := &BoxOfficeGross{}
+		if err = rows.StructScan(gross); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		grosses = append(grosses, &entity.BoxOfficeGross{
+			ID:        gross.ID,
+			MovieID:   gross.MovieID,
+			Territory: gross.Territory,
+			WeekStart: gross.WeekStart,
+			Gross:     entity.Money{Amount: gross.Gross, Currency: gross.Currency},
+		})
+	}
+
+	return grosses, nil
+}
@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBoxOfficeRepositorySuite struct {
+	suite.Suite
+}
+
+func TestBoxOfficeRepositorySuite(t *testing.T) {
+	suite.Run(t, &testBoxOfficeRepositorySuite{})
+}
+
+func (s *testBoxOfficeRepositorySuite) TestFindGrossesByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		grosses []*entity.BoxOfficeGross
+		err     error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, movie_id, territory, week_start, gross, currency
+	FROM box_office_grosses
+	WHERE movie_id = ?
+	ORDER BY territory ASC, week_start ASC, id ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_grosses_of_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(boxOfficeGrossesTableRows)
+					rows.AddRow(4, 1, "JP", utils.MustRFC3339Time("2022-01-14T00:00:00+00:00"), 120000000, "JPY")
+					rows.AddRow(1, 1, "US", utils.MustRFC3339Time("2022-01-07T00:00:00+00:00"), 9813250000, "USD")
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				grosses: []*entity.BoxOfficeGross{
+					{
+						ID:        4,
+						MovieID:   1,
+						Territory: "JP",
+						WeekStart: utils.MustRFC3339Time("2022-01-14T00:00:00+00:00"),
+						Gross:     entity.Money{Amount: 120000000, Currency: "JPY"},
+					},
+					{
+						ID:        1,
+						MovieID:   1,
+						Territory: "US",
+						WeekStart: utils.MustRFC3339Time("2022-01-07T00:00:00+00:00"),
+						Gross:     entity.Money{Amount: 9813250000, Currency: "USD"},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_empty_when_movie_has_no_gross",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(boxOfficeGrossesTableRows))
+				},
+			},
+			expected: testOutput{
+				grosses: []*entity.BoxOfficeGross{},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			boxOfficeRepository := repository.NewBoxOfficeRepository(manager)
+
+			ctx := context.Background()
+			res, err := boxOfficeRepository.FindGrossesByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.grosses, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type collectionRepository struct {
+	connManager ConnManager
+}
+
+func NewCollectionRepository(connManager ConnManager) *collectionRepository {
+	return &collectionRepository{connManager: connManager}
+}
+
+const findCollectionByIDQuery = `SELECT id, name, overview, poster_path, created_at, updated_at FROM collections WHERE id = ?`
+
+func (r *collectionRepository) FindByID(ctx context.Context, collectionID uint64) (*entity.Collection, error) {
+	collection := &Collection{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findCollectionByIDQuery,
+		collectionID).StructScan(collection); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.Collection{
+		ID:         collection.ID,
+		Name:       collection.Name,
+		Overview:   collection.Overview,
+		PosterPath: collection.PosterPath,
+		CreatedAt:  collection.CreatedAt,
+		UpdatedAt:  collection.UpdatedAt,
+	}, nil
+}
+
+// findMoviesByCollectionIDQuery lists the movies of a collection, the %s is the condition of the cursor
+const findMoviesByCollectionIDQuery = `SELECT ` + movieColumns + `, collection_movies.position
+FROM collection_movies
+INNER JOIN movies
+ON collection_movies.movie_id = movies.id
+` + movieRatingStatsJoin + `
+WHERE collection_movies.collection_id = ? AND movies.deleted_at IS NULL%s
+ORDER BY collection_movies.position ASC
+LIMIT ?`
+
+const collectionMovieAfterCondition = `
+AND collection_movies.position > ?`
+
+func (r *collectionRepository) FindMoviesByCollectionID(ctx context.Context,
+	args repository.FindMoviesByCollectionIDParams) (*repository.CollectionMoviePage, error) {
+	after, queryArgs := "", []interface{}{args.CollectionID}
+	if args.After != nil {
+		after = collectionMovieAfterCondition
+		queryArgs = append(queryArgs, args.After.Position)
+	}
+	queryArgs = append(queryArgs, args.Limit+1)
+
+	collectionMovies := make([]*entity.CollectionMovie, 0)
+	movies := make([]*entity.Movie, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(findMoviesByCollectionIDQuery, after),
+		queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		collectionMovie := &CollectionMovie{}
+		if err = rows.StructScan(collectionMovie); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		movie := collectionMovie.toEntity()
+		movies = append(movies, movie)
+		collectionMovies = append(collectionMovies, &entity.CollectionMovie{
+			Position: collectionMovie.Position,
+			Movie:    movie,
+		})
+	}
+
+	// the movies are queried with one more movie than the limit so that the next page exists only when it is found
+	page := &repository.CollectionMoviePage{Movies: collectionMovies}
+	if uint(len(collectionMovies)) > args.Limit {
+		page.Movies, movies = collectionMovies[:args.Limit], movies[:args.Limit]
+		// a page without any movie has no movie to continue after
+		if args.Limit > 0 {
+			page.Next = &repository.CollectionMovieCursor{Position: page.Movies[len(page.Movies)-1].Position}
+		}
+	}
+
+	if err := attachGenres(ctx, r.connManager.GetReader(), movies); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
+	}
+
+	return page, nil
+}
+
+// findCollectionSummaryByMovieIDQuery counts only the movies which are not deleted
+const findCollectionSummaryByMovieIDQuery = `SELECT collections.id, collections.name, collection_movies.position,
+(SELECT COUNT(*) FROM collection_movies AS members INNER JOIN movies ON members.movie_id = movies.id
+WHERE members.collection_id = collections.id AND movies.deleted_at IS NULL) AS movie_count
+FROM collection_movies
+INNER JOIN collections
+ON collection_movies.collection_id = collections.id
+WHERE collection_movies.movie_id = ?`
+
+func (r *collectionRepository) FindSummaryByMovieID(ctx context.Context,
+	movieID uint64) (*entity.CollectionSummary, error) {
+	summary := &CollectionSummary{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findCollectionSummaryByMovieIDQuery,
+		movieID).StructScan(summary); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.CollectionSummary{
+		ID:         summary.ID,
+		Name:       summary.Name,
+		Position:   summary.Position,
+		MovieCount: summary.MovieCount,
+	}, nil
+}
@@ -0,0 +1,333 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCollectionRepositorySuite struct {
+	suite.Suite
+}
+
+func TestCollectionRepositorySuite(t *testing.T) {
+	suite.Run(t, &testCollectionRepositorySuite{})
+}
+
+func (s *testCollectionRepositorySuite) TestFindByID() {
+	type testInput struct {
+		collectionID uint64
+		mocks        func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		collection *entity.Collection
+		err        error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, name, overview, poster_path, created_at, updated_at FROM collections WHERE id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_collection_when_exist_record",
+			input: testInput{
+				collectionID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(collectionsTableRows)
+					rows.AddRow(1, "The Stranger Collection", "The stories of the strangers.", nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				collection: &entity.Collection{
+					ID:        1,
+					Name:      "The Stranger Collection",
+					Overview:  utils.StringPtr("The stories of the strangers."),
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_not_exist_record",
+			input: testInput{
+				collectionID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				collectionID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			collectionRepository := repository.NewCollectionRepository(manager)
+
+			ctx := context.Background()
+			res, err := collectionRepository.FindByID(ctx, c.input.collectionID)
+			assert.Equal(t, c.expected.collection, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCollectionRepositorySuite) TestFindMoviesByCollectionID() {
+	type testInput struct {
+		args  usecaserepository.FindMoviesByCollectionIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.CollectionMoviePage
+		err  error
+	}
+
+	query := regexp.QuoteMeta(`SELECT ` + movieColumnsQuery + `, collection_movies.position
+	FROM collection_movies
+	INNER JOIN movies
+	ON collection_movies.movie_id = movies.id
+	LEFT JOIN movie_rating_stats
+	ON movies.id = movie_rating_stats.movie_id
+	LEFT JOIN movie_critic_rating_stats
+	ON movies.id = movie_critic_rating_stats.movie_id
+	WHERE collection_movies.collection_id = ? AND movies.deleted_at IS NULL
+	ORDER BY collection_movies.position ASC
+	LIMIT ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_movies_ordered_by_position",
+			input: testInput{
+				args: usecaserepository.FindMoviesByCollectionIDParams{CollectionID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "position"))
+					rows.AddRow(3, "vitae", "Vietnam", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 1)
+					rows.AddRow(1, "accumsan sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 2)
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnRows(rows)
+					genreRows := sqlmock.NewRows(movieGenresTableRows)
+					genreRows.AddRow(1, 10, "Horror", "horror")
+					mock.ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).WithArgs(3, 1).WillReturnRows(genreRows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CollectionMoviePage{Movies: []*entity.CollectionMovie{
+					{
+						Position: 1,
+						Movie: &entity.Movie{
+							ID:               3,
+							OriginalTitle:    "vitae",
+							Title:            "vitae",
+							OriginalLanguage: "Vietnam",
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
+					},
+					{
+						Position: 2,
+						Movie: &entity.Movie{
+							ID:               1,
+							OriginalTitle:    "accumsan sed",
+							Title:            "accumsan sed",
+							OriginalLanguage: "Nigeria",
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{{ID: 10, Name: "Horror", Slug: "horror"}},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name: "returns_next_cursor_when_there_are_more_movies_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesByCollectionIDParams{CollectionID: 1, Limit: 1,
+					After: &usecaserepository.CollectionMovieCursor{Position: 1}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "position"))
+					rows.AddRow(1, "accumsan sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 2)
+					rows.AddRow(5, "nulla", "Japan", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 3)
+					mock.ExpectQuery(regexp.QuoteMeta(`WHERE collection_movies.collection_id = ? AND movies.deleted_at IS NULL
+					AND collection_movies.position > ?
+					ORDER BY collection_movies.position ASC
+					LIMIT ?`)).WithArgs(1, 1, 2).WillReturnRows(rows)
+					mock.ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CollectionMoviePage{
+					Movies: []*entity.CollectionMovie{
+						{
+							Position: 2,
+							Movie: &entity.Movie{
+								ID:               1,
+								OriginalTitle:    "accumsan sed",
+								Title:            "accumsan sed",
+								OriginalLanguage: "Nigeria",
+								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+								UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+								Genres:           []entity.Genre{},
+							},
+						},
+					},
+					Next: &usecaserepository.CollectionMovieCursor{Position: 2},
+				},
+			},
+		},
+		{
+			name: "returns_empty_when_collection_has_no_movie",
+			input: testInput{
+				args: usecaserepository.FindMoviesByCollectionIDParams{CollectionID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnRows(sqlmock.NewRows(append(moviesTableRows, "position")))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CollectionMoviePage{Movies: []*entity.CollectionMovie{}},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindMoviesByCollectionIDParams{CollectionID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			collectionRepository := repository.NewCollectionRepository(manager)
+
+			ctx := context.Background()
+			res, err := collectionRepository.FindMoviesByCollectionID(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCollectionRepositorySuite) TestFindSummaryByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		summary *entity.CollectionSummary
+		err     error
+	}
+
+	query := regexp.QuoteMeta(`SELECT collections.id, collections.name, collection_movies.position,
+	(SELECT COUNT(*) FROM collection_movies AS members INNER JOIN movies ON members.movie_id = movies.id
+	WHERE members.collection_id = collections.id AND movies.deleted_at IS NULL) AS movie_count
+	FROM collection_movies
+	INNER JOIN collections
+	ON collection_movies.collection_id = collections.id
+	WHERE collection_movies.movie_id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_summary_when_movie_belongs_to_collection",
+			input: testInput{
+				movieID: 3,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(collectionSummariesTableRows)
+					rows.AddRow(1, "The Stranger Collection", 2, 2)
+					mock.ExpectQuery(query).WithArgs(3).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				summary: &entity.CollectionSummary{ID: 1, Name: "The Stranger Collection", Position: 2, MovieCount: 2},
+			},
+		},
+		{
+			name: "returns_nil_when_movie_belongs_to_no_collection",
+			input: testInput{
+				movieID: 3,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(3).WillReturnError(sql.ErrNoRows)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 3,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(3).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			collectionRepository := repository.NewCollectionRepository(manager)
+
+			ctx := context.Background()
+			res, err := collectionRepository.FindSummaryByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.summary, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type commentRepository struct {
+	connManager ConnManager
+}
+
+func NewCommentRepository(connManager ConnManager) *commentRepository {
+	return &commentRepository{connManager: connManager}
+}
+
+// commentColumns are the columns which are needed to build an entity.Comment, the query using them has to
+// join users
+const commentColumns = `review_comments.id, review_comments.review_id, review_comments.user_id, users.username,
+review_comments.parent_id, review_comments.depth, review_comments.content, review_comments.moderation_status,
+review_comments.deleted_at, review_comments.created_at, review_comments.updated_at,
+(SELECT COUNT(*) FROM review_comments AS replies
+WHERE replies.parent_id = review_comments.id AND replies.moderation_status = 'visible') AS reply_count`
+
+const createCommentQuery = `INSERT INTO review_comments(review_id, user_id, parent_id, depth, content, moderation_status)
+VALUES (?,?,?,?,?,?)`
+
+func (r *commentRepository) CreateComment(ctx context.Context, args repository.CreateCommentParams) (*entity.Comment, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, createCommentQuery, args.ReviewID, args.UserID, args.ParentID,
+		args.Depth, args.Content, args.ModerationStatus)
+	if err != nil {
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	createdCommentID, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("LastInsertId: %w", err)
+	}
+
+	comment := &Comment{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findCommentByIDQuery, createdCommentID).StructScan(comment); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return comment.toEntity(), nil
+}
+
+const findCommentByIDQuery = `SELECT ` + commentColumns + `
+FROM review_comments
+INNER JOIN users
+ON review_comments.user_id = users.id
+WHERE review_comments.id = ?`
+
+func (r *commentRepository) FindByID(ctx context.Context, commentID uint64) (*entity.Comment, error) {
+	comment := &Comment{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findCommentByIDQuery, commentID).StructScan(comment); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return comment.toEntity(), nil
+}
+
+// findCommentsOfReviewQuery lists the comments on a review, the %s is the condition of the cursor
+const findCommentsOfReviewQuery = `SELECT ` + commentColumns + `
+FROM review_comments
+INNER JOIN users
+ON review_comments.user_id = users.id
+WHERE review_comments.review_id = ? AND review_comments.parent_id IS NULL
+AND review_comments.moderation_status = 'visible'%s
+ORDER BY review_comments.id ASC
+LIMIT ?`
+
+// findRepliesOfCommentQuery lists the replies to a comment, the %s is the condition of the cursor
+const findRepliesOfCommentQuery = `SELECT ` + commentColumns + `
+FROM review_comments
+INNER JOIN users
+ON review_comments.user_id = users.id
+WHERE review_comments.review_id = ? AND review_comments.parent_id = ?
+AND review_comments.moderation_status = 'visible'%s
+ORDER BY review_comments.id ASC
+LIMIT ?`
+
+const commentAfterCondition = `
+AND review_comments.id > ?`
+
+func (r *commentRepository) FindComments(ctx context.Context, args repository.FindCommentsParams) (*repository.CommentPage, error) {
+	query, queryArgs := findCommentsOfReviewQuery, []interface{}{args.ReviewID}
+	if args.ParentID != nil {
+		query, queryArgs = findRepliesOfCommentQuery, []interface{}{args.ReviewID, *args.ParentID}
+	}
+
+	after := ""
+	if args.After != nil {
+		after = commentAfterCondition
+		queryArgs = append(queryArgs, args.After.ID)
+	}
+	queryArgs = append(queryArgs, args.Limit+1)
+
+	comments := make([]*entity.Comment, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(query, after), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		comment := &Comment{}
+		if err = rows.StructScan(comment); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		comments = append(comments, comment.toEntity())
+	}
+
+	// the list is queried with one more comment than the limit so that the next page exists only when it is found
+	if uint(len(comments)) <= args.Limit {
+		return &repository.CommentPage{Comments: comments}, nil
+	}
+
+	comments = comments[:args.Limit]
+	if args.Limit == 0 {
+		// a page without any comment has no comment to continue after
+		return &repository.CommentPage{Comments: comments}, nil
+	}
+
+	return &repository.CommentPage{Comments: comments,
+		Next: &repository.CommentCursor{ID: comments[len(comments)-1].ID}}, nil
+}
+
+const updateCommentQuery = `UPDATE review_comments SET content = ?, moderation_status = ? WHERE id = ?`
+
+func (r *commentRepository) UpdateComment(ctx context.Context, args repository.UpdateCommentParams) error {
+	_, err := r.connManager.GetWriter().ExecContext(ctx, updateCommentQuery, args.Content, args.ModerationStatus, args.ID)
+	if err != nil {
+		return fmt.Errorf("ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+const deleteCommentQuery = `UPDATE review_comments SET content = '', deleted_at = CURRENT_TIMESTAMP WHERE id = ?`
+
+func (r *commentRepository) DeleteComment(ctx context.Context, commentID uint64) error {
+	_, err := r.connManager.GetWriter().ExecContext(ctx, deleteCommentQuery, commentID)
+	if err != nil {
+		return fmt.Errorf("ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Comment) toEntity() *entity.Comment {
+	return &entity.Comment{
+		ID:               c.ID,
+		ReviewID:         c.ReviewID,
+		UserID:           c.UserID,
+		Username:         c.Username,
+		ParentID:         c.ParentID,
+		Depth:            c.Depth,
+		Content:          c.Content,
+		ModerationStatus: entity.ModerationStatus(c.ModerationStatus),
+		ReplyCount:       c.ReplyCount,
+		Deleted:          c.DeletedAt != nil,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
+	}
+}
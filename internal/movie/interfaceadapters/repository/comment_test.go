@@ -0,0 +1,404 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCommentRepositorySuite struct {
+	suite.Suite
+}
+
+func TestCommentRepositorySuite(t *testing.T) {
+	suite.Run(t, &testCommentRepositorySuite{})
+}
+
+func (s *testCommentRepositorySuite) TestCreateComment() {
+	type testInput struct {
+		args  usecaserepository.CreateCommentParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		comment *entity.Comment
+		err     error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_reply_when_insert_successfully",
+			input: testInput{
+				args: usecaserepository.CreateCommentParams{
+					ReviewID:         5,
+					UserID:           2,
+					ParentID:         utils.Uint64Ptr(7),
+					Depth:            2,
+					Content:          "totally agree",
+					ModerationStatus: entity.ModerationStatusVisible,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO review_comments(review_id, user_id, parent_id, depth, content, moderation_status)
+VALUES (?,?,?,?,?,?)`)).
+						WithArgs(5, 2, 7, 2, "totally agree", "visible").
+						WillReturnResult(sqlmock.NewResult(8, 1))
+
+					rows := sqlmock.NewRows(commentsTableRows)
+					rows.AddRow(8, 5, 2, "otheruser", 7, 2, "totally agree", "visible", nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 0)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + commentColumnsQuery + `
+						FROM review_comments
+						INNER JOIN users
+						ON review_comments.user_id = users.id
+						WHERE review_comments.id = ?`)).
+						WithArgs(8).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				comment: &entity.Comment{
+					ID:               8,
+					ReviewID:         5,
+					UserID:           2,
+					Username:         "otheruser",
+					ParentID:         utils.Uint64Ptr(7),
+					Depth:            2,
+					Content:          "totally agree",
+					ModerationStatus: entity.ModerationStatusVisible,
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_error_when_insert_failed",
+			input: testInput{
+				args: usecaserepository.CreateCommentParams{ReviewID: 5, UserID: 2, Depth: 1, Content: "nice",
+					ModerationStatus: entity.ModerationStatusPending},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO review_comments")).
+						WithArgs(5, 2, nil, 1, "nice", "pending").
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			commentRepository := repository.NewCommentRepository(manager)
+
+			ctx := context.Background()
+			res, err := commentRepository.CreateComment(ctx, c.input.args)
+			assert.Equal(t, c.expected.comment, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCommentRepositorySuite) TestFindByID() {
+	type testInput struct {
+		commentID uint64
+		mocks     func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		comment *entity.Comment
+		err     error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_deleted_comment_with_replies",
+			input: testInput{
+				commentID: 7,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(commentsTableRows)
+					rows.AddRow(7, 5, 1, "testuser", nil, 1, "", "visible", utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"), 3)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE review_comments.id = ?`)).
+						WithArgs(7).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				comment: &entity.Comment{
+					ID:               7,
+					ReviewID:         5,
+					UserID:           1,
+					Username:         "testuser",
+					Depth:            1,
+					ModerationStatus: entity.ModerationStatusVisible,
+					ReplyCount:       3,
+					Deleted:          true,
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_there_is_no_record",
+			input: testInput{
+				commentID: 7,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE review_comments.id = ?`)).
+						WithArgs(7).
+						WillReturnRows(sqlmock.NewRows(commentsTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			commentRepository := repository.NewCommentRepository(manager)
+
+			ctx := context.Background()
+			res, err := commentRepository.FindByID(ctx, c.input.commentID)
+			assert.Equal(t, c.expected.comment, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCommentRepositorySuite) TestFindComments() {
+	type testInput struct {
+		args  usecaserepository.FindCommentsParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.CommentPage
+		err  error
+	}
+
+	comment := func(id uint64) *entity.Comment {
+		return &entity.Comment{
+			ID:               id,
+			ReviewID:         5,
+			UserID:           1,
+			Username:         "testuser",
+			Depth:            1,
+			Content:          "nice review",
+			ModerationStatus: entity.ModerationStatusVisible,
+			ReplyCount:       1,
+			CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		}
+	}
+
+	addComment := func(rows *sqlmock.Rows, id uint64) *sqlmock.Rows {
+		return rows.AddRow(id, 5, 1, "testuser", nil, 1, "nice review", "visible", nil,
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 1)
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_first_page_of_comments_of_review_when_parent_is_nil",
+			input: testInput{
+				args: usecaserepository.FindCommentsParams{ReviewID: 5, Limit: 1},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(commentsTableRows)
+					addComment(rows, 7)
+					addComment(rows, 8)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE review_comments.review_id = ? AND review_comments.parent_id IS NULL
+						AND review_comments.moderation_status = 'visible'
+						ORDER BY review_comments.id ASC
+						LIMIT ?`)).
+						WithArgs(5, 2).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CommentPage{
+					Comments: []*entity.Comment{comment(7)},
+					Next:     &usecaserepository.CommentCursor{ID: 7},
+				},
+			},
+		},
+		{
+			name: "returns_last_page_of_comments_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindCommentsParams{ReviewID: 5, Limit: 1,
+					After: &usecaserepository.CommentCursor{ID: 7}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`AND review_comments.moderation_status = 'visible'
+						AND review_comments.id > ?
+						ORDER BY review_comments.id ASC
+						LIMIT ?`)).
+						WithArgs(5, 7, 2).
+						WillReturnRows(addComment(sqlmock.NewRows(commentsTableRows), 8))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CommentPage{
+					Comments: []*entity.Comment{comment(8)},
+				},
+			},
+		},
+		{
+			name: "returns_replies_of_comment_when_parent_is_given",
+			input: testInput{
+				args: usecaserepository.FindCommentsParams{ReviewID: 5, ParentID: utils.Uint64Ptr(7), Limit: 20,
+					After: &usecaserepository.CommentCursor{ID: 9}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE review_comments.review_id = ? AND review_comments.parent_id = ?
+						AND review_comments.moderation_status = 'visible'
+						AND review_comments.id > ?
+						ORDER BY review_comments.id ASC
+						LIMIT ?`)).
+						WithArgs(5, 7, 9, 21).
+						WillReturnRows(sqlmock.NewRows(commentsTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CommentPage{Comments: []*entity.Comment{}},
+			},
+		},
+		{
+			name: "returns_empty_page_without_next_when_limit_is_zero",
+			input: testInput{
+				args: usecaserepository.FindCommentsParams{ReviewID: 5},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM review_comments`)).
+						WithArgs(5, 1).
+						WillReturnRows(addComment(sqlmock.NewRows(commentsTableRows), 7))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.CommentPage{Comments: []*entity.Comment{}},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindCommentsParams{ReviewID: 5, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM review_comments`)).
+						WithArgs(5, 21).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			commentRepository := repository.NewCommentRepository(manager)
+
+			ctx := context.Background()
+			res, err := commentRepository.FindComments(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCommentRepositorySuite) TestDeleteComment() {
+	type testInput struct {
+		commentID uint64
+		mocks     func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "clears_content_and_marks_comment_deleted",
+			input: testInput{
+				commentID: 7,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("UPDATE review_comments SET content = '', deleted_at = CURRENT_TIMESTAMP WHERE id = ?")).
+						WithArgs(7).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_update_failed",
+			input: testInput{
+				commentID: 7,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("UPDATE review_comments SET content = ''")).
+						WithArgs(7).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			commentRepository := repository.NewCommentRepository(manager)
+
+			ctx := context.Background()
+			err := commentRepository.DeleteComment(ctx, c.input.commentID)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type creditRepository struct {
+	connManager ConnManager
+}
+
+func NewCreditRepository(connManager ConnManager) *creditRepository {
+	return &creditRepository{connManager: connManager}
+}
+
+const findCreditsByMovieIDQuery = `SELECT credits.id, credits.movie_id, credits.person_id, people.name AS person_name,
+people.profile_path, credits.role, credits.department, credits.character_name, credits.billing_order
+FROM credits
+INNER JOIN people
+ON credits.person_id = people.id
+WHERE credits.movie_id = ?
+ORDER BY credits.billing_order ASC, credits.id ASC`
+
+func (r *creditRepository) FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.Credit, error) {
+	credits := make([]*entity.Credit, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findCreditsByMovieIDQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		credit := &Credit{}
+		if err = rows.StructScan(credit); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		credits = append(credits, credit.toEntity())
+	}
+
+	return credits, nil
+}
+
+// findFilmographyByPersonIDQuery lists the credits of a person, the %s is the condition of the cursor
+const findFilmographyByPersonIDQuery = `SELECT credits.id, credits.movie_id, movies.original_title, movies.poster_path,
+movies.release_date, movies.release_date_precision, credits.role, credits.department, credits.character_name,
+credits.billing_order
+FROM credits
+INNER JOIN movies
+ON credits.movie_id = movies.id
+WHERE credits.person_id = ? AND movies.deleted_at IS NULL%s
+ORDER BY ` + movieReleaseDateDescOrder + `, movies.id ASC, credits.billing_order ASC, credits.id ASC
+LIMIT ?`
+
+// creditAfterCondition continues the filmography after the credit of the cursor among the movies of the same
+// release date
+const creditAfterCondition = `(movies.id > ? OR (movies.id = ? AND (credits.billing_order > ? OR ` +
+	`(credits.billing_order = ? AND credits.id > ?))))`
+
+// filmographyAfter returns the condition which continues the filmography after the credit of the cursor, the credits
+// whose movie has no release date come last
+func filmographyAfter(after *repository.FilmographyCursor) (string, []interface{}, error) {
+	creditArgs := []interface{}{after.MovieID, after.MovieID, after.BillingOrder, after.BillingOrder, after.ID}
+	if after.ReleaseDate == nil {
+		return "\nAND movies.release_date IS NULL AND " + creditAfterCondition, creditArgs, nil
+	}
+
+	if after.ReleaseDatePrecision == nil {
+		return "", nil, ErrCursorWithoutSortValue
+	}
+
+	rank, ok := datePrecisionRanks[*after.ReleaseDatePrecision]
+	if !ok {
+		return "", nil, ErrCursorWithoutSortValue
+	}
+
+	date := dateValue(after.ReleaseDate)
+	condition := "\nAND (movies.release_date < ? OR (movies.release_date = ? AND " +
+		"((movies.release_date_precision + 0) < ? OR ((movies.release_date_precision + 0) = ? AND " +
+		creditAfterCondition + "))) OR movies.release_date IS NULL)"
+
+	return condition, append([]interface{}{date, date, rank, rank}, creditArgs...), nil
+}
+
+func (r *creditRepository) FindFilmographyByPersonID(ctx context.Context,
+	args repository.FindFilmographyParams) (*repository.FilmographyPage, error) {
+	after, queryArgs := "", []interface{}{args.PersonID}
+	if args.After != nil {
+		condition, afterArgs, err := filmographyAfter(args.After)
+		if err != nil {
+			return nil, err
+		}
+
+		after, queryArgs = condition, append(queryArgs, afterArgs...)
+	}
+	queryArgs = append(queryArgs, args.Limit+1)
+
+	credits := make([]*entity.FilmographyCredit, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(findFilmographyByPersonIDQuery, after),
+		queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		credit := &FilmographyCredit{}
+		if err = rows.StructScan(credit); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		credits = append(credits, &entity.FilmographyCredit{
+			ID:                   credit.ID,
+			MovieID:              credit.MovieID,
+			OriginalTitle:        credit.OriginalTitle,
+			PosterPath:           credit.PosterPath,
+			ReleaseDate:          datePtr(credit.ReleaseDate),
+			ReleaseDatePrecision: credit.ReleaseDatePrecision,
+			Role:                 credit.Role,
+			Department:           credit.Department,
+			CharacterName:        credit.CharacterName,
+			BillingOrder:         credit.BillingOrder,
+		})
+	}
+
+	// the filmography is queried with one more credit than the limit so that the next page exists only when it is found
+	if uint(len(credits)) <= args.Limit {
+		return &repository.FilmographyPage{Credits: credits}, nil
+	}
+
+	credits = credits[:args.Limit]
+	if args.Limit == 0 {
+		// a page without any credit has no credit to continue after
+		return &repository.FilmographyPage{Credits: credits}, nil
+	}
+
+	last := credits[len(credits)-1]
+	return &repository.FilmographyPage{Credits: credits, Next: &repository.FilmographyCursor{
+		ID:                   last.ID,
+		MovieID:              last.MovieID,
+		ReleaseDate:          last.ReleaseDate,
+		ReleaseDatePrecision: last.ReleaseDatePrecision,
+		BillingOrder:         last.BillingOrder,
+	}}, nil
+}
+
+func (c *Credit) toEntity() *entity.Credit {
+	return &entity.Credit{
+		ID:            c.ID,
+		MovieID:       c.MovieID,
+		PersonID:      c.PersonID,
+		PersonName:    c.PersonName,
+		ProfilePath:   c.ProfilePath,
+		Role:          c.Role,
+		Department:    c.Department,
+		CharacterName: c.CharacterName,
+		BillingOrder:  c.BillingOrder,
+	}
+}
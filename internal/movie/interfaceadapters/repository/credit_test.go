@@ -0,0 +1,286 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCreditRepositorySuite struct {
+	suite.Suite
+}
+
+func TestCreditRepositorySuite(t *testing.T) {
+	suite.Run(t, &testCreditRepositorySuite{})
+}
+
+func (s *testCreditRepositorySuite) TestFindByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		credits []*entity.Credit
+		err     error
+	}
+
+	query := regexp.QuoteMeta(`SELECT credits.id, credits.movie_id, credits.person_id, people.name AS person_name,
+	people.profile_path, credits.role, credits.department, credits.character_name, credits.billing_order
+	FROM credits
+	INNER JOIN people
+	ON credits.person_id = people.id
+	WHERE credits.movie_id = ?
+	ORDER BY credits.billing_order ASC, credits.id ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_credits_of_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(creditsTableRows)
+					rows.AddRow(1, 1, 1, "Christopher Nolan", nil, "Director", "Directing", nil, 0)
+					rows.AddRow(2, 1, 2, "Emma Thompson", "/emma.jpg", "Actor", "Acting", "Mara", 0)
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				credits: []*entity.Credit{
+					{
+						ID:         1,
+						MovieID:    1,
+						PersonID:   1,
+						PersonName: "Christopher Nolan",
+						Role:       "Director",
+						Department: "Directing",
+					},
+					{
+						ID:            2,
+						MovieID:       1,
+						PersonID:      2,
+						PersonName:    "Emma Thompson",
+						ProfilePath:   utils.StringPtr("/emma.jpg"),
+						Role:          "Actor",
+						Department:    "Acting",
+						CharacterName: utils.StringPtr("Mara"),
+					},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			creditRepository := repository.NewCreditRepository(manager)
+
+			ctx := context.Background()
+			res, err := creditRepository.FindByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.credits, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testCreditRepositorySuite) TestFindFilmographyByPersonID() {
+	type testInput struct {
+		args  usecaserepository.FindFilmographyParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.FilmographyPage
+		err  error
+	}
+
+	query := regexp.QuoteMeta(`SELECT credits.id, credits.movie_id, movies.original_title, movies.poster_path,
+	movies.release_date, movies.release_date_precision, credits.role, credits.department, credits.character_name,
+	credits.billing_order
+	FROM credits
+	INNER JOIN movies
+	ON credits.movie_id = movies.id
+	WHERE credits.person_id = ? AND movies.deleted_at IS NULL
+	ORDER BY movies.release_date DESC, movies.release_date_precision DESC, movies.id ASC, credits.billing_order ASC, credits.id ASC
+	LIMIT ?`)
+
+	creditAfter := `(movies.id > ? OR (movies.id = ? AND (credits.billing_order > ? OR
+	(credits.billing_order = ? AND credits.id > ?))))`
+
+	filmographyRows := func() *sqlmock.Rows {
+		rows := sqlmock.NewRows(filmographyTableRows)
+		rows.AddRow(5, 3, "semper pretium neque.", nil, utils.MustRFC3339Time("2023-04-24T00:00:00+00:00"),
+			"day", "Director", "Directing", nil, 0)
+		rows.AddRow(6, 3, "semper pretium neque.", nil, utils.MustRFC3339Time("2023-04-24T00:00:00+00:00"),
+			"day", "Screenplay", "Writing", nil, 1)
+		rows.AddRow(1, 1, "accumsan sed, facilisis vitae,", "/poster.jpg", nil, nil, "Director", "Directing",
+			nil, 0)
+		return rows
+	}
+
+	credits := []*entity.FilmographyCredit{
+		{
+			ID:                   5,
+			MovieID:              3,
+			OriginalTitle:        "semper pretium neque.",
+			ReleaseDate:          datePtr("2023-04-24"),
+			ReleaseDatePrecision: utils.StringPtr("day"),
+			Role:                 "Director",
+			Department:           "Directing",
+		},
+		{
+			ID:                   6,
+			MovieID:              3,
+			OriginalTitle:        "semper pretium neque.",
+			ReleaseDate:          datePtr("2023-04-24"),
+			ReleaseDatePrecision: utils.StringPtr("day"),
+			Role:                 "Screenplay",
+			Department:           "Writing",
+			BillingOrder:         1,
+		},
+		{
+			ID:            1,
+			MovieID:       1,
+			OriginalTitle: "accumsan sed, facilisis vitae,",
+			PosterPath:    utils.StringPtr("/poster.jpg"),
+			Role:          "Director",
+			Department:    "Directing",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_filmography_of_person",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnRows(filmographyRows())
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.FilmographyPage{Credits: credits},
+			},
+		},
+		{
+			name: "returns_next_cursor_at_credit_of_last_movie_when_there_are_more_credits",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 2},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 3).WillReturnRows(filmographyRows())
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.FilmographyPage{
+					Credits: credits[:2],
+					Next: &usecaserepository.FilmographyCursor{ID: 6, MovieID: 3, ReleaseDate: datePtr("2023-04-24"),
+						ReleaseDatePrecision: utils.StringPtr("day"), BillingOrder: 1},
+				},
+			},
+		},
+		{
+			name: "continues_after_cursor_of_release_date",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 20,
+					After: &usecaserepository.FilmographyCursor{ID: 6, MovieID: 3, ReleaseDate: datePtr("2023-04-24"),
+						ReleaseDatePrecision: utils.StringPtr("day"), BillingOrder: 1}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(regexp.QuoteMeta(`WHERE credits.person_id = ? AND movies.deleted_at IS NULL
+					AND (movies.release_date < ? OR (movies.release_date = ? AND
+					((movies.release_date_precision + 0) < ? OR ((movies.release_date_precision + 0) = ? AND `+
+						creditAfter+`))) OR movies.release_date IS NULL)
+					ORDER BY`)).
+						WithArgs(1, "2023-04-24", "2023-04-24", 3, 3, 3, 3, 1, 1, 6, 21).
+						WillReturnRows(sqlmock.NewRows(filmographyTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.FilmographyPage{Credits: []*entity.FilmographyCredit{}},
+			},
+		},
+		{
+			name: "continues_after_cursor_without_release_date",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 20,
+					After: &usecaserepository.FilmographyCursor{ID: 1, MovieID: 1}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(regexp.QuoteMeta(`WHERE credits.person_id = ? AND movies.deleted_at IS NULL
+					AND movies.release_date IS NULL AND `+creditAfter+`
+					ORDER BY`)).
+						WithArgs(1, 1, 1, 0, 0, 1, 21).
+						WillReturnRows(sqlmock.NewRows(filmographyTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.FilmographyPage{Credits: []*entity.FilmographyCredit{}},
+			},
+		},
+		{
+			name: "returns_error_when_release_date_cursor_has_no_precision",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 20,
+					After: &usecaserepository.FilmographyCursor{ID: 6, MovieID: 3, ReleaseDate: datePtr("2023-04-24")}},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrCursorWithoutSortValue,
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindFilmographyParams{PersonID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			creditRepository := repository.NewCreditRepository(manager)
+
+			ctx := context.Background()
+			res, err := creditRepository.FindFilmographyByPersonID(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
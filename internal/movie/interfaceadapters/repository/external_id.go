@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type externalIDRepository struct {
+	connManager ConnManager
+}
+
+func NewExternalIDRepository(connManager ConnManager) *externalIDRepository {
+	return &externalIDRepository{connManager: connManager}
+}
+
+const findExternalIDsByMovieIDQuery = `SELECT movie_id, source, external_id FROM movie_external_ids WHERE movie_id = ?`
+
+func (r *externalIDRepository) FindByMovieID(ctx context.Context, movieID uint64) (entity.ExternalIDs, error) {
+	externalIDs := make(entity.ExternalIDs)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findExternalIDsByMovieIDQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		externalID := &ExternalID{}
+		if err = rows.StructScan(externalID); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		externalIDs[externalID.Source] = externalID.ExternalID
+	}
+
+	return externalIDs, nil
+}
+
+const findMovieIDByExternalIDQuery = `SELECT movie_id FROM movie_external_ids WHERE source = ? AND external_id = ?`
+
+func (r *externalIDRepository) FindMovieIDByExternalID(ctx context.Context,
+	args repository.FindMovieIDByExternalIDParams) (uint64, error) {
+	var movieID uint64
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findMovieIDByExternalIDQuery, args.Source,
+		args.ExternalID).Scan(&movieID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return movieID, nil
+}
+
+const deleteExternalIDQuery = `DELETE FROM movie_external_ids WHERE movie_id = ? AND source = ?`
+
+const insertExternalIDQuery = `INSERT INTO movie_external_ids(movie_id, source, external_id) VALUES (?,?,?)`
+
+// SetExternalID deletes the current id before inserting the new one, so the insert fails instead of touching the
+// id of another movie when the external id is already used
+func (r *externalIDRepository) SetExternalID(ctx context.Context, args repository.SetExternalIDParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, deleteExternalIDQuery, args.MovieID, args.Source); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, insertExternalIDQuery, args.MovieID, args.Source, args.ExternalID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
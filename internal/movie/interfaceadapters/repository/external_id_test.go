@@ -0,0 +1,230 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testExternalIDRepositorySuite struct {
+	suite.Suite
+}
+
+func TestExternalIDRepositorySuite(t *testing.T) {
+	suite.Run(t, &testExternalIDRepositorySuite{})
+}
+
+func (s *testExternalIDRepositorySuite) TestFindByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		externalIDs entity.ExternalIDs
+		err         error
+	}
+
+	query := regexp.QuoteMeta(`SELECT movie_id, source, external_id FROM movie_external_ids WHERE movie_id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_external_ids_keyed_by_source",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(externalIDsTableRows)
+					rows.AddRow(1, "imdb", "tt0111161")
+					rows.AddRow(1, "tmdb", "278")
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				externalIDs: entity.ExternalIDs{"imdb": "tt0111161", "tmdb": "278"},
+			},
+		},
+		{
+			name: "returns_empty_when_movie_has_no_external_id",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(externalIDsTableRows))
+				},
+			},
+			expected: testOutput{
+				externalIDs: entity.ExternalIDs{},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			externalIDRepository := repository.NewExternalIDRepository(manager)
+
+			ctx := context.Background()
+			res, err := externalIDRepository.FindByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.externalIDs, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testExternalIDRepositorySuite) TestFindMovieIDByExternalID() {
+	type testInput struct {
+		args  usecaserepository.FindMovieIDByExternalIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		movieID uint64
+		err     error
+	}
+
+	query := regexp.QuoteMeta(`SELECT movie_id FROM movie_external_ids WHERE source = ? AND external_id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_movie_id_when_exist_record",
+			input: testInput{
+				args: usecaserepository.FindMovieIDByExternalIDParams{Source: "imdb", ExternalID: "tt0111161"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs("imdb", "tt0111161").
+						WillReturnRows(sqlmock.NewRows([]string{"movie_id"}).AddRow(1))
+				},
+			},
+			expected: testOutput{
+				movieID: 1,
+			},
+		},
+		{
+			name: "returns_zero_when_not_exist_record",
+			input: testInput{
+				args: usecaserepository.FindMovieIDByExternalIDParams{Source: "imdb", ExternalID: "tt0111161"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs("imdb", "tt0111161").WillReturnError(sql.ErrNoRows)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindMovieIDByExternalIDParams{Source: "imdb", ExternalID: "tt0111161"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs("imdb", "tt0111161").WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			externalIDRepository := repository.NewExternalIDRepository(manager)
+
+			ctx := context.Background()
+			res, err := externalIDRepository.FindMovieIDByExternalID(ctx, c.input.args)
+			assert.Equal(t, c.expected.movieID, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testExternalIDRepositorySuite) TestSetExternalID() {
+	type testInput struct {
+		args  usecaserepository.SetExternalIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM movie_external_ids WHERE movie_id = ? AND source = ?`)
+	insertQuery := regexp.QuoteMeta(`INSERT INTO movie_external_ids(movie_id, source, external_id) VALUES (?,?,?)`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "replaces_external_id_of_source",
+			input: testInput{
+				args: usecaserepository.SetExternalIDParams{MovieID: 1, Source: "imdb", ExternalID: "tt0111161"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectExec(deleteQuery).WithArgs(1, "imdb").WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(insertQuery).WithArgs(1, "imdb", "tt0111161").WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "rolls_back_when_insert_failed",
+			input: testInput{
+				args: usecaserepository.SetExternalIDParams{MovieID: 1, Source: "imdb", ExternalID: "tt0111161"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectExec(deleteQuery).WithArgs(1, "imdb").WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(insertQuery).WithArgs(1, "imdb", "tt0111161").WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			externalIDRepository := repository.NewExternalIDRepository(manager)
+
+			ctx := context.Background()
+			err := externalIDRepository.SetExternalID(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -29,6 +29,31 @@ func (r *favoriteRepository) AddFavoriteMovie(ctx context.Context, args reposito
 	return nil
 }
 
+// addFavoriteCollectionQuery skips the movies which are deleted or are already favorite
+const addFavoriteCollectionQuery = `INSERT INTO favorites(user_id, movie_id)
+SELECT ?, collection_movies.movie_id
+FROM collection_movies
+INNER JOIN movies
+ON collection_movies.movie_id = movies.id
+WHERE collection_movies.collection_id = ? AND movies.deleted_at IS NULL
+AND NOT EXISTS (SELECT 1 FROM favorites WHERE favorites.user_id = ? AND favorites.movie_id = collection_movies.movie_id)`
+
+func (r *favoriteRepository) AddFavoriteCollection(ctx context.Context,
+	args repository.AddFavoriteCollectionParams) (int64, error) {
+	result, err := r.connManager.GetWriter().ExecContext(ctx, addFavoriteCollectionQuery, args.UserID,
+		args.CollectionID, args.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("RowsAffected: %w", err)
+	}
+
+	return added, nil
+}
+
 const checkIsFavoriteMovieQuery = `SELECT user_id, movie_id, created_at, updated_at FROM favorites WHERE user_id = ? AND movie_id = ?`
 
 func (r *favoriteRepository) CheckIsFavoriteMovie(ctx context.Context, args repository.CheckIsFavoriteMovieParams) (bool, error) {
@@ -44,19 +69,28 @@ func (r *favoriteRepository) CheckIsFavoriteMovie(ctx context.Context, args repo
 	return true, nil
 }
 
-const findFavoriteMoviesByUserIDQuery = `SELECT movies.id, movies.original_title, movies.original_language,
-movies.overview, movies.poster_path, movies.backdrop_path,
-movies.adult, movies.release_date, movies.budget, movies.revenue, movies.created_at, movies.updated_at
+const findFavoriteMoviesByUserIDQuery = `SELECT ` + movieColumns + `
 FROM movies
 INNER JOIN favorites
 ON movies.id = favorites.movie_id
-WHERE favorites.user_id = ?
-ORDER BY movies.id ASC`
-
-func (r *favoriteRepository) FindFavoriteMoviesByUserID(ctx context.Context, userID uint64) ([]*entity.Movie, error) {
+` + movieRatingStatsJoin + `
+WHERE favorites.user_id = ? AND movies.deleted_at IS NULL%s%s%s
+ORDER BY movies.id ASC
+LIMIT ?`
+
+func (r *favoriteRepository) FindFavoriteMoviesByUserID(ctx context.Context,
+	args repository.FindFavoriteMoviesByUserIDParams) (*repository.MoviePage, error) {
+	genres, genresArgs := genresCondition(args.Genres)
+	release, releaseArgs := releaseCondition(args.Release)
+	after, afterArgs := afterCondition(args.Page.After)
+	query := fmt.Sprintf(findFavoriteMoviesByUserIDQuery, genres, release, after)
+	queryArgs := append([]interface{}{args.UserID}, genresArgs...)
+	queryArgs = append(queryArgs, releaseArgs...)
+	queryArgs = append(queryArgs, afterArgs...)
+	queryArgs = append(queryArgs, args.Page.Limit+1)
 	movies := make([]*entity.Movie, 0)
 
-	rows, err := r.connManager.GetReader().QueryxContext(ctx, findFavoriteMoviesByUserIDQuery, userID)
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, query, queryArgs...)
 	if err != nil {
 		return nil, fmt.Errorf("QueryxContext: %w", err)
 	}
@@ -68,21 +102,13 @@ func (r *favoriteRepository) FindFavoriteMoviesByUserID(ctx context.Context, use
 			return nil, fmt.Errorf("StructScan: %w", err)
 		}
 
-		movies = append(movies, &entity.Movie{
-			ID:               movie.ID,
-			OriginalTitle:    movie.OriginalTitle,
-			OriginalLanguage: movie.OriginalLanguage,
-			Overview:         movie.Overview,
-			PosterPath:       movie.PosterPath,
-			BackdropPath:     movie.BackdropPath,
-			Adult:            movie.Adult,
-			ReleaseDate:      movie.ReleaseDate,
-			Budget:           movie.Budget,
-			Revenue:          movie.Revenue,
-			CreatedAt:        movie.CreatedAt,
-			UpdatedAt:        movie.UpdatedAt,
-		})
+		movies = append(movies, movie.toEntity())
+	}
+
+	page := toMoviePage(movies, args.Page.Limit)
+	if err := attachGenres(ctx, r.connManager.GetReader(), page.Movies); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
 	}
 
-	return movies, nil
+	return page, nil
 }
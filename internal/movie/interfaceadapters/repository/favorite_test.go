@@ -92,6 +92,81 @@ func (s *testFavoriteRepositorySuite) TestAddFavoriteMovie() {
 	}
 }
 
+func (s *testFavoriteRepositorySuite) TestAddFavoriteCollection() {
+	type testInput struct {
+		args  usecaserepository.AddFavoriteCollectionParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		added int64
+		err   error
+	}
+
+	query := regexp.QuoteMeta(`INSERT INTO favorites(user_id, movie_id)
+	SELECT ?, collection_movies.movie_id
+	FROM collection_movies
+	INNER JOIN movies
+	ON collection_movies.movie_id = movies.id
+	WHERE collection_movies.collection_id = ? AND movies.deleted_at IS NULL
+	AND NOT EXISTS (SELECT 1 FROM favorites WHERE favorites.user_id = ? AND favorites.movie_id = collection_movies.movie_id)`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_number_of_added_movies",
+			input: testInput{
+				args: usecaserepository.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(query).WithArgs(1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 2))
+				},
+			},
+			expected: testOutput{
+				added: 2,
+			},
+		},
+		{
+			name: "returns_zero_when_every_movie_is_already_favorite",
+			input: testInput{
+				args: usecaserepository.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(query).WithArgs(1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_add_favorite_collection_fail",
+			input: testInput{
+				args: usecaserepository.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(query).WithArgs(1, 2, 1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			favoriteRepository := repository.NewFavoriteRepository(manager)
+
+			ctx := context.Background()
+			added, err := favoriteRepository.AddFavoriteCollection(ctx, c.input.args)
+			assert.Equal(t, c.expected.added, added)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
 func (s *testFavoriteRepositorySuite) TestCheckIsFavoriteMovie() {
 	type testInput struct {
 		args  usecaserepository.CheckIsFavoriteMovieParams
@@ -193,15 +268,17 @@ func (s *testFavoriteRepositorySuite) TestCheckIsFavoriteMovie() {
 
 func (s *testFavoriteRepositorySuite) TestFindFavoriteMoviesByUserID() {
 	type testInput struct {
-		userID uint64
-		mocks  func(mock sqlmock.Sqlmock)
+		args  usecaserepository.FindFavoriteMoviesByUserIDParams
+		mocks func(mock sqlmock.Sqlmock)
 	}
 
 	type testOutput struct {
-		movies []*entity.Movie
-		err    error
+		page *usecaserepository.MoviePage
+		err  error
 	}
 
+	testPage := usecaserepository.PageParams{Limit: 20}
+
 	cases := []struct {
 		name     string
 		input    testInput
@@ -210,7 +287,7 @@ func (s *testFavoriteRepositorySuite) TestFindFavoriteMoviesByUserID() {
 		{
 			name: "returns_favorite_movies",
 			input: testInput{
-				userID: 1,
+				args: usecaserepository.FindFavoriteMoviesByUserIDParams{UserID: 1, Page: testPage},
 				mocks: func(mock sqlmock.Sqlmock) {
 					rows := sqlmock.NewRows(moviesTableRows)
 					rows.AddRow(
@@ -240,52 +317,120 @@ func (s *testFavoriteRepositorySuite) TestFindFavoriteMoviesByUserID() {
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT movies.id, movies.original_title, movies.original_language,
-						movies.overview, movies.poster_path, movies.backdrop_path,
-						movies.adult, movies.release_date, movies.budget, movies.revenue, movies.created_at, movies.updated_at
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`
 						FROM movies
 						INNER JOIN favorites
 						ON movies.id = favorites.movie_id
-						WHERE favorites.user_id = ?
-						ORDER BY movies.id ASC`)).
-						WithArgs(1).
+						LEFT JOIN movie_rating_stats
+						ON movies.id = movie_rating_stats.movie_id
+						LEFT JOIN movie_critic_rating_stats
+						ON movies.id = movie_critic_rating_stats.movie_id
+						WHERE favorites.user_id = ? AND movies.deleted_at IS NULL
+						ORDER BY movies.id ASC
+						LIMIT ?`)).
+						WithArgs(1, 21).
 						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1, 2).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
 				},
 			},
 			expected: testOutput{
 				err: nil,
-				movies: []*entity.Movie{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "accumsan sed, facilisis vitae,",
+						Title:            "accumsan sed, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
 					},
 					{
 						ID:               2,
 						OriginalTitle:    "arcu. Vivamus sit amet risus. Donec egestas. Aliquam",
+						Title:            "arcu. Vivamus sit amet risus. Donec egestas. Aliquam",
 						OriginalLanguage: "Belgium",
 						Overview:         utils.StringPtr("egestas, urna justo faucibus lectus, a sollicitudin orci sem eget massa. Suspendisse eleifend. Cras sed leo. Cras vehicula aliquet libero. Integer in magna. Phasellus dolor elit, pellentesque a, facilisis non, bibendum sed, est. Nunc laoreet lectus quis massa. Mauris vestibulum, neque sed dictum eleifend, nunc risus varius orci, in consequat enim diam vel arcu. Curabitur ut odio vel est tempor bibendum. Donec felis orci, adipiscing non, luctus sit amet, faucibus ut, nulla."),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
+					},
+				}},
+			},
+		},
+		{
+			name: "returns_favorite_movies_of_any_of_genres",
+			input: testInput{
+				args: usecaserepository.FindFavoriteMoviesByUserIDParams{UserID: 1, Genres: []string{"horror", "thriller"}, Page: testPage},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(2, "ac mattis ornare,", "Belgium", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE favorites.user_id = ? AND movies.deleted_at IS NULL
+						AND EXISTS (SELECT 1 FROM movie_genres INNER JOIN genres ON movie_genres.genre_id = genres.id
+						WHERE movie_genres.movie_id = movies.id AND genres.slug IN (?, ?))
+						ORDER BY movies.id ASC
+						LIMIT ?`)).
+						WithArgs(1, "horror", "thriller", 21).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(2).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows).AddRow(2, 17, "Thriller", "thriller"))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
+					{
+						ID:               2,
+						OriginalTitle:    "ac mattis ornare,",
+						Title:            "ac mattis ornare,",
+						OriginalLanguage: "Belgium",
+						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{{ID: 17, Name: "Thriller", Slug: "thriller"}},
 					},
+				}},
+			},
+		},
+		{
+			name: "returns_favorite_movies_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindFavoriteMoviesByUserIDParams{UserID: 1, Page: usecaserepository.PageParams{
+					Limit: 20,
+					After: &usecaserepository.MovieCursor{ID: 3},
+				}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE favorites.user_id = ? AND movies.deleted_at IS NULL
+						AND movies.id > ?
+						ORDER BY movies.id ASC
+						LIMIT ?`)).
+						WithArgs(1, 3, 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
 				},
 			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
 		},
 		{
 			name: "returns_error_when_query_failed",
 			input: testInput{
-				userID: 1,
+				args: usecaserepository.FindFavoriteMoviesByUserIDParams{UserID: 1, Page: testPage},
 				mocks: func(mock sqlmock.Sqlmock) {
 					rows := sqlmock.NewRows(moviesTableRows)
 					rows.AddRow(
@@ -315,21 +460,24 @@ func (s *testFavoriteRepositorySuite) TestFindFavoriteMoviesByUserID() {
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT movies.id, movies.original_title, movies.original_language,
-						movies.overview, movies.poster_path, movies.backdrop_path,
-						movies.adult, movies.release_date, movies.budget, movies.revenue, movies.created_at, movies.updated_at
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`
 						FROM movies
 						INNER JOIN favorites
 						ON movies.id = favorites.movie_id
-						WHERE favorites.user_id = ?
-						ORDER BY movies.id ASC`)).
-						WithArgs(1).
+						LEFT JOIN movie_rating_stats
+						ON movies.id = movie_rating_stats.movie_id
+						LEFT JOIN movie_critic_rating_stats
+						ON movies.id = movie_critic_rating_stats.movie_id
+						WHERE favorites.user_id = ? AND movies.deleted_at IS NULL
+						ORDER BY movies.id ASC
+						LIMIT ?`)).
+						WithArgs(1, 21).
 						WillReturnError(fmt.Errorf("dummy error"))
 				},
 			},
 			expected: testOutput{
-				err:    fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
-				movies: nil,
+				err:  fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+				page: nil,
 			},
 		},
 	}
@@ -342,8 +490,8 @@ func (s *testFavoriteRepositorySuite) TestFindFavoriteMoviesByUserID() {
 			favoriteRepository := repository.NewFavoriteRepository(manager)
 
 			ctx := context.Background()
-			res, err := favoriteRepository.FindFavoriteMoviesByUserID(ctx, c.input.userID)
-			assert.Equal(t, c.expected.movies, res)
+			res, err := favoriteRepository.FindFavoriteMoviesByUserID(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
 			assert.Equal(t, c.expected.err, err)
 		})
 	}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type genreRepository struct {
+	connManager ConnManager
+}
+
+func NewGenreRepository(connManager ConnManager) *genreRepository {
+	return &genreRepository{connManager: connManager}
+}
+
+const findAllGenresQuery = `SELECT id, name, slug FROM genres ORDER BY name ASC`
+
+func (r *genreRepository) FindAll(ctx context.Context) ([]*entity.Genre, error) {
+	genres := make([]*entity.Genre, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findAllGenresQuery)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		genre := &Genre{}
+		if err = rows.StructScan(genre); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		genres = append(genres, &entity.Genre{ID: genre.ID, Name: genre.Name, Slug: genre.Slug})
+	}
+
+	return genres, nil
+}
+
+// movieGenresCondition is appended to the WHERE clause of a movie query to return only the movies of any of
+// the genres, %s is the placeholders of the genre slugs
+const movieGenresCondition = `
+AND EXISTS (SELECT 1 FROM movie_genres INNER JOIN genres ON movie_genres.genre_id = genres.id
+WHERE movie_genres.movie_id = movies.id AND genres.slug IN (%s))`
+
+// genresCondition returns the condition which filters the movies by the genre slugs and its arguments,
+// the condition is empty when there is no slug
+func genresCondition(slugs []string) (string, []interface{}) {
+	if len(slugs) == 0 {
+		return "", nil
+	}
+
+	args := make([]interface{}, len(slugs))
+	for i, slug := range slugs {
+		args[i] = slug
+	}
+
+	return fmt.Sprintf(movieGenresCondition, strings.TrimSuffix(strings.Repeat("?, ", len(slugs)), ", ")), args
+}
+
+const findGenresByMovieIDsQuery = `SELECT movie_genres.movie_id, genres.id, genres.name, genres.slug
+FROM movie_genres
+INNER JOIN genres
+ON movie_genres.genre_id = genres.id
+WHERE movie_genres.movie_id IN (?)
+ORDER BY genres.name ASC`
+
+// attachGenres sets the genres of every movie, a movie without genre gets an empty list
+func attachGenres(ctx context.Context, db *sqlx.DB, movies []*entity.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	movieIDs := make([]uint64, len(movies))
+	moviesByID := make(map[uint64][]*entity.Movie, len(movies))
+	for i, movie := range movies {
+		movie.Genres = make([]entity.Genre, 0)
+		movieIDs[i] = movie.ID
+		moviesByID[movie.ID] = append(moviesByID[movie.ID], movie)
+	}
+
+	query, args, err := sqlx.In(findGenresByMovieIDsQuery, movieIDs)
+	if err != nil {
+		return fmt.Errorf("sqlx.In: %w", err)
+	}
+
+	rows, err := db.QueryxContext(ctx, db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movieGenre := &MovieGenre{}
+		if err = rows.StructScan(movieGenre); err != nil {
+			return fmt.Errorf("StructScan: %w", err)
+		}
+
+		for _, movie := range moviesByID[movieGenre.MovieID] {
+			movie.Genres = append(movie.Genres, entity.Genre{
+				ID:   movieGenre.ID,
+				Name: movieGenre.Name,
+				Slug: movieGenre.Slug,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testGenreRepositorySuite struct {
+	suite.Suite
+}
+
+func TestGenreRepositorySuite(t *testing.T) {
+	suite.Run(t, &testGenreRepositorySuite{})
+}
+
+func (s *testGenreRepositorySuite) TestFindAll() {
+	type testInput struct {
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		genres []*entity.Genre
+		err    error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, name, slug FROM genres ORDER BY name ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_all_genres",
+			input: testInput{
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(genresTableRows)
+					rows.AddRow(1, "Action", "action")
+					rows.AddRow(16, "Science Fiction", "science-fiction")
+					mock.ExpectQuery(query).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				genres: []*entity.Genre{
+					{ID: 1, Name: "Action", Slug: "action"},
+					{ID: 16, Name: "Science Fiction", Slug: "science-fiction"},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			genreRepository := repository.NewGenreRepository(manager)
+
+			ctx := context.Background()
+			res, err := genreRepository.FindAll(ctx)
+			assert.Equal(t, c.expected.genres, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -3,23 +3,271 @@ package repository
 import "time"
 
 type Movie struct {
+	ID                   uint64     `json:"id" db:"id"`
+	OriginalTitle        string     `json:"original_title" db:"original_title"`
+	OriginalLanguage     string     `json:"original_language" db:"original_language"`
+	Overview             *string    `json:"overview" db:"overview"`
+	PosterPath           *string    `json:"poster_path" db:"poster_path"`
+	BackdropPath         *string    `json:"backdrop_path" db:"backdrop_path"`
+	Adult                bool       `json:"adult" db:"adult"`
+	ReleaseDate          *time.Time `json:"release_date" db:"release_date"`
+	ReleaseDatePrecision *string    `json:"release_date_precision" db:"release_date_precision"`
+	Budget               *uint64    `json:"budget" db:"budget"`
+	Revenue              *int64     `json:"revenue" db:"revenue"`
+	Currency             *string    `json:"currency" db:"currency"`
+	CreatedAt            time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at" db:"updated_at"`
+	RatingStats
+	CriticRatingStats
+}
+
+// RatingStats is the row of movie_rating_stats which is LEFT JOINed with movies, so every column
+// is NULL for a movie which has not been rated yet
+type RatingStats struct {
+	RatingCount *uint64 `json:"rating_count" db:"rating_count"`
+	RatingSum   *uint64 `json:"rating_sum" db:"rating_sum"`
+	Rating1     *uint64 `json:"rating_1" db:"rating_1"`
+	Rating2     *uint64 `json:"rating_2" db:"rating_2"`
+	Rating3     *uint64 `json:"rating_3" db:"rating_3"`
+	Rating4     *uint64 `json:"rating_4" db:"rating_4"`
+	Rating5     *uint64 `json:"rating_5" db:"rating_5"`
+	Rating6     *uint64 `json:"rating_6" db:"rating_6"`
+	Rating7     *uint64 `json:"rating_7" db:"rating_7"`
+	Rating8     *uint64 `json:"rating_8" db:"rating_8"`
+	Rating9     *uint64 `json:"rating_9" db:"rating_9"`
+	Rating10    *uint64 `json:"rating_10" db:"rating_10"`
+}
+
+// CriticRatingStats is the row of movie_critic_rating_stats which is LEFT JOINed with movies, the columns are
+// NULL for a movie which has never been rated by a critic
+type CriticRatingStats struct {
+	CriticRatingCount *uint64 `json:"critic_rating_count" db:"critic_rating_count"`
+	CriticRatingSum   *uint64 `json:"critic_rating_sum" db:"critic_rating_sum"`
+}
+
+type Genre struct {
+	ID   uint64 `json:"id" db:"id"`
+	Name string `json:"name" db:"name"`
+	Slug string `json:"slug" db:"slug"`
+}
+
+// MovieGenre is a genre of the movie of MovieID
+type MovieGenre struct {
+	MovieID uint64 `json:"movie_id" db:"movie_id"`
+	Genre
+}
+
+type MovieTranslation struct {
+	MovieID  uint64  `json:"movie_id" db:"movie_id"`
+	Language string  `json:"language" db:"language"`
+	Title    string  `json:"title" db:"title"`
+	Overview *string `json:"overview" db:"overview"`
+}
+
+type Person struct {
+	ID          uint64    `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Biography   *string   `json:"biography" db:"biography"`
+	ProfilePath *string   `json:"profile_path" db:"profile_path"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Credit is a row of credits joined with the name and the profile of the person
+type Credit struct {
+	ID            uint64  `json:"id" db:"id"`
+	MovieID       uint64  `json:"movie_id" db:"movie_id"`
+	PersonID      uint64  `json:"person_id" db:"person_id"`
+	PersonName    string  `json:"person_name" db:"person_name"`
+	ProfilePath   *string `json:"profile_path" db:"profile_path"`
+	Role          string  `json:"role" db:"role"`
+	Department    string  `json:"department" db:"department"`
+	CharacterName *string `json:"character_name" db:"character_name"`
+	BillingOrder  uint    `json:"billing_order" db:"billing_order"`
+}
+
+// FilmographyCredit is a row of credits joined with the movie
+type FilmographyCredit struct {
+	ID                   uint64     `json:"id" db:"id"`
+	MovieID              uint64     `json:"movie_id" db:"movie_id"`
+	OriginalTitle        string     `json:"original_title" db:"original_title"`
+	PosterPath           *string    `json:"poster_path" db:"poster_path"`
+	ReleaseDate          *time.Time `json:"release_date" db:"release_date"`
+	ReleaseDatePrecision *string    `json:"release_date_precision" db:"release_date_precision"`
+	Role                 string     `json:"role" db:"role"`
+	Department           string     `json:"department" db:"department"`
+	CharacterName        *string    `json:"character_name" db:"character_name"`
+	BillingOrder         uint       `json:"billing_order" db:"billing_order"`
+}
+
+type Collection struct {
+	ID         uint64    `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	Overview   *string   `json:"overview" db:"overview"`
+	PosterPath *string   `json:"poster_path" db:"poster_path"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CollectionMovie is a movie joined with its position in the collection
+type CollectionMovie struct {
+	*Movie
+	Position uint `json:"position" db:"position"`
+}
+
+// CollectionSummary is a row of collections joined with the position of a movie and the number of the movies
+type CollectionSummary struct {
+	ID         uint64 `json:"id" db:"id"`
+	Name       string `json:"name" db:"name"`
+	Position   uint   `json:"position" db:"position"`
+	MovieCount uint   `json:"movie_count" db:"movie_count"`
+}
+
+type Tag struct {
+	ID   uint64 `json:"id" db:"id"`
+	Slug string `json:"slug" db:"slug"`
+}
+
+// TaggedMovie is a movie joined with the number of the users who applied the tag to it
+type TaggedMovie struct {
+	*Movie
+	UserCount uint `json:"user_count" db:"user_count"`
+}
+
+// TagUsage is a tag joined with the number of the users who applied it to a movie
+type TagUsage struct {
+	Slug      string `json:"slug" db:"slug"`
+	UserCount uint   `json:"user_count" db:"user_count"`
+}
+
+// MovieTagSlug is the slug of a tag applied to a movie
+type MovieTagSlug struct {
+	MovieID uint64 `json:"movie_id" db:"movie_id"`
+	Slug    string `json:"slug" db:"slug"`
+}
+
+// MoviePersonName is the name of a person credited in a movie
+type MoviePersonName struct {
+	MovieID uint64 `json:"movie_id" db:"movie_id"`
+	Name    string `json:"name" db:"name"`
+}
+
+type ExternalID struct {
+	MovieID    uint64 `json:"movie_id" db:"movie_id"`
+	Source     string `json:"source" db:"source"`
+	ExternalID string `json:"external_id" db:"external_id"`
+}
+
+type ReleaseDate struct {
+	ID            uint64    `json:"id" db:"id"`
+	MovieID       uint64    `json:"movie_id" db:"movie_id"`
+	Country       string    `json:"country" db:"country"`
+	ReleaseType   string    `json:"release_type" db:"release_type"`
+	ReleaseDate   time.Time `json:"release_date" db:"release_date"`
+	Certification *string   `json:"certification" db:"certification"`
+	MinimumAge    *uint8    `json:"minimum_age" db:"minimum_age"`
+}
+
+type BoxOfficeGross struct {
+	ID        uint64    `json:"id" db:"id"`
+	MovieID   uint64    `json:"movie_id" db:"movie_id"`
+	Territory string    `json:"territory" db:"territory"`
+	WeekStart time.Time `json:"week_start" db:"week_start"`
+	Gross     int64     `json:"gross" db:"gross"`
+	Currency  string    `json:"currency" db:"currency"`
+}
+
+type Favorite struct {
+	UserID    uint64    `json:"user_id" db:"user_id"`
+	MovieID   uint64    `json:"movie_id" db:"movie_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type Review struct {
 	ID               uint64     `json:"id" db:"id"`
-	OriginalTitle    string     `json:"original_title" db:"original_title"`
-	OriginalLanguage string     `json:"original_language" db:"original_language"`
-	Overview         *string    `json:"overview" db:"overview"`
-	PosterPath       *string    `json:"poster_path" db:"poster_path"`
-	BackdropPath     *string    `json:"backdrop_path" db:"backdrop_path"`
-	Adult            bool       `json:"adult" db:"adult"`
-	ReleaseDate      *time.Time `json:"release_date" db:"release_date"`
-	Budget           *uint64    `json:"budget" db:"budget"`
-	Revenue          *int64     `json:"revenue" db:"revenue"`
+	MovieID          uint64     `json:"movie_id" db:"movie_id"`
+	UserID           uint64     `json:"user_id" db:"user_id"`
+	Username         string     `json:"username" db:"username"`
+	AuthorIsCritic   bool       `json:"author_is_critic" db:"author_is_critic"`
+	Title            string     `json:"title" db:"title"`
+	Content          string     `json:"content" db:"content"`
+	IsSpoiler        bool       `json:"is_spoiler" db:"is_spoiler"`
+	ModerationStatus string     `json:"moderation_status" db:"moderation_status"`
+	HelpfulCount     uint64     `json:"helpful_count" db:"helpful_count"`
+	NotHelpfulCount  uint64     `json:"not_helpful_count" db:"not_helpful_count"`
+	AuthorRating     *uint8     `json:"author_rating" db:"author_rating"`
+	EditedAt         *time.Time `json:"edited_at" db:"edited_at"`
 	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
 }
 
-type Favorite struct {
+type ReviewRevision struct {
+	ID        uint64    `json:"id" db:"id"`
+	ReviewID  uint64    `json:"review_id" db:"review_id"`
+	Title     string    `json:"title" db:"title"`
+	Content   string    `json:"content" db:"content"`
+	IsSpoiler bool      `json:"is_spoiler" db:"is_spoiler"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+type ReviewVote struct {
+	UserID    uint64    `json:"user_id" db:"user_id"`
+	ReviewID  uint64    `json:"review_id" db:"review_id"`
+	Helpful   bool      `json:"helpful" db:"helpful"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type Rating struct {
 	UserID    uint64    `json:"user_id" db:"user_id"`
 	MovieID   uint64    `json:"movie_id" db:"movie_id"`
+	Score     uint8     `json:"score" db:"score"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
+
+type Comment struct {
+	ID               uint64     `json:"id" db:"id"`
+	ReviewID         uint64     `json:"review_id" db:"review_id"`
+	UserID           uint64     `json:"user_id" db:"user_id"`
+	Username         string     `json:"username" db:"username"`
+	ParentID         *uint64    `json:"parent_id" db:"parent_id"`
+	Depth            uint8      `json:"depth" db:"depth"`
+	Content          string     `json:"content" db:"content"`
+	ModerationStatus string     `json:"moderation_status" db:"moderation_status"`
+	ReplyCount       uint64     `json:"reply_count" db:"reply_count"`
+	DeletedAt        *time.Time `json:"deleted_at" db:"deleted_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+type Report struct {
+	ID         uint64    `json:"id" db:"id"`
+	UserID     *uint64   `json:"user_id" db:"user_id"`
+	Username   *string   `json:"username" db:"username"`
+	TargetType string    `json:"target_type" db:"target_type"`
+	TargetID   uint64    `json:"target_id" db:"target_id"`
+	Reason     string    `json:"reason" db:"reason"`
+	Status     string    `json:"status" db:"status"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type ModerationAction struct {
+	ID          uint64    `json:"id" db:"id"`
+	ModeratorID uint64    `json:"moderator_id" db:"moderator_id"`
+	TargetType  string    `json:"target_type" db:"target_type"`
+	TargetID    uint64    `json:"target_id" db:"target_id"`
+	Action      string    `json:"action" db:"action"`
+	Note        string    `json:"note" db:"note"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type Post struct {
+	TargetType string    `json:"target_type" db:"target_type"`
+	ID         uint64    `json:"id" db:"id"`
+	UserID     uint64    `json:"user_id" db:"user_id"`
+	Content    string    `json:"content" db:"content"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
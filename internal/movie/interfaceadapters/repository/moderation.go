@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type moderationRepository struct {
+	connManager ConnManager
+}
+
+func NewModerationRepository(connManager ConnManager) *moderationRepository {
+	return &moderationRepository{connManager: connManager}
+}
+
+// moderating is not a change of the content itself so updated_at is kept as it is
+const updateReviewModerationStatusQuery = `UPDATE reviews SET moderation_status = ?, updated_at = updated_at WHERE id = ?`
+
+const updateCommentModerationStatusQuery = `UPDATE review_comments SET moderation_status = ?, updated_at = updated_at
+WHERE id = ?`
+
+const resolveReportsQuery = `UPDATE content_reports SET status = 'resolved'
+WHERE target_type = ? AND target_id = ? AND status = 'open'`
+
+const createModerationActionQuery = `INSERT INTO moderation_actions(moderator_id, target_type, target_id, action, note)
+VALUES (?,?,?,?,?)`
+
+const findModerationActionByIDQuery = `SELECT id, moderator_id, target_type, target_id, action, note, created_at
+FROM moderation_actions WHERE id = ?`
+
+func (r *moderationRepository) Moderate(ctx context.Context, args repository.ModerateParams) (*entity.ModerationAction, error) {
+	action := &ModerationAction{}
+	if err := withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		query, queryArgs, err := moderationQuery(args)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, query, queryArgs...); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, resolveReportsQuery, args.TargetType, args.TargetID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		res, err := tx.ExecContext(ctx, createModerationActionQuery, args.ModeratorID, args.TargetType, args.TargetID,
+			args.Action, args.Note)
+		if err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		createdActionID, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("LastInsertId: %w", err)
+		}
+
+		if err := tx.QueryRowxContext(ctx, findModerationActionByIDQuery, createdActionID).StructScan(action); err != nil {
+			return fmt.Errorf("QueryRowxContext: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &entity.ModerationAction{
+		ID:          action.ID,
+		ModeratorID: action.ModeratorID,
+		TargetType:  entity.ReportTargetType(action.TargetType),
+		TargetID:    action.TargetID,
+		Action:      entity.ModerationActionType(action.Action),
+		Note:        action.Note,
+		CreatedAt:   action.CreatedAt,
+	}, nil
+}
+
+var deleteModerationTargetQueries = map[entity.ReportTargetType]string{
+	entity.ReportTargetReview:  deleteReviewQuery,
+	entity.ReportTargetComment: deleteCommentQuery,
+}
+
+var updateModerationStatusQueries = map[entity.ReportTargetType]string{
+	entity.ReportTargetReview:  updateReviewModerationStatusQuery,
+	entity.ReportTargetComment: updateCommentModerationStatusQuery,
+}
+
+// moderationQuery returns the query which applies the action of args to its target
+func moderationQuery(args repository.ModerateParams) (string, []interface{}, error) {
+	if _, ok := deleteModerationTargetQueries[args.TargetType]; !ok {
+		return "", nil, fmt.Errorf("invalid report target type: %s", args.TargetType)
+	}
+
+	switch args.Action {
+	case entity.ModerationActionHide:
+		return updateModerationStatusQueries[args.TargetType], []interface{}{entity.ModerationStatusHidden, args.TargetID}, nil
+	case entity.ModerationActionRestore:
+		return updateModerationStatusQueries[args.TargetType], []interface{}{entity.ModerationStatusVisible, args.TargetID}, nil
+	case entity.ModerationActionDelete:
+		return deleteModerationTargetQueries[args.TargetType], []interface{}{args.TargetID}, nil
+	default:
+		return "", nil, fmt.Errorf("invalid moderation action: %s", args.Action)
+	}
+}
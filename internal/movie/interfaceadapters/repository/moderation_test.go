@@ -0,0 +1,187 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testModerationRepositorySuite struct {
+	suite.Suite
+}
+
+func TestModerationRepositorySuite(t *testing.T) {
+	suite.Run(t, &testModerationRepositorySuite{})
+}
+
+func (s *testModerationRepositorySuite) TestModerate() {
+	type testInput struct {
+		args  usecaserepository.ModerateParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		action *entity.ModerationAction
+		err    error
+	}
+
+	resolveQuery := regexp.QuoteMeta(`UPDATE content_reports SET status = 'resolved'
+	WHERE target_type = ? AND target_id = ? AND status = 'open'`)
+	createActionQuery := regexp.QuoteMeta(`INSERT INTO moderation_actions(moderator_id, target_type, target_id, action, note)
+	VALUES (?,?,?,?,?)`)
+	findActionQuery := regexp.QuoteMeta(`SELECT id, moderator_id, target_type, target_id, action, note, created_at
+	FROM moderation_actions WHERE id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "hides_review_resolves_reports_and_records_action",
+			input: testInput{
+				args: usecaserepository.ModerateParams{
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetReview,
+					TargetID:    5,
+					Action:      entity.ModerationActionHide,
+					Note:        "harassment",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE reviews SET moderation_status = ?, updated_at = updated_at WHERE id = ?`)).
+						WithArgs("hidden", 5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(resolveQuery).WithArgs("review", 5).WillReturnResult(sqlmock.NewResult(0, 2))
+					mock.
+						ExpectExec(createActionQuery).
+						WithArgs(9, "review", 5, "hide", "harassment").
+						WillReturnResult(sqlmock.NewResult(4, 1))
+
+					rows := sqlmock.NewRows(moderationActionsTableRows)
+					rows.AddRow(4, 9, "review", 5, "hide", "harassment", utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.ExpectQuery(findActionQuery).WithArgs(4).WillReturnRows(rows)
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{
+				action: &entity.ModerationAction{
+					ID:          4,
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetReview,
+					TargetID:    5,
+					Action:      entity.ModerationActionHide,
+					Note:        "harassment",
+					CreatedAt:   utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "soft_deletes_comment_when_action_is_delete",
+			input: testInput{
+				args: usecaserepository.ModerateParams{
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetComment,
+					TargetID:    7,
+					Action:      entity.ModerationActionDelete,
+					Note:        "spam",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE review_comments SET content = '', deleted_at = CURRENT_TIMESTAMP WHERE id = ?`)).
+						WithArgs(7).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(resolveQuery).WithArgs("comment", 7).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(createActionQuery).
+						WithArgs(9, "comment", 7, "delete", "spam").
+						WillReturnResult(sqlmock.NewResult(4, 1))
+
+					rows := sqlmock.NewRows(moderationActionsTableRows)
+					rows.AddRow(4, 9, "comment", 7, "delete", "spam", utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.ExpectQuery(findActionQuery).WithArgs(4).WillReturnRows(rows)
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{
+				action: &entity.ModerationAction{
+					ID:          4,
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetComment,
+					TargetID:    7,
+					Action:      entity.ModerationActionDelete,
+					Note:        "spam",
+					CreatedAt:   utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "rollbacks_when_resolve_reports_failed",
+			input: testInput{
+				args: usecaserepository.ModerateParams{
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetComment,
+					TargetID:    7,
+					Action:      entity.ModerationActionRestore,
+					Note:        "not abusive",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE review_comments SET moderation_status = ?, updated_at = updated_at
+						WHERE id = ?`)).
+						WithArgs("visible", 7).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(resolveQuery).WithArgs("comment", 7).WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+		{
+			name: "returns_error_when_action_is_invalid",
+			input: testInput{
+				args: usecaserepository.ModerateParams{
+					ModeratorID: 9,
+					TargetType:  entity.ReportTargetReview,
+					TargetID:    5,
+					Action:      "ban",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("invalid moderation action: %s", "ban"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			moderationRepository := repository.NewModerationRepository(manager)
+
+			ctx := context.Background()
+			res, err := moderationRepository.Moderate(ctx, c.input.args)
+			assert.Equal(t, c.expected.action, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -5,8 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
 )
 
 type movieRepository struct {
@@ -17,8 +22,67 @@ func NewMovieRepository(connManager ConnManager) *movieRepository {
 	return &movieRepository{connManager: connManager}
 }
 
-const findByID = `SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-adult, release_date, budget, revenue, created_at, updated_at FROM movies WHERE id = ?`
+// movieColumns are the columns which are needed to build an entity.Movie, the query using them has to
+// LEFT JOIN movie_rating_stats and movie_critic_rating_stats (see movieRatingStatsJoin)
+const movieColumns = `movies.id, movies.original_title, movies.original_language, movies.overview,
+movies.poster_path, movies.backdrop_path, movies.adult, movies.release_date, movies.release_date_precision,
+movies.budget, movies.revenue, movies.currency, movies.created_at, movies.updated_at,
+movie_rating_stats.rating_count, movie_rating_stats.rating_sum,
+movie_rating_stats.rating_1, movie_rating_stats.rating_2, movie_rating_stats.rating_3, movie_rating_stats.rating_4,
+movie_rating_stats.rating_5, movie_rating_stats.rating_6, movie_rating_stats.rating_7, movie_rating_stats.rating_8,
+movie_rating_stats.rating_9, movie_rating_stats.rating_10,
+movie_critic_rating_stats.rating_count AS critic_rating_count, movie_critic_rating_stats.rating_sum AS critic_rating_sum`
+
+var (
+	// ErrCursorWithoutSortValue is returned for a cursor which has not the value of the sort of the list
+	ErrCursorWithoutSortValue = errors.New("cursor has no value of the sort")
+	// ErrRelevanceWithoutQuery is returned when the movies are ordered by the relevance without a query or hits
+	ErrRelevanceWithoutQuery = errors.New("relevance sort requires a query")
+)
+
+const movieAfterCondition = `
+AND movies.id > ?`
+
+// afterCondition returns the condition which continues a list ordered by movies.id after the cursor and its
+// arguments, the condition is empty on the first page
+func afterCondition(after *repository.MovieCursor) (string, []interface{}) {
+	if after == nil {
+		return "", nil
+	}
+
+	return movieAfterCondition, []interface{}{after.ID}
+}
+
+// toMoviePage returns the first limit movies as a page, a list is queried with one more movie than limit so that
+// the next page exists only when the extra movie is found
+func toMoviePage(movies []*entity.Movie, limit uint) *repository.MoviePage {
+	if uint(len(movies)) <= limit {
+		return &repository.MoviePage{Movies: movies}
+	}
+
+	movies = movies[:limit]
+	if limit == 0 {
+		// a page without any movie has no movie to continue after
+		return &repository.MoviePage{Movies: movies}
+	}
+
+	return &repository.MoviePage{Movies: movies, Next: &repository.MovieCursor{ID: movies[len(movies)-1].ID}}
+}
+
+// movieReleaseDateDescOrder orders the movies from the latest release date and puts the unknown release dates last.
+// A date which is known only to the year or to the month sorts as its first day and after the dates of that day
+// which are known more precisely
+const movieReleaseDateDescOrder = `movies.release_date DESC, movies.release_date_precision DESC`
+
+const movieRatingStatsJoin = `LEFT JOIN movie_rating_stats
+ON movies.id = movie_rating_stats.movie_id
+LEFT JOIN movie_critic_rating_stats
+ON movies.id = movie_critic_rating_stats.movie_id`
+
+const findByID = `SELECT ` + movieColumns + `
+FROM movies
+` + movieRatingStatsJoin + `
+WHERE movies.id = ? AND movies.deleted_at IS NULL`
 
 func (r *movieRepository) FindByID(ctx context.Context, movieID uint64) (*entity.Movie, error) {
 	foundMovie := &Movie{}
@@ -30,76 +94,428 @@ func (r *movieRepository) FindByID(ctx context.Context, movieID uint64) (*entity
 		return nil, fmt.Errorf("QueryRowxContext: %w", err)
 	}
 
-	return &entity.Movie{
-		ID:               foundMovie.ID,
-		OriginalTitle:    foundMovie.OriginalTitle,
-		OriginalLanguage: foundMovie.OriginalLanguage,
-		Overview:         foundMovie.Overview,
-		PosterPath:       foundMovie.PosterPath,
-		BackdropPath:     foundMovie.BackdropPath,
-		Adult:            foundMovie.Adult,
-		ReleaseDate:      foundMovie.ReleaseDate,
-		Budget:           foundMovie.Budget,
-		Revenue:          foundMovie.Revenue,
-		CreatedAt:        foundMovie.CreatedAt,
-		UpdatedAt:        foundMovie.UpdatedAt,
-	}, nil
-}
-
-const findByKeyword = `SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-adult, release_date, budget, revenue, created_at, updated_at
-FROM movies
-WHERE MATCH (original_title, overview, original_language) AGAINST ('%s*' IN BOOLEAN MODE)
-ORDER BY id ASC`
+	movie := foundMovie.toEntity()
+	if err := attachGenres(ctx, r.connManager.GetReader(), []*entity.Movie{movie}); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
+	}
 
-func (r *movieRepository) FindByKeyword(ctx context.Context, keyword string) ([]*entity.Movie, error) {
-	query := fmt.Sprintf(findByKeyword, keyword)
-	movies := make([]*entity.Movie, 0)
+	return movie, nil
+}
 
-	rows, err := r.connManager.GetReader().QueryxContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("QueryxContext: %w", err)
-	}
-	defer rows.Close()
+// movieKeywordCondition matches a term with the movie itself, with the name of a person credited in the movie,
+// with a translation of the movie or with a tag applied to the movie
+const movieKeywordCondition = `(MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+OR movies.id IN (SELECT credits.movie_id FROM credits INNER JOIN people ON credits.person_id = people.id
+WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))`
 
-	for rows.Next() {
-		movie := &Movie{}
-		if err = rows.StructScan(movie); err != nil {
-			return nil, fmt.Errorf("StructScan: %w", err)
+// movieTitleCondition matches a term with the original title or with a translated title of the movie
+const movieTitleCondition = `(MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
+OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+WHERE MATCH (movie_translations.title) AGAINST (? IN BOOLEAN MODE)))`
+
+// searchCondition returns the condition which every term of the query must satisfy and its arguments,
+// an excluded term must not match
+func searchCondition(query searchquery.Query) (string, []interface{}) {
+	conditions := make([]string, len(query.Terms))
+	args := make([]interface{}, 0)
+
+	for i, term := range query.Terms {
+		condition, matches := movieKeywordCondition, 4
+		if term.Field == searchquery.FieldTitle {
+			condition, matches = movieTitleCondition, 2
 		}
 
-		movies = append(movies, &entity.Movie{
-			ID:               movie.ID,
-			OriginalTitle:    movie.OriginalTitle,
-			OriginalLanguage: movie.OriginalLanguage,
-			Overview:         movie.Overview,
-			PosterPath:       movie.PosterPath,
-			BackdropPath:     movie.BackdropPath,
-			Adult:            movie.Adult,
-			ReleaseDate:      movie.ReleaseDate,
-			Budget:           movie.Budget,
-			Revenue:          movie.Revenue,
-			CreatedAt:        movie.CreatedAt,
-			UpdatedAt:        movie.UpdatedAt,
-		})
+		if term.Exclude {
+			condition = "NOT " + condition
+		}
+
+		conditions[i] = condition
+		expression := booleanModeExpression(term)
+		for j := 0; j < matches; j++ {
+			args = append(args, expression)
+		}
 	}
 
-	return movies, nil
+	return strings.Join(conditions, "\nAND "), args
 }
 
-const findPopularMovies = `SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-adult, release_date, budget, revenue, created_at, updated_at, IFNULL(favorite_numbers.favorite_number, 0) as favorite_number
-FROM movies
+// booleanModeExpression writes the term for a MATCH in boolean mode, the words have only letters, digits and "_"
+// so the quotes of a phrase and the "*" of a prefix are its only operators
+func booleanModeExpression(term searchquery.Term) string {
+	if term.Phrase {
+		return `"` + strings.Join(term.Words, " ") + `"`
+	}
+
+	if term.Prefix {
+		return term.Words[0] + "*"
+	}
+
+	return term.Words[0]
+}
+
+// movieFilterConditions are the conditions of every field of repository.MovieFilter, the average rating is NULL and
+// never satisfies its condition when the movie has no rating
+const (
+	movieOriginalLanguageCondition = "movies.original_language = ?"
+	movieAdultCondition            = "movies.adult = ?"
+	movieReleasedFromCondition     = "movies.release_date >= ?"
+	movieReleasedBeforeCondition   = "movies.release_date < ?"
+	movieCurrencyCondition         = "movies.currency = ?"
+	movieMinBudgetCondition        = "movies.budget >= ?"
+	movieMaxBudgetCondition        = "movies.budget <= ?"
+	movieMinRevenueCondition       = "movies.revenue >= ?"
+	movieMaxRevenueCondition       = "movies.revenue <= ?"
+	movieMinRatingCondition        = "movie_rating_stats.rating_sum / movie_rating_stats.rating_count >= ?"
+)
+
+// filterCondition returns the condition which filters the movies by the filter and its arguments, the condition is
+// empty when the filter has no condition. The years are compared as their first day, which is also the release
+// date of a movie known only to the year
+func filterCondition(filter repository.MovieFilter) (string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	if len(filter.OriginalLanguage) > 0 {
+		conditions = append(conditions, movieOriginalLanguageCondition)
+		args = append(args, filter.OriginalLanguage)
+	}
+
+	if filter.Adult != nil {
+		conditions = append(conditions, movieAdultCondition)
+		args = append(args, *filter.Adult)
+	}
+
+	if filter.ReleaseYearFrom != nil {
+		conditions = append(conditions, movieReleasedFromCondition)
+		args = append(args, dateValue(&entity.Date{Year: *filter.ReleaseYearFrom, Month: time.January, Day: 1}))
+	}
+
+	if filter.ReleaseYearTo != nil {
+		conditions = append(conditions, movieReleasedBeforeCondition)
+		args = append(args, dateValue(&entity.Date{Year: *filter.ReleaseYearTo + 1, Month: time.January, Day: 1}))
+	}
+
+	if len(filter.Currency) > 0 {
+		conditions = append(conditions, movieCurrencyCondition)
+		args = append(args, filter.Currency)
+	}
+
+	if filter.MinBudget != nil {
+		conditions = append(conditions, movieMinBudgetCondition)
+		args = append(args, *filter.MinBudget)
+	}
+
+	if filter.MaxBudget != nil {
+		conditions = append(conditions, movieMaxBudgetCondition)
+		args = append(args, *filter.MaxBudget)
+	}
+
+	if filter.MinRevenue != nil {
+		conditions = append(conditions, movieMinRevenueCondition)
+		args = append(args, *filter.MinRevenue)
+	}
+
+	if filter.MaxRevenue != nil {
+		conditions = append(conditions, movieMaxRevenueCondition)
+		args = append(args, *filter.MaxRevenue)
+	}
+
+	if filter.MinRating != nil {
+		conditions = append(conditions, movieMinRatingCondition)
+		args = append(args, *filter.MinRating)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return "\nAND " + strings.Join(conditions, " AND "), args
+}
+
+// moviePopularityScore is
+// RatingWeight * (rating_sum + MinimumVotes * mean_rating) / (rating_count + MinimumVotes)
+// + FavoriteWeight * LN(1 + favorite_number), the first term is the bayesian average of the movie's ratings.
+// The query selecting it has to join moviePopularityJoin
+const moviePopularityScore = `,
+? * IFNULL((IFNULL(movie_rating_stats.rating_sum, 0) + ? * rating_means.mean_rating)
+/ (IFNULL(movie_rating_stats.rating_count, 0) + ?), 0)
++ ? * LN(1 + IFNULL(favorite_numbers.favorite_number, 0)) AS popularity_score`
+
+const moviePopularityJoin = `
 LEFT JOIN (SELECT movie_id, count(*) AS favorite_number FROM favorites GROUP BY movie_id) AS favorite_numbers
 ON movies.id = favorite_numbers.movie_id
-ORDER BY favorite_number DESC
-LIMIT %v`
+CROSS JOIN (SELECT IFNULL(SUM(rating_sum) / SUM(rating_count), 0) AS mean_rating FROM movie_rating_stats) AS rating_means`
+
+// movieRelevanceScore scores how well the movie matches the search with the texts which movieKeywordCondition
+// matches, a match in the original title is counted twice. The best matching person, translation and tag of the
+// movie are added so that a movie found only by one of them is ranked by it too
+const movieRelevanceScore = `,
+MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
++ MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
++ IFNULL((SELECT MAX(MATCH (people.name) AGAINST (? IN BOOLEAN MODE)) FROM credits
+INNER JOIN people ON credits.person_id = people.id WHERE credits.movie_id = movies.id), 0)
++ IFNULL((SELECT MAX(MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+FROM movie_translations WHERE movie_translations.movie_id = movies.id), 0)
++ IFNULL((SELECT MAX(MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)) FROM movie_tags
+INNER JOIN tags ON movie_tags.tag_id = tags.id WHERE movie_tags.movie_id = movies.id), 0) AS relevance_score`
+
+// relevanceExpression writes the terms of the query which are not excluded as the optional terms of a MATCH in
+// boolean mode, so that the more terms a text matches the greater its score is
+func relevanceExpression(query searchquery.Query) string {
+	expressions := make([]string, 0, len(query.Terms))
+	for _, term := range query.Terms {
+		if !term.Exclude {
+			expressions = append(expressions, booleanModeExpression(term))
+		}
+	}
+
+	return strings.Join(expressions, " ")
+}
+
+// movieOrder is the part of a movie list query which depends on its sort, after continues the list after the
+// cursor in the WHERE clause and having does it in the HAVING clause when it compares a selected score. The
+// relevance score is selected by every query with a search so the order by it has no score of its own
+type movieOrder struct {
+	score      string
+	scoreArgs  []interface{}
+	joins      string
+	after      string
+	afterArgs  []interface{}
+	having     string
+	havingArgs []interface{}
+	orderBy    string
+}
+
+// keysetCondition continues a list ordered by the expression and then by movies.id after the movie whose value
+// of the expression is the first and the second argument and whose id is the third one
+func keysetCondition(expression string, comparison string) string {
+	return fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND movies.id > ?))", expression, comparison)
+}
+
+// nullableMovieOrder orders the movies by a column which may be NULL and puts the movies whose column is NULL
+// last, value is the column of the movie of the cursor
+func nullableMovieOrder(column string, direction string, comparison string, after *repository.MovieCursor,
+	value interface{}) movieOrder {
+	order := movieOrder{orderBy: fmt.Sprintf("%[1]s IS NULL, %[1]s %[2]s, movies.id ASC", column, direction)}
+	if after == nil {
+		return order
+	}
+
+	if value == nil {
+		order.after = fmt.Sprintf("\nAND %s IS NULL AND movies.id > ?", column)
+		order.afterArgs = []interface{}{after.ID}
+		return order
+	}
+
+	order.after = fmt.Sprintf("\nAND (%[1]s %[2]s ? OR (%[1]s = ? AND movies.id > ?) OR %[1]s IS NULL)",
+		column, comparison)
+	order.afterArgs = []interface{}{value, value, after.ID}
+
+	return order
+}
+
+// datePrecisionRanks are the indexes of the precisions in the ENUM of movies.release_date_precision, which orders
+// it. The column is compared with the index of the precision of the cursor since an ENUM is compared with a string
+// as a string
+var datePrecisionRanks = map[string]int{
+	entity.DatePrecisionYear:  1,
+	entity.DatePrecisionMonth: 2,
+	entity.DatePrecisionDay:   3,
+}
+
+// releaseDateMovieOrder orders the movies by their release date and then by its precision like
+// movieReleaseDateDescOrder does, and puts the unknown release dates last
+func releaseDateMovieOrder(direction string, comparison string, after *repository.MovieCursor) (movieOrder, error) {
+	order := movieOrder{orderBy: fmt.Sprintf("movies.release_date IS NULL, movies.release_date %[1]s, "+
+		"movies.release_date_precision %[1]s, movies.id ASC", direction)}
+	if after == nil {
+		return order, nil
+	}
+
+	if after.ReleaseDate == nil {
+		order.after = "\nAND movies.release_date IS NULL AND movies.id > ?"
+		order.afterArgs = []interface{}{after.ID}
+		return order, nil
+	}
+
+	if after.ReleaseDatePrecision == nil {
+		return movieOrder{}, ErrCursorWithoutSortValue
+	}
+
+	rank, ok := datePrecisionRanks[*after.ReleaseDatePrecision]
+	if !ok {
+		return movieOrder{}, ErrCursorWithoutSortValue
+	}
 
-func (r *movieRepository) FindPopularMovies(ctx context.Context, limit uint) ([]*entity.Movie, error) {
-	query := fmt.Sprintf(findPopularMovies, limit)
+	date := dateValue(after.ReleaseDate)
+	order.after = fmt.Sprintf("\nAND (movies.release_date %[1]s ? OR (movies.release_date = ? AND %[2]s) "+
+		"OR movies.release_date IS NULL)", comparison, keysetCondition("(movies.release_date_precision + 0)", comparison))
+	order.afterArgs = []interface{}{date, date, rank, rank, after.ID}
+
+	return order, nil
+}
+
+// orderMovies returns the part of the query which orders the movies by the sort of args and continues the list
+// after the cursor of the page
+func orderMovies(args repository.FindMoviesParams) (movieOrder, error) {
+	after := args.Page.After
+	direction, comparison := "ASC", ">"
+	if args.Sort.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	switch args.Sort.Field {
+	case "":
+		order := movieOrder{orderBy: "movies.id ASC"}
+		order.after, order.afterArgs = afterCondition(after)
+		return order, nil
+	case entity.MovieSortPopularity:
+		order := movieOrder{
+			score:     moviePopularityScore,
+			scoreArgs: []interface{}{args.RatingWeight, args.MinimumVotes, args.MinimumVotes, args.FavoriteWeight},
+			joins:     moviePopularityJoin,
+			orderBy:   "popularity_score " + direction + ", movies.id ASC",
+		}
+		if after != nil {
+			if after.Score == nil {
+				return movieOrder{}, ErrCursorWithoutSortValue
+			}
+
+			order.having = "\nHAVING " + keysetCondition("popularity_score", comparison)
+			order.havingArgs = []interface{}{*after.Score, *after.Score, after.ID}
+		}
+
+		return order, nil
+	case entity.MovieSortRelevance:
+		if args.Query == nil && args.Hits == nil {
+			return movieOrder{}, ErrRelevanceWithoutQuery
+		}
+
+		order := movieOrder{orderBy: "relevance_score " + direction + ", movies.id ASC"}
+		if after != nil {
+			if after.Score == nil {
+				return movieOrder{}, ErrCursorWithoutSortValue
+			}
+
+			order.having = "\nHAVING " + keysetCondition("relevance_score", comparison)
+			order.havingArgs = []interface{}{*after.Score, *after.Score, after.ID}
+		}
+
+		return order, nil
+	case entity.MovieSortTitle:
+		order := movieOrder{orderBy: "movies.original_title " + direction + ", movies.id ASC"}
+		if after != nil {
+			if after.Title == nil {
+				return movieOrder{}, ErrCursorWithoutSortValue
+			}
+
+			order.after = "\nAND " + keysetCondition("movies.original_title", comparison)
+			order.afterArgs = []interface{}{*after.Title, *after.Title, after.ID}
+		}
+
+		return order, nil
+	case entity.MovieSortReleaseDate:
+		return releaseDateMovieOrder(direction, comparison, after)
+	case entity.MovieSortRevenue:
+		var value interface{}
+		if after != nil && after.Revenue != nil {
+			value = *after.Revenue
+		}
+
+		return nullableMovieOrder("movies.revenue", direction, comparison, after, value), nil
+	}
+
+	return movieOrder{}, fmt.Errorf("unknown sort: %s", args.Sort.Field)
+}
+
+// setSortValue sets the value of the sort of the movie to its cursor, score is the selected score of the movie
+func setSortValue(cursor *repository.MovieCursor, sort repository.MovieSort, movie *entity.Movie, score *float64) {
+	cursor.Sort, cursor.Desc = sort.Field, sort.Desc
+
+	switch sort.Field {
+	case entity.MovieSortPopularity, entity.MovieSortRelevance:
+		cursor.Score = score
+	case entity.MovieSortTitle:
+		cursor.Title = &movie.OriginalTitle
+	case entity.MovieSortReleaseDate:
+		cursor.ReleaseDate, cursor.ReleaseDatePrecision = movie.ReleaseDate, movie.ReleaseDatePrecision
+	case entity.MovieSortRevenue:
+		cursor.Revenue = movie.Revenue
+	}
+}
+
+// hitsRelevanceScore selects the score of the hit of the movie as its relevance score
+func hitsRelevanceScore(hits []repository.MovieHit) (string, []interface{}) {
+	args := make([]interface{}, 0, 2*len(hits))
+	for _, hit := range hits {
+		args = append(args, hit.MovieID, hit.Score)
+	}
+
+	return ",\nCASE movies.id" + strings.Repeat(" WHEN ? THEN ?", len(hits)) + " END AS relevance_score", args
+}
+
+// hitsCondition returns the condition which matches the movies of the hits and its arguments
+func hitsCondition(hits []repository.MovieHit) (string, []interface{}) {
+	args := make([]interface{}, len(hits))
+	for i, hit := range hits {
+		args[i] = hit.MovieID
+	}
+
+	return fmt.Sprintf("\nAND movies.id IN (%s)", strings.TrimSuffix(strings.Repeat("?, ", len(hits)), ", ")), args
+}
+
+// findMovies lists the movies, the first three %s are the relevance score, the score which orders them and its
+// joins, the others are the conditions of the WHERE clause, the HAVING clause and the ORDER BY expressions
+const findMovies = `SELECT ` + movieColumns + `%s%s
+FROM movies
+` + movieRatingStatsJoin + `%s
+WHERE movies.deleted_at IS NULL%s%s%s%s%s%s
+ORDER BY %s
+LIMIT ?`
+
+func (r *movieRepository) FindMovies(ctx context.Context, args repository.FindMoviesParams) (*repository.MoviePage, error) {
+	order, err := orderMovies(args)
+	if err != nil {
+		return nil, err
+	}
+
+	relevance, search, queryArgs, searchArgs := "", "", make([]interface{}, 0), []interface{}(nil)
+	switch {
+	case args.Hits != nil:
+		if len(args.Hits) == 0 {
+			return &repository.MoviePage{Movies: make([]*entity.Movie, 0)}, nil
+		}
+
+		relevance, queryArgs = hitsRelevanceScore(args.Hits)
+		search, searchArgs = hitsCondition(args.Hits)
+	case args.Query != nil:
+		expression := relevanceExpression(*args.Query)
+		relevance = movieRelevanceScore
+		queryArgs = append(queryArgs, expression, expression, expression, expression, expression)
+		search, searchArgs = searchCondition(*args.Query)
+		search = "\nAND " + search
+	}
+	filter, filterArgs := filterCondition(args.Filter)
+	genres, genresArgs := genresCondition(args.Genres)
+	release, releaseArgs := releaseCondition(args.Release)
+	queryArgs = append(queryArgs, order.scoreArgs...)
+	queryArgs = append(queryArgs, searchArgs...)
+	queryArgs = append(queryArgs, filterArgs...)
+	queryArgs = append(queryArgs, genresArgs...)
+	queryArgs = append(queryArgs, releaseArgs...)
+	queryArgs = append(queryArgs, order.afterArgs...)
+	queryArgs = append(queryArgs, order.havingArgs...)
+	queryArgs = append(queryArgs, args.Page.Limit+1)
+	query := fmt.Sprintf(findMovies, relevance, order.score, order.joins, search, filter, genres, release, order.after,
+		order.having, order.orderBy)
 	movies := make([]*entity.Movie, 0)
+	scores := make([]*float64, 0)
 
-	rows, err := r.connManager.GetReader().QueryxContext(ctx, query)
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, query, queryArgs...)
 	if err != nil {
 		return nil, fmt.Errorf("QueryxContext: %w", err)
 	}
@@ -108,27 +524,200 @@ func (r *movieRepository) FindPopularMovies(ctx context.Context, limit uint) ([]
 	for rows.Next() {
 		movie := &struct {
 			*Movie
-			FavoriteNumber uint `json:"favorite_number" db:"favorite_number"`
+			PopularityScore *float64 `json:"popularity_score" db:"popularity_score"`
+			RelevanceScore  *float64 `json:"relevance_score" db:"relevance_score"`
 		}{}
 		if err = rows.StructScan(movie); err != nil {
 			return nil, fmt.Errorf("StructScan: %w", err)
 		}
 
-		movies = append(movies, &entity.Movie{
-			ID:               movie.ID,
-			OriginalTitle:    movie.OriginalTitle,
-			OriginalLanguage: movie.OriginalLanguage,
-			Overview:         movie.Overview,
-			PosterPath:       movie.PosterPath,
-			BackdropPath:     movie.BackdropPath,
-			Adult:            movie.Adult,
-			ReleaseDate:      movie.ReleaseDate,
-			Budget:           movie.Budget,
-			Revenue:          movie.Revenue,
-			CreatedAt:        movie.CreatedAt,
-			UpdatedAt:        movie.UpdatedAt,
-		})
+		foundMovie := movie.toEntity()
+		foundMovie.Score = movie.RelevanceScore
+		movies = append(movies, foundMovie)
+		if args.Sort.Field == entity.MovieSortRelevance {
+			scores = append(scores, movie.RelevanceScore)
+		} else {
+			scores = append(scores, movie.PopularityScore)
+		}
+	}
+
+	page := toMoviePage(movies, args.Page.Limit)
+	if page.Next != nil {
+		setSortValue(page.Next, args.Sort, page.Movies[len(page.Movies)-1], scores[len(page.Movies)-1])
+	}
+
+	if err := attachGenres(ctx, r.connManager.GetReader(), page.Movies); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
+	}
+
+	return page, nil
+}
+
+const createMovieQuery = `INSERT INTO movies(original_title, original_language, overview, poster_path, backdrop_path,
+adult, release_date, release_date_precision, budget, revenue, currency) VALUES (?,?,?,?,?,?,?,?,?,?,?)`
+
+func (r *movieRepository) CreateMovie(ctx context.Context, args repository.MovieParams) (*entity.Movie, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, createMovieQuery, args.OriginalTitle, args.OriginalLanguage,
+		args.Overview, args.PosterPath, args.BackdropPath, args.Adult, dateValue(args.ReleaseDate),
+		args.ReleaseDatePrecision, args.Budget, args.Revenue, args.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	createdMovieID, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("LastInsertId: %w", err)
+	}
+
+	movie := &Movie{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findByID, createdMovieID).StructScan(movie); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return r.withGenres(ctx, movie.toEntity())
+}
+
+const updateMovieQuery = `UPDATE movies SET original_title = ?, original_language = ?, overview = ?, poster_path = ?,
+backdrop_path = ?, adult = ?, release_date = ?, release_date_precision = ?, budget = ?, revenue = ?, currency = ?
+WHERE id = ? AND deleted_at IS NULL`
+
+func (r *movieRepository) UpdateMovie(ctx context.Context, args repository.UpdateMovieParams) (*entity.Movie, error) {
+	if _, err := r.connManager.GetWriter().ExecContext(ctx, updateMovieQuery, args.OriginalTitle, args.OriginalLanguage,
+		args.Overview, args.PosterPath, args.BackdropPath, args.Adult, dateValue(args.ReleaseDate),
+		args.ReleaseDatePrecision, args.Budget, args.Revenue, args.Currency, args.ID); err != nil {
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	movie := &Movie{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findByID, args.ID).StructScan(movie); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return r.withGenres(ctx, movie.toEntity())
+}
+
+const deleteMovieQuery = `UPDATE movies SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL`
+
+func (r *movieRepository) DeleteMovie(ctx context.Context, movieID uint64) error {
+	if _, err := r.connManager.GetWriter().ExecContext(ctx, deleteMovieQuery, movieID); err != nil {
+		return fmt.Errorf("ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+const restoreMovieQuery = `UPDATE movies SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
+func (r *movieRepository) RestoreMovie(ctx context.Context, movieID uint64) (*entity.Movie, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, restoreMovieQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	restored, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("RowsAffected: %w", err)
+	}
+
+	if restored == 0 {
+		return nil, nil
+	}
+
+	movie := &Movie{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findByID, movieID).StructScan(movie); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return r.withGenres(ctx, movie.toEntity())
+}
+
+// withGenres attaches the genres to the movie which has just been written, the writer is used so that the
+// movie is read even when the reader is behind
+func (r *movieRepository) withGenres(ctx context.Context, movie *entity.Movie) (*entity.Movie, error) {
+	if err := attachGenres(ctx, r.connManager.GetWriter(), []*entity.Movie{movie}); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
+	}
+
+	return movie, nil
+}
+
+func (m *Movie) toEntity() *entity.Movie {
+	movie := &entity.Movie{
+		ID:                   m.ID,
+		OriginalTitle:        m.OriginalTitle,
+		Title:                m.OriginalTitle,
+		OriginalLanguage:     m.OriginalLanguage,
+		Overview:             m.Overview,
+		PosterPath:           m.PosterPath,
+		BackdropPath:         m.BackdropPath,
+		Adult:                m.Adult,
+		ReleaseDate:          datePtr(m.ReleaseDate),
+		ReleaseDatePrecision: m.ReleaseDatePrecision,
+		Budget:               m.Budget,
+		Revenue:              m.Revenue,
+		Currency:             m.Currency,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+	}
+
+	ratingCount := uint64Value(m.RatingCount)
+	if ratingCount > 0 {
+		movie.RatingCount = ratingCount
+		movie.AverageRating = averageRating(uint64Value(m.RatingSum), ratingCount)
+		movie.RatingDistribution = entity.RatingDistribution{
+			uint64Value(m.Rating1), uint64Value(m.Rating2), uint64Value(m.Rating3), uint64Value(m.Rating4),
+			uint64Value(m.Rating5), uint64Value(m.Rating6), uint64Value(m.Rating7), uint64Value(m.Rating8),
+			uint64Value(m.Rating9), uint64Value(m.Rating10),
+		}
+	}
+
+	criticRatingCount := uint64Value(m.CriticRatingCount)
+	if criticRatingCount > 0 {
+		movie.CriticRatingCount = criticRatingCount
+		movie.CriticScore = averageRating(uint64Value(m.CriticRatingSum), criticRatingCount)
+	}
+
+	if audienceRatingCount := ratingCount - criticRatingCount; audienceRatingCount > 0 {
+		movie.AudienceRatingCount = audienceRatingCount
+		movie.AudienceScore = averageRating(uint64Value(m.RatingSum)-uint64Value(m.CriticRatingSum), audienceRatingCount)
+	}
+
+	return movie
+}
+
+// averageRating returns the average of ratings rounded to 2 decimal places
+func averageRating(sum uint64, count uint64) float64 {
+	return math.Round(float64(sum)/float64(count)*100) / 100
+}
+
+func uint64Value(v *uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+// datePtr returns the date of a DATE column, which is scanned as the start of the date in UTC
+func datePtr(t *time.Time) *entity.Date {
+	if t == nil {
+		return nil
+	}
+
+	date := entity.DateOf(*t)
+	return &date
+}
+
+// dateValue returns the value of a DATE column, the date is formatted so that it is not converted by the time zone
+// of the connection
+func dateValue(date *entity.Date) interface{} {
+	if date == nil {
+		return nil
 	}
 
-	return movies, nil
+	return date.String()
 }
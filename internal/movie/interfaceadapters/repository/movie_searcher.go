@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// mysqlMovieSearcher searches the movies with the FULLTEXT indexes of MySQL, which are kept in sync by MySQL itself
+type mysqlMovieSearcher struct {
+	movieRepository repository.MovieRepository
+}
+
+func NewMySQLMovieSearcher(movieRepository repository.MovieRepository) *mysqlMovieSearcher {
+	return &mysqlMovieSearcher{movieRepository: movieRepository}
+}
+
+func (s *mysqlMovieSearcher) SearchMovies(ctx context.Context, args repository.FindMoviesParams) (*repository.MoviePage, error) {
+	return s.movieRepository.FindMovies(ctx, args)
+}
+
+func (s *mysqlMovieSearcher) IndexMovie(ctx context.Context, movieID uint64) error {
+	return nil
+}
+
+func (s *mysqlMovieSearcher) RemoveMovie(ctx context.Context, movieID uint64) error {
+	return nil
+}
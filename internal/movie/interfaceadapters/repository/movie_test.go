@@ -9,6 +9,8 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -43,7 +45,7 @@ func (s *testMovieRepositorySuite) TestFindByID() {
 			input: testInput{
 				movieID: 1,
 				mocks: func(mock sqlmock.Sqlmock) {
-					rows := sqlmock.NewRows(moviesTableRows)
+					rows := sqlmock.NewRows(append(moviesTableRows, "currency"))
 					rows.AddRow(
 						1,
 						"accumsan sed, facilisis vitae,",
@@ -53,14 +55,24 @@ func (s *testMovieRepositorySuite) TestFindByID() {
 						nil,
 						false,
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
-						100000,
-						1000000,
+						35600000000,
+						279750132800,
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
-						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						"USD")
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at FROM movies WHERE id = ?`)).
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + movieColumnsQuery + `
+					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
 						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows).AddRow(1, 7, "Drama", "drama").AddRow(1, 11, "Horror", "horror"))
 				},
 			},
 			expected: testOutput{
@@ -68,14 +80,115 @@ func (s *testMovieRepositorySuite) TestFindByID() {
 				movie: &entity.Movie{
 					ID:               1,
 					OriginalTitle:    "accumsan sed, facilisis vitae,",
+					Title:            "accumsan sed, facilisis vitae,",
 					OriginalLanguage: "Nigeria",
 					Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 					Adult:            false,
-					ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
-					Revenue:          utils.Int64Ptr(1000000),
-					Budget:           utils.Uint64Ptr(100000),
+					ReleaseDate:      datePtr("2022-08-20"),
+					Revenue:          utils.Int64Ptr(279750132800),
+					Budget:           utils.Uint64Ptr(35600000000),
+					Currency:         utils.StringPtr("USD"),
 					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:           []entity.Genre{{ID: 7, Name: "Drama", Slug: "drama"}, {ID: 11, Name: "Horror", Slug: "horror"}},
+				},
+			},
+		},
+		{
+			name: "returns_movie_with_rating_aggregates_when_movie_is_rated",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, ratingStatsTableRows...))
+					rows.AddRow(
+						1,
+						"accumsan sed, facilisis vitae,",
+						"Nigeria",
+						nil,
+						nil,
+						nil,
+						false,
+						nil,
+						nil,
+						nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						3, 20, 0, 0, 0, 0, 0, 0, 1, 0, 0, 2)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				err: nil,
+				movie: &entity.Movie{
+					ID:                  1,
+					OriginalTitle:       "accumsan sed, facilisis vitae,",
+					Title:               "accumsan sed, facilisis vitae,",
+					OriginalLanguage:    "Nigeria",
+					CreatedAt:           utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:           utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:              []entity.Genre{},
+					AverageRating:       6.67,
+					RatingCount:         3,
+					RatingDistribution:  entity.RatingDistribution{0, 0, 0, 0, 0, 0, 1, 0, 0, 2},
+					AudienceScore:       6.67,
+					AudienceRatingCount: 3,
+				},
+			},
+		},
+		{
+			name: "returns_movie_with_critic_and_audience_scores_when_movie_is_rated_by_critic",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					columns := append(append(moviesTableRows, ratingStatsTableRows...), criticRatingStatsTableRows...)
+					rows := sqlmock.NewRows(columns)
+					rows.AddRow(
+						1,
+						"accumsan sed, facilisis vitae,",
+						"Nigeria",
+						nil,
+						nil,
+						nil,
+						false,
+						nil,
+						nil,
+						nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						3, 20, 0, 0, 0, 0, 0, 0, 1, 0, 0, 2,
+						2, 17)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				err: nil,
+				movie: &entity.Movie{
+					ID:                  1,
+					OriginalTitle:       "accumsan sed, facilisis vitae,",
+					Title:               "accumsan sed, facilisis vitae,",
+					OriginalLanguage:    "Nigeria",
+					CreatedAt:           utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:           utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:              []entity.Genre{},
+					AverageRating:       6.67,
+					RatingCount:         3,
+					RatingDistribution:  entity.RatingDistribution{0, 0, 0, 0, 0, 0, 1, 0, 0, 2},
+					CriticScore:         8.5,
+					CriticRatingCount:   2,
+					AudienceScore:       3,
+					AudienceRatingCount: 1,
 				},
 			},
 		},
@@ -99,8 +212,13 @@ func (s *testMovieRepositorySuite) TestFindByID() {
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at FROM movies WHERE id = ?`)).
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + movieColumnsQuery + `
+					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
 						WillReturnError(fmt.Errorf("dummy error"))
 				},
 			},
@@ -125,17 +243,23 @@ func (s *testMovieRepositorySuite) TestFindByID() {
 	}
 }
 
-func (s *testMovieRepositorySuite) TestFindByKeyword() {
+func (s *testMovieRepositorySuite) TestFindMovies() {
 	type testInput struct {
-		keyword string
-		mocks   func(mock sqlmock.Sqlmock)
+		args  usecaserepository.FindMoviesParams
+		mocks func(mock sqlmock.Sqlmock)
 	}
 
 	type testOutput struct {
-		movies []*entity.Movie
-		err    error
+		page *usecaserepository.MoviePage
+		err  error
 	}
 
+	testQuery := searchquery.Query{Terms: []searchquery.Term{{Words: []string{"test"}}}}
+
+	testPage := usecaserepository.PageParams{Limit: 20}
+
+	popularitySort := usecaserepository.MovieSort{Field: entity.MovieSortPopularity, Desc: true}
+
 	cases := []struct {
 		name     string
 		input    testInput
@@ -144,7 +268,7 @@ func (s *testMovieRepositorySuite) TestFindByKeyword() {
 		{
 			name: "returns_movies_match_keyword",
 			input: testInput{
-				keyword: "test",
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: testPage},
 				mocks: func(mock sqlmock.Sqlmock) {
 					rows := sqlmock.NewRows(moviesTableRows)
 					rows.AddRow(
@@ -174,99 +298,337 @@ func (s *testMovieRepositorySuite) TestFindByKeyword() {
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					+ MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
+					+ IFNULL((SELECT MAX(MATCH (people.name) AGAINST (? IN BOOLEAN MODE)) FROM credits
+					INNER JOIN people ON credits.person_id = people.id WHERE credits.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					FROM movie_translations WHERE movie_translations.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)) FROM movie_tags
+					INNER JOIN tags ON movie_tags.tag_id = tags.id WHERE movie_tags.movie_id = movies.id), 0) AS relevance_score
 					FROM movies
-					WHERE MATCH (original_title, overview, original_language) AGAINST ('test*' IN BOOLEAN MODE)
-					ORDER BY id ASC`)).
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.deleted_at IS NULL
+					AND (MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					OR movies.id IN (SELECT credits.movie_id FROM credits INNER JOIN people ON credits.person_id = people.id
+					WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+					WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 21).
 						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1, 2).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
 				},
 			},
 			expected: testOutput{
-				movies: []*entity.Movie{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "test sed, facilisis vitae,",
+						Title:            "test sed, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
 					},
 					{
 						ID:               2,
 						OriginalTitle:    "sed, facilisis vitae,",
+						Title:            "sed, facilisis vitae,",
 						OriginalLanguage: "test",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
+					},
+				}},
+			},
+		},
+		{
+			name: "returns_movies_match_keyword_of_any_of_genres",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Genres: []string{"horror", "thriller"}, Page: testPage},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(1, "test sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+					WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					AND EXISTS (SELECT 1 FROM movie_genres INNER JOIN genres ON movie_genres.genre_id = genres.id
+					WHERE movie_genres.movie_id = movies.id AND genres.slug IN (?, ?))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", "horror", "thriller", 21).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows).AddRow(1, 11, "Horror", "horror"))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
+					{
+						ID:               1,
+						OriginalTitle:    "test sed",
+						Title:            "test sed",
+						OriginalLanguage: "Nigeria",
+						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{{ID: 11, Name: "Horror", Slug: "horror"}},
+					},
+				}},
+			},
+		},
+		{
+			name: "returns_movies_match_keyword_released_in_country",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: testPage, Release: usecaserepository.ReleaseFilter{
+					Country:        "JP",
+					ReleasedFrom:   utils.TimePtr(utils.MustRFC3339Time("2021-01-01T00:00:00+00:00")),
+					ReleasedBefore: utils.TimePtr(utils.MustRFC3339Time("2023-01-01T00:00:00+00:00")),
+					MaxMinimumAge:  utils.Uint8Ptr(12),
+				}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					AND EXISTS (SELECT 1 FROM release_dates
+					WHERE release_dates.movie_id = movies.id AND release_dates.country = ?
+					AND release_dates.release_date >= ? AND release_dates.release_date < ? AND release_dates.minimum_age <= ?)
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", "JP", utils.MustRFC3339Time("2021-01-01T00:00:00+00:00"),
+							utils.MustRFC3339Time("2023-01-01T00:00:00+00:00"), 12, 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_movies_whose_release_date_overlaps_dates_when_release_filter_has_only_dates",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: testPage, Release: usecaserepository.ReleaseFilter{
+					ReleasedFrom:   utils.TimePtr(utils.MustRFC3339Time("1927-06-01T00:00:00+00:00")),
+					ReleasedBefore: utils.TimePtr(utils.MustRFC3339Time("1928-01-01T00:00:00+00:00")),
+				}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					AND (EXISTS (SELECT 1 FROM release_dates
+					WHERE release_dates.movie_id = movies.id
+					AND release_dates.release_date >= ? AND release_dates.release_date < ?)
+					OR (CASE movies.release_date_precision
+					WHEN 'year' THEN DATE_ADD(movies.release_date, INTERVAL 1 YEAR)
+					WHEN 'month' THEN DATE_ADD(movies.release_date, INTERVAL 1 MONTH)
+					ELSE DATE_ADD(movies.release_date, INTERVAL 1 DAY) END > ? AND movies.release_date < ?))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", utils.MustRFC3339Time("1927-06-01T00:00:00+00:00"),
+							utils.MustRFC3339Time("1928-01-01T00:00:00+00:00"),
+							utils.MustRFC3339Time("1927-06-01T00:00:00+00:00"),
+							utils.MustRFC3339Time("1928-01-01T00:00:00+00:00"), 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "ignores_country_when_release_filter_has_no_other_condition",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: testPage, Release: usecaserepository.ReleaseFilter{
+					Country: "JP",
+				}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_movies_satisfying_every_term_of_query",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &searchquery.Query{Terms: []searchquery.Term{
+					{Words: []string{"the", "thing"}, Field: searchquery.FieldTitle, Phrase: true},
+					{Words: []string{"carp"}, Prefix: true},
+					{Words: []string{"remake"}, Exclude: true},
+				}}, Page: testPage},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					AND (MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title) AGAINST (? IN BOOLEAN MODE)))
+					AND (MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					OR movies.id IN (SELECT credits.movie_id FROM credits INNER JOIN people ON credits.person_id = people.id
+					WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+					WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					AND NOT (MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					OR movies.id IN (SELECT credits.movie_id FROM credits INNER JOIN people ON credits.person_id = people.id
+					WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+					WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs(`"the thing" carp*`, `"the thing" carp*`, `"the thing" carp*`, `"the thing" carp*`, `"the thing" carp*`,
+							`"the thing"`, `"the thing"`, "carp*", "carp*", "carp*", "carp*",
+							"remake", "remake", "remake", "remake", 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_page_after_cursor_with_next_cursor_when_there_are_more_movies",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: usecaserepository.PageParams{
+					Limit: 1,
+					After: &usecaserepository.MovieCursor{ID: 3},
+				}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(4, "test sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					rows.AddRow(5, "test ac", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					AND movies.id > ?
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 3, 2).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:               4,
+							OriginalTitle:    "test sed",
+							Title:            "test sed",
+							OriginalLanguage: "Nigeria",
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
 					},
+					Next: &usecaserepository.MovieCursor{ID: 4},
+				},
+			},
+		},
+		{
+			name: "returns_empty_page_without_next_cursor_when_limit_is_0",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: usecaserepository.PageParams{Limit: 0}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(4, "test sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 1).
+						WillReturnRows(rows)
 				},
 			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
 		},
 		{
 			name: "returns_errors_when_query_failed",
 			input: testInput{
-				keyword: "test",
+				args: usecaserepository.FindMoviesParams{Query: &testQuery, Page: testPage},
 				mocks: func(mock sqlmock.Sqlmock) {
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					+ MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
+					+ IFNULL((SELECT MAX(MATCH (people.name) AGAINST (? IN BOOLEAN MODE)) FROM credits
+					INNER JOIN people ON credits.person_id = people.id WHERE credits.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					FROM movie_translations WHERE movie_translations.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)) FROM movie_tags
+					INNER JOIN tags ON movie_tags.tag_id = tags.id WHERE movie_tags.movie_id = movies.id), 0) AS relevance_score
 					FROM movies
-					WHERE MATCH (original_title, overview, original_language) AGAINST ('test*' IN BOOLEAN MODE)
-					ORDER BY id ASC`)).
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.deleted_at IS NULL
+					AND (MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					OR movies.id IN (SELECT credits.movie_id FROM credits INNER JOIN people ON credits.person_id = people.id
+					WHERE MATCH (people.name) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_translations.movie_id FROM movie_translations
+					WHERE MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					OR movies.id IN (SELECT movie_tags.movie_id FROM movie_tags INNER JOIN tags ON movie_tags.tag_id = tags.id
+					WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					ORDER BY movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 21).
 						WillReturnError(fmt.Errorf("dummy error"))
 				},
 			},
 			expected: testOutput{
-				movies: nil,
-				err:    fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+				page: nil,
+				err:  fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
 			},
 		},
-	}
-
-	for _, c := range cases {
-		s.T().Run(c.name, func(t *testing.T) {
-			manager, clean := initMockConnManager(t, c.input.mocks)
-			defer clean()
-
-			movieRepository := repository.NewMovieRepository(manager)
-
-			ctx := context.Background()
-			res, err := movieRepository.FindByKeyword(ctx, c.input.keyword)
-			assert.Equal(t, c.expected.movies, res)
-			assert.Equal(t, c.expected.err, err)
-		})
-	}
-}
-
-func (s *testMovieRepositorySuite) TestFindPopularMovies() {
-	type testInput struct {
-		limit uint64
-		mocks func(mock sqlmock.Sqlmock)
-	}
-
-	type testOutput struct {
-		movies []*entity.Movie
-		err    error
-	}
-
-	cases := []struct {
-		name     string
-		input    testInput
-		expected testOutput
-	}{
 		{
-			name: "returns_popular_movies",
+			name: "returns_movies_ordered_by_popularity",
 			input: testInput{
-				limit: 10,
+				args: usecaserepository.FindMoviesParams{
+					Sort:           popularitySort,
+					Page:           usecaserepository.PageParams{Limit: 10},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+				},
 				mocks: func(mock sqlmock.Sqlmock) {
 					rows := sqlmock.NewRows(moviesTableRows)
 					rows.AddRow(
@@ -296,78 +658,999 @@ func (s *testMovieRepositorySuite) TestFindPopularMovies() {
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at, IFNULL(favorite_numbers.favorite_number, 0) as favorite_number
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					? * IFNULL((IFNULL(movie_rating_stats.rating_sum, 0) + ? * rating_means.mean_rating)
+					/ (IFNULL(movie_rating_stats.rating_count, 0) + ?), 0)
+					+ ? * LN(1 + IFNULL(favorite_numbers.favorite_number, 0)) AS popularity_score
 					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
 					LEFT JOIN (SELECT movie_id, count(*) AS favorite_number FROM favorites GROUP BY movie_id) AS favorite_numbers
 					ON movies.id = favorite_numbers.movie_id
-					ORDER BY favorite_number DESC
-					LIMIT 10`)).
+					CROSS JOIN (SELECT IFNULL(SUM(rating_sum) / SUM(rating_count), 0) AS mean_rating FROM movie_rating_stats) AS rating_means
+					WHERE movies.deleted_at IS NULL
+					ORDER BY popularity_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(1.0, 10, 10, 0.5, 11).
 						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1, 2).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
 				},
 			},
 			expected: testOutput{
-				movies: []*entity.Movie{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "test sed, facilisis vitae,",
+						Title:            "test sed, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
 					},
 					{
 						ID:               2,
 						OriginalTitle:    "sed, facilisis vitae,",
+						Title:            "sed, facilisis vitae,",
 						OriginalLanguage: "test",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
 					},
+				}},
+			},
+		},
+		{
+			name: "returns_movies_ordered_by_popularity_of_any_of_genres",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort:           popularitySort,
+					Page:           usecaserepository.PageParams{Limit: 10},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+					Genres:         []string{"horror"},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					AND EXISTS (SELECT 1 FROM movie_genres INNER JOIN genres ON movie_genres.genre_id = genres.id
+					WHERE movie_genres.movie_id = movies.id AND genres.slug IN (?))
+					ORDER BY popularity_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(1.0, 10, 10, 0.5, "horror", 11).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
 				},
 			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
 		},
 		{
-			name: "returns_errors_when_query_failed",
+			name: "returns_movies_ordered_by_popularity_of_genres_and_release_type",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort:           popularitySort,
+					Page:           usecaserepository.PageParams{Limit: 10},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+					Genres:         []string{"horror"},
+					Release:        usecaserepository.ReleaseFilter{ReleaseType: "digital"},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movie_genres.movie_id = movies.id AND genres.slug IN (?))
+					AND EXISTS (SELECT 1 FROM release_dates
+					WHERE release_dates.movie_id = movies.id AND release_dates.release_type = ?)
+					ORDER BY popularity_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(1.0, 10, 10, 0.5, "horror", "digital", 11).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_page_after_popularity_cursor_with_score_of_last_movie_in_next_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: popularitySort,
+					Page: usecaserepository.PageParams{
+						Limit: 1,
+						After: &usecaserepository.MovieCursor{ID: 3, Score: utils.Float64Ptr(2.5)},
+					},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "popularity_score"))
+					rows.AddRow(4, "test sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 2.5)
+					rows.AddRow(1, "test ac", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 1.25)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					HAVING (popularity_score < ? OR (popularity_score = ? AND movies.id > ?))
+					ORDER BY popularity_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(1.0, 10, 10, 0.5, 2.5, 2.5, 3, 2).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:               4,
+							OriginalTitle:    "test sed",
+							Title:            "test sed",
+							OriginalLanguage: "Nigeria",
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
+					},
+					Next: &usecaserepository.MovieCursor{ID: 4, Sort: entity.MovieSortPopularity, Desc: true,
+						Score: utils.Float64Ptr(2.5)},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_popularity_cursor_has_no_score",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort:           popularitySort,
+					Page:           usecaserepository.PageParams{Limit: 1, After: &usecaserepository.MovieCursor{ID: 3}},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrCursorWithoutSortValue,
+			},
+		},
+		{
+			name: "returns_errors_when_popularity_query_failed",
 			input: testInput{
-				limit: 10,
+				args: usecaserepository.FindMoviesParams{
+					Sort:           popularitySort,
+					Page:           usecaserepository.PageParams{Limit: 10},
+					MinimumVotes:   10,
+					RatingWeight:   1,
+					FavoriteWeight: 0.5,
+				},
 				mocks: func(mock sqlmock.Sqlmock) {
 					mock.
-						ExpectQuery(regexp.QuoteMeta(`SELECT id, original_title, original_language, overview, poster_path, backdrop_path,
-					adult, release_date, budget, revenue, created_at, updated_at, IFNULL(favorite_numbers.favorite_number, 0) as favorite_number
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					? * IFNULL((IFNULL(movie_rating_stats.rating_sum, 0) + ? * rating_means.mean_rating)
+					/ (IFNULL(movie_rating_stats.rating_count, 0) + ?), 0)
+					+ ? * LN(1 + IFNULL(favorite_numbers.favorite_number, 0)) AS popularity_score
 					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
 					LEFT JOIN (SELECT movie_id, count(*) AS favorite_number FROM favorites GROUP BY movie_id) AS favorite_numbers
 					ON movies.id = favorite_numbers.movie_id
-					ORDER BY favorite_number DESC
-					LIMIT 10`)).
+					CROSS JOIN (SELECT IFNULL(SUM(rating_sum) / SUM(rating_count), 0) AS mean_rating FROM movie_rating_stats) AS rating_means
+					WHERE movies.deleted_at IS NULL
+					ORDER BY popularity_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(1.0, 10, 10, 0.5, 11).
 						WillReturnError(fmt.Errorf("dummy error"))
 				},
 			},
 			expected: testOutput{
-				movies: nil,
-				err:    fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+				page: nil,
+				err:  fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
 			},
 		},
-	}
-
-	for _, c := range cases {
-		s.T().Run(c.name, func(t *testing.T) {
-			manager, clean := initMockConnManager(t, c.input.mocks)
-			defer clean()
-
-			movieRepository := repository.NewMovieRepository(manager)
-
-			ctx := context.Background()
-			res, err := movieRepository.FindPopularMovies(ctx, uint(c.input.limit))
-			assert.Equal(t, c.expected.movies, res)
+		{
+			name: "returns_movies_matching_every_condition_of_filter",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Filter: usecaserepository.MovieFilter{
+						OriginalLanguage: "en",
+						Adult:            utils.BoolPtr(false),
+						ReleaseYearFrom:  utils.IntPtr(1990),
+						ReleaseYearTo:    utils.IntPtr(1999),
+						Currency:         "USD",
+						MinBudget:        utils.Uint64Ptr(100),
+						MaxBudget:        utils.Uint64Ptr(200),
+						MinRevenue:       utils.Int64Ptr(300),
+						MaxRevenue:       utils.Int64Ptr(400),
+						MinRating:        utils.Float64Ptr(7.5),
+					},
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortTitle},
+					Page: testPage,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`
+					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.deleted_at IS NULL
+					AND movies.original_language = ? AND movies.adult = ? AND movies.release_date >= ?
+					AND movies.release_date < ? AND movies.currency = ? AND movies.budget >= ? AND movies.budget <= ?
+					AND movies.revenue >= ? AND movies.revenue <= ?
+					AND movie_rating_stats.rating_sum / movie_rating_stats.rating_count >= ?
+					ORDER BY movies.original_title ASC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs("en", false, "1990-01-01", "2000-01-01", "USD", 100, 200, 300, 400, 7.5, 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_movies_ordered_by_relevance_with_score_after_cursor_with_score_in_next_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Query: &searchquery.Query{Terms: []searchquery.Term{
+						{Words: []string{"test"}},
+						{Words: []string{"remake"}, Exclude: true},
+					}},
+					Filter: usecaserepository.MovieFilter{OriginalLanguage: "en"},
+					Sort:   usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page: usecaserepository.PageParams{
+						Limit: 1,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortRelevance, Desc: true,
+							Score: utils.Float64Ptr(2.5)},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "relevance_score"))
+					rows.AddRow(4, "test sed", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 2.5)
+					rows.AddRow(1, "test ac", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 1.25)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)
+					+ MATCH (movies.original_title) AGAINST (? IN BOOLEAN MODE)
+					+ IFNULL((SELECT MAX(MATCH (people.name) AGAINST (? IN BOOLEAN MODE)) FROM credits
+					INNER JOIN people ON credits.person_id = people.id WHERE credits.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (movie_translations.title, movie_translations.overview) AGAINST (? IN BOOLEAN MODE))
+					FROM movie_translations WHERE movie_translations.movie_id = movies.id), 0)
+					+ IFNULL((SELECT MAX(MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)) FROM movie_tags
+					INNER JOIN tags ON movie_tags.tag_id = tags.id WHERE movie_tags.movie_id = movies.id), 0) AS relevance_score
+					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.deleted_at IS NULL
+					AND (MATCH (movies.original_title, movies.overview, movies.original_language) AGAINST (? IN BOOLEAN MODE)`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", "remake", "remake", "remake", "remake",
+							"en", 2.5, 2.5, 3, 2).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:               4,
+							OriginalTitle:    "test sed",
+							Title:            "test sed",
+							OriginalLanguage: "en",
+							Score:            utils.Float64Ptr(2.5),
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
+					},
+					Next: &usecaserepository.MovieCursor{ID: 4, Sort: entity.MovieSortRelevance, Desc: true,
+						Score: utils.Float64Ptr(2.5)},
+				},
+			},
+		},
+		{
+			name: "ranks_and_scores_movie_matching_only_person_credited_in_it",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Query: &searchquery.Query{Terms: []searchquery.Term{{Words: []string{"nolan"}}}},
+					Sort:  usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page:  testPage,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "relevance_score"))
+					rows.AddRow(7, "Inception", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 0.75)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`+ IFNULL((SELECT MAX(MATCH (people.name) AGAINST (? IN BOOLEAN MODE)) FROM credits
+					INNER JOIN people ON credits.person_id = people.id WHERE credits.movie_id = movies.id), 0)`)+
+							".*"+regexp.QuoteMeta(`ORDER BY relevance_score DESC, movies.id ASC`)).
+						WithArgs("nolan", "nolan", "nolan", "nolan", "nolan", "nolan", "nolan", "nolan", "nolan", 21).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(7).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{
+					{
+						ID:               7,
+						OriginalTitle:    "Inception",
+						Title:            "Inception",
+						OriginalLanguage: "en",
+						Score:            utils.Float64Ptr(0.75),
+						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Genres:           []entity.Genre{},
+					},
+				}},
+			},
+		},
+		{
+			name: "continues_relevance_in_having_clause",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Query: &testQuery,
+					Sort:  usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page: usecaserepository.PageParams{
+						Limit: 20,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortRelevance, Desc: true,
+							Score: utils.Float64Ptr(2.5)},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE MATCH (tags.slug) AGAINST (? IN BOOLEAN MODE)))
+					HAVING (relevance_score < ? OR (relevance_score = ? AND movies.id > ?))
+					ORDER BY relevance_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs("test", "test", "test", "test", "test", "test", "test", "test", "test", 2.5, 2.5, 3, 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_movies_ordered_by_title_after_cursor_with_title_in_next_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortTitle},
+					Page: usecaserepository.PageParams{
+						Limit: 1,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortTitle,
+							Title: utils.StringPtr("alien")},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(4, "blade runner", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					rows.AddRow(1, "cube", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					AND (movies.original_title > ? OR (movies.original_title = ? AND movies.id > ?))
+					ORDER BY movies.original_title ASC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs("alien", "alien", 3, 2).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:               4,
+							OriginalTitle:    "blade runner",
+							Title:            "blade runner",
+							OriginalLanguage: "en",
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
+					},
+					Next: &usecaserepository.MovieCursor{ID: 4, Sort: entity.MovieSortTitle,
+						Title: utils.StringPtr("blade runner")},
+				},
+			},
+		},
+		{
+			name: "returns_movies_ordered_by_release_date_and_its_precision_with_unknown_release_dates_last",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortReleaseDate, Desc: true},
+					Page: usecaserepository.PageParams{
+						Limit: 1,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortReleaseDate, Desc: true,
+							ReleaseDate: datePtr("2022-01-01"), ReleaseDatePrecision: utils.StringPtr(entity.DatePrecisionMonth)},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "release_date_precision"))
+					rows.AddRow(4, "test sed", "en", nil, nil, nil, false,
+						utils.MustRFC3339Time("2022-01-01T00:00:00+00:00"), nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						entity.DatePrecisionYear)
+					rows.AddRow(1, "test ac", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						nil)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					AND (movies.release_date < ? OR (movies.release_date = ?
+					AND ((movies.release_date_precision + 0) < ? OR ((movies.release_date_precision + 0) = ? AND movies.id > ?)))
+					OR movies.release_date IS NULL)
+					ORDER BY movies.release_date IS NULL, movies.release_date DESC, movies.release_date_precision DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs("2022-01-01", "2022-01-01", 2, 2, 3, 2).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:                   4,
+							OriginalTitle:        "test sed",
+							Title:                "test sed",
+							OriginalLanguage:     "en",
+							ReleaseDate:          datePtr("2022-01-01"),
+							ReleaseDatePrecision: utils.StringPtr(entity.DatePrecisionYear),
+							CreatedAt:            utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:            utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:               []entity.Genre{},
+						},
+					},
+					Next: &usecaserepository.MovieCursor{ID: 4, Sort: entity.MovieSortReleaseDate, Desc: true,
+						ReleaseDate: datePtr("2022-01-01"), ReleaseDatePrecision: utils.StringPtr(entity.DatePrecisionYear)},
+				},
+			},
+		},
+		{
+			name: "continues_movies_of_unknown_revenue_after_cursor_of_unknown_revenue",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortRevenue, Desc: true},
+					Page: usecaserepository.PageParams{
+						Limit: 20,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortRevenue, Desc: true},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+					AND movies.revenue IS NULL AND movies.id > ?
+					ORDER BY movies.revenue IS NULL, movies.revenue DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(3, 21).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_movies_of_hits_ordered_by_score_of_hit_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Hits: []usecaserepository.MovieHit{{MovieID: 4, Score: 2.5}, {MovieID: 1, Score: 1.25}},
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page: usecaserepository.PageParams{
+						Limit: 20,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortRelevance, Desc: true,
+							Score: utils.Float64Ptr(3)},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(moviesTableRows, "relevance_score"))
+					rows.AddRow(4, "test sed", "en", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 2.5)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+movieColumnsQuery+`,
+					CASE movies.id WHEN ? THEN ? WHEN ? THEN ? END AS relevance_score
+					FROM movies
+					LEFT JOIN movie_rating_stats
+					ON movies.id = movie_rating_stats.movie_id
+					LEFT JOIN movie_critic_rating_stats
+					ON movies.id = movie_critic_rating_stats.movie_id
+					WHERE movies.deleted_at IS NULL
+					AND movies.id IN (?, ?)
+					HAVING (relevance_score < ? OR (relevance_score = ? AND movies.id > ?))
+					ORDER BY relevance_score DESC, movies.id ASC
+					LIMIT ?`)).
+						WithArgs(4, 2.5, 1, 1.25, 4, 1, 3.0, 3.0, 3, 21).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(4).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{
+					Movies: []*entity.Movie{
+						{
+							ID:               4,
+							OriginalTitle:    "test sed",
+							Title:            "test sed",
+							OriginalLanguage: "en",
+							Score:            utils.Float64Ptr(2.5),
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							Genres:           []entity.Genre{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_empty_page_without_query_when_there_is_no_hit",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Hits: []usecaserepository.MovieHit{},
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page: testPage,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				page: &usecaserepository.MoviePage{Movies: []*entity.Movie{}},
+			},
+		},
+		{
+			name: "returns_error_when_ordered_by_relevance_without_query",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortRelevance, Desc: true},
+					Page: testPage,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrRelevanceWithoutQuery,
+			},
+		},
+		{
+			name: "returns_error_when_title_cursor_has_no_title",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortTitle},
+					Page: usecaserepository.PageParams{
+						Limit: 20,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortTitle},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrCursorWithoutSortValue,
+			},
+		},
+		{
+			name: "returns_error_when_release_date_cursor_has_no_precision",
+			input: testInput{
+				args: usecaserepository.FindMoviesParams{
+					Sort: usecaserepository.MovieSort{Field: entity.MovieSortReleaseDate},
+					Page: usecaserepository.PageParams{
+						Limit: 20,
+						After: &usecaserepository.MovieCursor{ID: 3, Sort: entity.MovieSortReleaseDate,
+							ReleaseDate: datePtr("2022-08-20")},
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrCursorWithoutSortValue,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieRepository := repository.NewMovieRepository(manager)
+
+			ctx := context.Background()
+			res, err := movieRepository.FindMovies(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieRepositorySuite) TestCreateMovie() {
+	type testInput struct {
+		args  usecaserepository.MovieParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	args := usecaserepository.MovieParams{
+		OriginalTitle:        "accumsan sed, facilisis vitae,",
+		OriginalLanguage:     "Nigeria",
+		Overview:             utils.StringPtr("risus. Donec nibh enim"),
+		Adult:                true,
+		ReleaseDate:          datePtr("1927-01-01"),
+		ReleaseDatePrecision: utils.StringPtr("year"),
+		Budget:               utils.Uint64Ptr(100000),
+		Currency:             utils.StringPtr("USD"),
+	}
+
+	insertQuery := regexp.QuoteMeta(`INSERT INTO movies(original_title, original_language, overview, poster_path, backdrop_path,
+	adult, release_date, release_date_precision, budget, revenue, currency) VALUES (?,?,?,?,?,?,?,?,?,?,?)`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "creates_movie_and_returns_it",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(insertQuery).
+						WithArgs(args.OriginalTitle, args.OriginalLanguage, args.Overview, nil, nil, true, "1927-01-01",
+							args.ReleaseDatePrecision, args.Budget, nil, args.Currency).
+						WillReturnResult(sqlmock.NewResult(3, 1))
+
+					rows := sqlmock.NewRows(append(moviesTableRows, "currency", "release_date_precision"))
+					rows.AddRow(3, "accumsan sed, facilisis vitae,", "Nigeria", "risus. Donec nibh enim", nil, nil, true,
+						utils.MustRFC3339Time("1927-01-01T00:00:00+00:00"), 100000, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						"USD", "year")
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WithArgs(3).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(3).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:                   3,
+					OriginalTitle:        "accumsan sed, facilisis vitae,",
+					Title:                "accumsan sed, facilisis vitae,",
+					OriginalLanguage:     "Nigeria",
+					Overview:             utils.StringPtr("risus. Donec nibh enim"),
+					Adult:                true,
+					ReleaseDate:          datePtr("1927-01-01"),
+					ReleaseDatePrecision: utils.StringPtr("year"),
+					Budget:               utils.Uint64Ptr(100000),
+					Currency:             utils.StringPtr("USD"),
+					CreatedAt:            utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:            utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:               []entity.Genre{},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_insert_failed",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(insertQuery).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieRepository := repository.NewMovieRepository(manager)
+
+			ctx := context.Background()
+			res, err := movieRepository.CreateMovie(ctx, c.input.args)
+			assert.Equal(t, c.expected.movie, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieRepositorySuite) TestUpdateMovie() {
+	type testInput struct {
+		args  usecaserepository.UpdateMovieParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	updateQuery := regexp.QuoteMeta(`UPDATE movies SET original_title = ?, original_language = ?, overview = ?, poster_path = ?,
+	backdrop_path = ?, adult = ?, release_date = ?, release_date_precision = ?, budget = ?, revenue = ?, currency = ?
+	WHERE id = ? AND deleted_at IS NULL`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "updates_every_field_of_movie",
+			input: testInput{
+				args: usecaserepository.UpdateMovieParams{
+					ID: 1,
+					MovieParams: usecaserepository.MovieParams{
+						OriginalTitle:    "accumsan sed",
+						OriginalLanguage: "Nigeria",
+						Revenue:          utils.Int64Ptr(-100),
+						Currency:         utils.StringPtr("EUR"),
+					},
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(updateQuery).
+						WithArgs("accumsan sed", "Nigeria", nil, nil, nil, false, nil, nil, nil, utils.Int64Ptr(-100),
+							utils.StringPtr("EUR"), 1).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(1, "accumsan sed", "Nigeria", nil, nil, nil, false, nil, nil, -100,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WithArgs(1).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:               1,
+					OriginalTitle:    "accumsan sed",
+					Title:            "accumsan sed",
+					OriginalLanguage: "Nigeria",
+					Revenue:          utils.Int64Ptr(-100),
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:           []entity.Genre{},
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_movie_is_deleted",
+			input: testInput{
+				args: usecaserepository.UpdateMovieParams{ID: 1},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(moviesTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_update_failed",
+			input: testInput{
+				args: usecaserepository.UpdateMovieParams{ID: 1},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(updateQuery).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieRepository := repository.NewMovieRepository(manager)
+
+			ctx := context.Background()
+			res, err := movieRepository.UpdateMovie(ctx, c.input.args)
+			assert.Equal(t, c.expected.movie, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieRepositorySuite) TestDeleteMovie() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	deleteQuery := regexp.QuoteMeta(`UPDATE movies SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "sets_deleted_at_of_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(deleteQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_update_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(deleteQuery).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieRepository := repository.NewMovieRepository(manager)
+
+			ctx := context.Background()
+			err := movieRepository.DeleteMovie(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieRepositorySuite) TestRestoreMovie() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	restoreQuery := regexp.QuoteMeta(`UPDATE movies SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "clears_deleted_at_and_returns_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(restoreQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+					rows := sqlmock.NewRows(moviesTableRows)
+					rows.AddRow(1, "accumsan sed, facilisis vitae,", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE movies.id = ? AND movies.deleted_at IS NULL`)).
+						WithArgs(1).
+						WillReturnRows(rows)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).
+						WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:               1,
+					OriginalTitle:    "accumsan sed, facilisis vitae,",
+					Title:            "accumsan sed, facilisis vitae,",
+					OriginalLanguage: "Nigeria",
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Genres:           []entity.Genre{},
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_movie_is_not_deleted",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(restoreQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_update_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(restoreQuery).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieRepository := repository.NewMovieRepository(manager)
+
+			ctx := context.Background()
+			res, err := movieRepository.RestoreMovie(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.movie, res)
 			assert.Equal(t, c.expected.err, err)
 		})
 	}
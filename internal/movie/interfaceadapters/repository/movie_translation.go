@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type movieTranslationRepository struct {
+	connManager ConnManager
+}
+
+func NewMovieTranslationRepository(connManager ConnManager) *movieTranslationRepository {
+	return &movieTranslationRepository{connManager: connManager}
+}
+
+const findMovieTranslationsByMovieIDsQuery = `SELECT movie_id, language, title, overview
+FROM movie_translations
+WHERE movie_id IN (?)
+ORDER BY movie_id ASC, language ASC`
+
+func (r *movieTranslationRepository) FindByMovieIDs(ctx context.Context, movieIDs []uint64) ([]*entity.MovieTranslation, error) {
+	translations := make([]*entity.MovieTranslation, 0)
+	if len(movieIDs) == 0 {
+		return translations, nil
+	}
+
+	query, args, err := sqlx.In(findMovieTranslationsByMovieIDsQuery, movieIDs)
+	if err != nil {
+		return nil, fmt.Errorf("sqlx.In: %w", err)
+	}
+
+	db := r.connManager.GetReader()
+	rows, err := db.QueryxContext(ctx, db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		translation := &MovieTranslation{}
+		if err = rows.StructScan(translation); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		translations = append(translations, &entity.MovieTranslation{
+			MovieID:  translation.MovieID,
+			Language: translation.Language,
+			Title:    translation.Title,
+			Overview: translation.Overview,
+		})
+	}
+
+	return translations, nil
+}
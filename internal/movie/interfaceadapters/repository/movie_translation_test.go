@@ -0,0 +1,101 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testMovieTranslationRepositorySuite struct {
+	suite.Suite
+}
+
+func TestMovieTranslationRepositorySuite(t *testing.T) {
+	suite.Run(t, &testMovieTranslationRepositorySuite{})
+}
+
+func (s *testMovieTranslationRepositorySuite) TestFindByMovieIDs() {
+	type testInput struct {
+		movieIDs []uint64
+		mocks    func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		translations []*entity.MovieTranslation
+		err          error
+	}
+
+	query := regexp.QuoteMeta(`SELECT movie_id, language, title, overview
+	FROM movie_translations
+	WHERE movie_id IN (?, ?)
+	ORDER BY movie_id ASC, language ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_translations_of_movies",
+			input: testInput{
+				movieIDs: []uint64{1, 3},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(movieTranslationsTableRows)
+					rows.AddRow(1, "fr", "L'étranger de la nuit", nil)
+					rows.AddRow(3, "vi", "Mãi mãi", "Câu chuyện về An")
+					mock.ExpectQuery(query).WithArgs(1, 3).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				translations: []*entity.MovieTranslation{
+					{MovieID: 1, Language: "fr", Title: "L'étranger de la nuit"},
+					{MovieID: 3, Language: "vi", Title: "Mãi mãi", Overview: utils.StringPtr("Câu chuyện về An")},
+				},
+			},
+		},
+		{
+			name: "returns_nothing_without_query_when_there_is_no_movie",
+			input: testInput{
+				movieIDs: []uint64{},
+				mocks:    func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				translations: []*entity.MovieTranslation{},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieIDs: []uint64{1, 3},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 3).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			movieTranslationRepository := repository.NewMovieTranslationRepository(manager)
+
+			ctx := context.Background()
+			res, err := movieTranslationRepository.FindByMovieIDs(ctx, c.input.movieIDs)
+			assert.Equal(t, c.expected.translations, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
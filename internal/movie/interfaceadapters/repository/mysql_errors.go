@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDuplicateEntry is the error number of MySQL when a row violates a unique key
+const mysqlErrDuplicateEntry = 1062
+
+// isDuplicateEntry reports whether the error is the violation of a unique key
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type personRepository struct {
+	connManager ConnManager
+}
+
+func NewPersonRepository(connManager ConnManager) *personRepository {
+	return &personRepository{connManager: connManager}
+}
+
+const findPersonByIDQuery = `SELECT id, name, biography, profile_path, created_at, updated_at FROM people WHERE id = ?`
+
+func (r *personRepository) FindByID(ctx context.Context, personID uint64) (*entity.Person, error) {
+	person := &Person{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findPersonByIDQuery, personID).StructScan(person); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.Person{
+		ID:          person.ID,
+		Name:        person.Name,
+		Biography:   person.Biography,
+		ProfilePath: person.ProfilePath,
+		CreatedAt:   person.CreatedAt,
+		UpdatedAt:   person.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testPersonRepositorySuite struct {
+	suite.Suite
+}
+
+func TestPersonRepositorySuite(t *testing.T) {
+	suite.Run(t, &testPersonRepositorySuite{})
+}
+
+func (s *testPersonRepositorySuite) TestFindByID() {
+	type testInput struct {
+		personID uint64
+		mocks    func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		person *entity.Person
+		err    error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, name, biography, profile_path, created_at, updated_at FROM people WHERE id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_person_when_exist_record",
+			input: testInput{
+				personID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(peopleTableRows)
+					rows.AddRow(1, "Christopher Nolan", "British-American filmmaker.", nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				person: &entity.Person{
+					ID:        1,
+					Name:      "Christopher Nolan",
+					Biography: utils.StringPtr("British-American filmmaker."),
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_not_exist_record",
+			input: testInput{
+				personID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				personID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			personRepository := repository.NewPersonRepository(manager)
+
+			ctx := context.Background()
+			res, err := personRepository.FindByID(ctx, c.input.personID)
+			assert.Equal(t, c.expected.person, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type postRepository struct {
+	connManager ConnManager
+}
+
+func NewPostRepository(connManager ConnManager) *postRepository {
+	return &postRepository{connManager: connManager}
+}
+
+const findRecentPostsQuery = `(SELECT 'review' AS target_type, id, user_id, content, created_at
+FROM reviews WHERE user_id = ? AND created_at >= ?)
+UNION ALL
+(SELECT 'comment' AS target_type, id, user_id, content, created_at
+FROM review_comments WHERE user_id = ? AND created_at >= ? AND deleted_at IS NULL)
+ORDER BY created_at DESC
+LIMIT ?`
+
+func (r *postRepository) FindRecentPosts(ctx context.Context, args repository.FindRecentPostsParams) ([]*entity.Post, error) {
+	posts := make([]*entity.Post, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findRecentPostsQuery, args.UserID, args.Since, args.UserID,
+		args.Since, args.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := &Post{}
+		if err = rows.StructScan(post); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		posts = append(posts, &entity.Post{
+			TargetType: entity.ReportTargetType(post.TargetType),
+			ID:         post.ID,
+			UserID:     post.UserID,
+			Content:    post.Content,
+			CreatedAt:  post.CreatedAt,
+		})
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,119 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testPostRepositorySuite struct {
+	suite.Suite
+}
+
+func TestPostRepositorySuite(t *testing.T) {
+	suite.Run(t, &testPostRepositorySuite{})
+}
+
+func (s *testPostRepositorySuite) TestFindRecentPosts() {
+	type testInput struct {
+		args  usecaserepository.FindRecentPostsParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		posts []*entity.Post
+		err   error
+	}
+
+	args := usecaserepository.FindRecentPostsParams{
+		UserID: 1,
+		Since:  utils.MustRFC3339Time("2022-08-20T00:00:00+00:00"),
+		Limit:  50,
+	}
+
+	query := regexp.QuoteMeta(`(SELECT 'review' AS target_type, id, user_id, content, created_at
+	FROM reviews WHERE user_id = ? AND created_at >= ?)
+	UNION ALL
+	(SELECT 'comment' AS target_type, id, user_id, content, created_at
+	FROM review_comments WHERE user_id = ? AND created_at >= ? AND deleted_at IS NULL)
+	ORDER BY created_at DESC
+	LIMIT ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_reviews_and_comments_of_user_newest_first",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(postsTableRows)
+					rows.AddRow("comment", 7, 1, "nice review", utils.MustRFC3339Time("2022-08-20T23:00:00+00:00"))
+					rows.AddRow("review", 5, 1, "really enjoyed it", utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(query).
+						WithArgs(1, utils.MustRFC3339Time("2022-08-20T00:00:00+00:00"), 1,
+							utils.MustRFC3339Time("2022-08-20T00:00:00+00:00"), 50).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				posts: []*entity.Post{
+					{
+						TargetType: entity.ReportTargetComment,
+						ID:         7,
+						UserID:     1,
+						Content:    "nice review",
+						CreatedAt:  utils.MustRFC3339Time("2022-08-20T23:00:00+00:00"),
+					},
+					{
+						TargetType: entity.ReportTargetReview,
+						ID:         5,
+						UserID:     1,
+						Content:    "really enjoyed it",
+						CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(query).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			postRepository := repository.NewPostRepository(manager)
+
+			ctx := context.Background()
+			res, err := postRepository.FindRecentPosts(ctx, c.input.args)
+			assert.Equal(t, c.expected.posts, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type ratingRepository struct {
+	connManager ConnManager
+}
+
+func NewRatingRepository(connManager ConnManager) *ratingRepository {
+	return &ratingRepository{connManager: connManager}
+}
+
+const findRatingByUserIDAndMovieIDQuery = `SELECT user_id, movie_id, score, created_at, updated_at
+FROM ratings WHERE user_id = ? AND movie_id = ?`
+
+func (r *ratingRepository) FindByUserIDAndMovieID(ctx context.Context,
+	args repository.FindRatingByUserIDAndMovieIDParams) (*entity.Rating, error) {
+	rating := &Rating{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findRatingByUserIDAndMovieIDQuery, args.UserID,
+		args.MovieID).StructScan(rating); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.Rating{
+		UserID:    rating.UserID,
+		MovieID:   rating.MovieID,
+		Score:     rating.Score,
+		CreatedAt: rating.CreatedAt,
+		UpdatedAt: rating.UpdatedAt,
+	}, nil
+}
+
+const lockRatingQuery = `SELECT user_id, movie_id, score, created_at, updated_at
+FROM ratings WHERE user_id = ? AND movie_id = ? FOR UPDATE`
+
+// insertRatingQuery inserts the rating or leaves the existing one unchanged, it affects no row then since the
+// connections do not set clientFoundRows
+const insertRatingQuery = `INSERT INTO ratings(user_id, movie_id, score) VALUES (?,?,?)
+ON DUPLICATE KEY UPDATE score = score`
+
+const updateRatingQuery = `UPDATE ratings SET score = ? WHERE user_id = ? AND movie_id = ?`
+
+const deleteRatingQuery = `DELETE FROM ratings WHERE user_id = ? AND movie_id = ?`
+
+const addRatingStatsQuery = `INSERT INTO movie_rating_stats(movie_id, rating_count, rating_sum, %[1]s) VALUES (?, 1, ?, 1)
+ON DUPLICATE KEY UPDATE rating_count = rating_count + 1, rating_sum = rating_sum + ?, %[1]s = %[1]s + 1`
+
+const changeRatingStatsQuery = `UPDATE movie_rating_stats
+SET rating_sum = rating_sum + ? - ?, %[1]s = %[1]s - 1, %[2]s = %[2]s + 1
+WHERE movie_id = ?`
+
+const removeRatingStatsQuery = `UPDATE movie_rating_stats
+SET rating_count = rating_count - 1, rating_sum = rating_sum - ?, %[1]s = %[1]s - 1
+WHERE movie_id = ?`
+
+const lockRaterIsCriticQuery = `SELECT is_critic FROM users WHERE id = ? FOR SHARE`
+
+const addCriticRatingStatsQuery = `INSERT INTO movie_critic_rating_stats(movie_id, rating_count, rating_sum) VALUES (?, 1, ?)
+ON DUPLICATE KEY UPDATE rating_count = rating_count + 1, rating_sum = rating_sum + ?`
+
+const changeCriticRatingStatsQuery = `UPDATE movie_critic_rating_stats SET rating_sum = rating_sum + ? - ? WHERE movie_id = ?`
+
+const removeCriticRatingStatsQuery = `UPDATE movie_critic_rating_stats
+SET rating_count = rating_count - 1, rating_sum = rating_sum - ?
+WHERE movie_id = ?`
+
+// UpsertRating inserts the rating before reading the existing one, since two transactions which lock the missing
+// rating before inserting it deadlock. The insert locks the existing rating which is then changed
+func (r *ratingRepository) UpsertRating(ctx context.Context, args repository.UpsertRatingParams) error {
+	newColumn, err := ratingStatsColumn(args.Score)
+	if err != nil {
+		return err
+	}
+
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		isCritic, err := lockRaterIsCritic(ctx, tx, args.UserID)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, insertRatingQuery, args.UserID, args.MovieID, args.Score)
+		if err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("RowsAffected: %w", err)
+		}
+
+		if affected == 1 {
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(addRatingStatsQuery, newColumn), args.MovieID, args.Score,
+				args.Score); err != nil {
+				return fmt.Errorf("ExecContext: %w", err)
+			}
+
+			return execIfCritic(ctx, tx, isCritic, addCriticRatingStatsQuery, args.MovieID, args.Score, args.Score)
+		}
+
+		current, err := lockRating(ctx, tx, args.UserID, args.MovieID)
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			return fmt.Errorf("rating of user %d for movie %d is not found", args.UserID, args.MovieID)
+		}
+
+		if current.Score == args.Score {
+			return nil
+		}
+
+		currentColumn, err := ratingStatsColumn(current.Score)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, updateRatingQuery, args.Score, args.UserID, args.MovieID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(changeRatingStatsQuery, currentColumn, newColumn), args.Score,
+			current.Score, args.MovieID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return execIfCritic(ctx, tx, isCritic, changeCriticRatingStatsQuery, args.Score, current.Score, args.MovieID)
+	})
+}
+
+func (r *ratingRepository) DeleteRating(ctx context.Context, args repository.DeleteRatingParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		isCritic, err := lockRaterIsCritic(ctx, tx, args.UserID)
+		if err != nil {
+			return err
+		}
+
+		current, err := lockRating(ctx, tx, args.UserID, args.MovieID)
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			return nil
+		}
+
+		currentColumn, err := ratingStatsColumn(current.Score)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteRatingQuery, args.UserID, args.MovieID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(removeRatingStatsQuery, currentColumn), current.Score,
+			args.MovieID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return execIfCritic(ctx, tx, isCritic, removeCriticRatingStatsQuery, current.Score, args.MovieID)
+	})
+}
+
+// lockRating returns the rating of user for the movie and locks it until the end of the transaction
+func lockRating(ctx context.Context, tx *sqlx.Tx, userID uint64, movieID uint64) (*Rating, error) {
+	rating := &Rating{}
+	if err := tx.QueryRowxContext(ctx, lockRatingQuery, userID, movieID).StructScan(rating); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return rating, nil
+}
+
+// lockRaterIsCritic returns whether the user is a critic and locks the user row in share mode, so the critic flag
+// can not be changed until the end of the transaction. The user row has to be locked before the rating like
+// SetCritic of the user repository does
+func lockRaterIsCritic(ctx context.Context, tx *sqlx.Tx, userID uint64) (bool, error) {
+	var isCritic bool
+	if err := tx.QueryRowxContext(ctx, lockRaterIsCriticQuery, userID).Scan(&isCritic); err != nil {
+		return false, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return isCritic, nil
+}
+
+// execIfCritic executes the query which updates movie_critic_rating_stats when the rater is a critic
+func execIfCritic(ctx context.Context, tx *sqlx.Tx, isCritic bool, query string, args ...interface{}) error {
+	if !isCritic {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// ratingStatsColumn returns the movie_rating_stats column which counts the ratings of given score
+func ratingStatsColumn(score uint8) (string, error) {
+	if score < entity.MinRatingScore || score > entity.MaxRatingScore {
+		return "", fmt.Errorf("invalid rating score: %d", score)
+	}
+
+	return fmt.Sprintf("rating_%d", score), nil
+}
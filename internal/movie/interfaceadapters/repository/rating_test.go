@@ -0,0 +1,382 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRatingRepositorySuite struct {
+	suite.Suite
+}
+
+func TestRatingRepositorySuite(t *testing.T) {
+	suite.Run(t, &testRatingRepositorySuite{})
+}
+
+func (s *testRatingRepositorySuite) TestFindByUserIDAndMovieID() {
+	type testInput struct {
+		args  usecaserepository.FindRatingByUserIDAndMovieIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		rating *entity.Rating
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_rating_when_exist_record",
+			input: testInput{
+				args: usecaserepository.FindRatingByUserIDAndMovieIDParams{UserID: 1, MovieID: 10},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 8, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT user_id, movie_id, score, created_at, updated_at
+						FROM ratings WHERE user_id = ? AND movie_id = ?`)).
+						WithArgs(1, 10).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				rating: &entity.Rating{
+					UserID:    1,
+					MovieID:   10,
+					Score:     8,
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_there_is_no_record",
+			input: testInput{
+				args: usecaserepository.FindRatingByUserIDAndMovieIDParams{UserID: 1, MovieID: 10},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM ratings WHERE user_id = ? AND movie_id = ?`)).
+						WithArgs(1, 10).
+						WillReturnRows(sqlmock.NewRows(ratingsTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			ratingRepository := repository.NewRatingRepository(manager)
+
+			ctx := context.Background()
+			res, err := ratingRepository.FindByUserIDAndMovieID(ctx, c.input.args)
+			assert.Equal(t, c.expected.rating, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testRatingRepositorySuite) TestUpsertRating() {
+	type testInput struct {
+		args  usecaserepository.UpsertRatingParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	criticQuery := regexp.QuoteMeta(`SELECT is_critic FROM users WHERE id = ? FOR SHARE`)
+	insertQuery := regexp.QuoteMeta(`INSERT INTO ratings(user_id, movie_id, score) VALUES (?,?,?)
+	ON DUPLICATE KEY UPDATE score = score`)
+	lockQuery := regexp.QuoteMeta(`SELECT user_id, movie_id, score, created_at, updated_at
+	FROM ratings WHERE user_id = ? AND movie_id = ? FOR UPDATE`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "inserts_rating_and_adds_it_to_aggregates_when_movie_is_not_rated_by_user",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO movie_rating_stats(movie_id, rating_count, rating_sum, rating_8) VALUES (?, 1, ?, 1)
+						ON DUPLICATE KEY UPDATE rating_count = rating_count + 1, rating_sum = rating_sum + ?, rating_8 = rating_8 + 1`)).
+						WithArgs(10, 8, 8).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "updates_rating_and_moves_it_in_aggregates_when_score_is_changed",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 3, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("UPDATE ratings SET score = ? WHERE user_id = ? AND movie_id = ?")).
+						WithArgs(8, 1, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_rating_stats
+						SET rating_sum = rating_sum + ? - ?, rating_3 = rating_3 - 1, rating_8 = rating_8 + 1
+						WHERE movie_id = ?`)).
+						WithArgs(8, 3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "adds_rating_to_critic_aggregates_when_user_is_critic",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(true))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO movie_rating_stats`)).
+						WithArgs(10, 8, 8).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO movie_critic_rating_stats(movie_id, rating_count, rating_sum) VALUES (?, 1, ?)
+						ON DUPLICATE KEY UPDATE rating_count = rating_count + 1, rating_sum = rating_sum + ?`)).
+						WithArgs(10, 8, 8).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "moves_rating_in_critic_aggregates_when_user_is_critic",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 3, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(true))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("UPDATE ratings SET score = ? WHERE user_id = ? AND movie_id = ?")).
+						WithArgs(8, 1, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_rating_stats`)).
+						WithArgs(8, 3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_critic_rating_stats SET rating_sum = rating_sum + ? - ? WHERE movie_id = ?`)).
+						WithArgs(8, 3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "does_nothing_when_score_is_not_changed",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 8, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(rows)
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "rollbacks_when_update_aggregates_failed",
+			input: testInput{
+				args: usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 8},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectExec(insertQuery).WithArgs(1, 10, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO movie_rating_stats`)).
+						WithArgs(10, 8, 8).
+						WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+		{
+			name: "returns_error_when_score_is_out_of_range",
+			input: testInput{
+				args:  usecaserepository.UpsertRatingParams{UserID: 1, MovieID: 10, Score: 11},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("invalid rating score: %d", 11),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			ratingRepository := repository.NewRatingRepository(manager)
+
+			ctx := context.Background()
+			err := ratingRepository.UpsertRating(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testRatingRepositorySuite) TestDeleteRating() {
+	type testInput struct {
+		args  usecaserepository.DeleteRatingParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	criticQuery := regexp.QuoteMeta(`SELECT is_critic FROM users WHERE id = ? FOR SHARE`)
+	lockQuery := regexp.QuoteMeta(`FROM ratings WHERE user_id = ? AND movie_id = ? FOR UPDATE`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "deletes_rating_and_removes_it_from_aggregates",
+			input: testInput{
+				args: usecaserepository.DeleteRatingParams{UserID: 1, MovieID: 10},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 3, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("DELETE FROM ratings WHERE user_id = ? AND movie_id = ?")).
+						WithArgs(1, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_rating_stats
+						SET rating_count = rating_count - 1, rating_sum = rating_sum - ?, rating_3 = rating_3 - 1
+						WHERE movie_id = ?`)).
+						WithArgs(3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "removes_rating_from_critic_aggregates_when_user_is_critic",
+			input: testInput{
+				args: usecaserepository.DeleteRatingParams{UserID: 1, MovieID: 10},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(ratingsTableRows)
+					rows.AddRow(1, 10, 3, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(true))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("DELETE FROM ratings WHERE user_id = ? AND movie_id = ?")).
+						WithArgs(1, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_rating_stats`)).
+						WithArgs(3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE movie_critic_rating_stats
+						SET rating_count = rating_count - 1, rating_sum = rating_sum - ?
+						WHERE movie_id = ?`)).
+						WithArgs(3, 10).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "does_nothing_when_movie_is_not_rated_by_user",
+			input: testInput{
+				args: usecaserepository.DeleteRatingParams{UserID: 1, MovieID: 10},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(criticQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"is_critic"}).AddRow(false))
+					mock.ExpectQuery(lockQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(ratingsTableRows))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			ratingRepository := repository.NewRatingRepository(manager)
+
+			ctx := context.Background()
+			err := ratingRepository.DeleteRating(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
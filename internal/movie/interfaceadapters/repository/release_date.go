@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type releaseDateRepository struct {
+	connManager ConnManager
+}
+
+func NewReleaseDateRepository(connManager ConnManager) *releaseDateRepository {
+	return &releaseDateRepository{connManager: connManager}
+}
+
+const findReleaseDatesByMovieIDQuery = `SELECT id, movie_id, country, release_type, release_date, certification,
+minimum_age
+FROM release_dates
+WHERE movie_id = ?
+ORDER BY country ASC, release_date ASC, id ASC`
+
+func (r *releaseDateRepository) FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.ReleaseDate, error) {
+	releaseDates := make([]*entity.ReleaseDate, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findReleaseDatesByMovieIDQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		releaseDate := &ReleaseDate{}
+		if err = rows.StructScan(releaseDate); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		releaseDates = append(releaseDates, &entity.ReleaseDate{
+			ID:            releaseDate.ID,
+			MovieID:       releaseDate.MovieID,
+			Country:       releaseDate.Country,
+			ReleaseType:   releaseDate.ReleaseType,
+			ReleaseDate:   entity.DateOf(releaseDate.ReleaseDate),
+			Certification: releaseDate.Certification,
+			MinimumAge:    releaseDate.MinimumAge,
+		})
+	}
+
+	return releaseDates, nil
+}
+
+// movieReleaseCondition is appended to the WHERE clause of a movie query to return only the movies which have a
+// release satisfying the conditions, %s is the conditions on release_dates
+const movieReleaseCondition = `
+AND EXISTS (SELECT 1 FROM release_dates
+WHERE release_dates.movie_id = movies.id AND %s)`
+
+// movieReleaseDateCondition is appended to the WHERE clause of a movie query instead of movieReleaseCondition when
+// the movie's own release date may satisfy the conditions, %[1]s is the conditions on release_dates and %[2]s is the
+// ones on the release date of the movie
+const movieReleaseDateCondition = `
+AND (EXISTS (SELECT 1 FROM release_dates
+WHERE release_dates.movie_id = movies.id AND %[1]s)
+OR (%[2]s))`
+
+// movieReleasePeriodEnd is the day after the last day which the release date of the movie may be, such as
+// 1928-01-01 for a date which is known only to the year 1927
+const movieReleasePeriodEnd = `CASE movies.release_date_precision
+WHEN 'year' THEN DATE_ADD(movies.release_date, INTERVAL 1 YEAR)
+WHEN 'month' THEN DATE_ADD(movies.release_date, INTERVAL 1 MONTH)
+ELSE DATE_ADD(movies.release_date, INTERVAL 1 DAY) END`
+
+// releaseCondition returns the condition which filters the movies by their releases and its arguments, the
+// condition is empty when the filter has no condition other than the country. When the filter has only the dates,
+// a movie also matches when the period which its own release date may be overlaps them, so that the movies which
+// have no release in release_dates and the ones known only to the year or to the month are found
+func releaseCondition(filter repository.ReleaseFilter) (string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	if len(filter.ReleaseType) > 0 {
+		conditions = append(conditions, "release_dates.release_type = ?")
+		args = append(args, filter.ReleaseType)
+	}
+
+	if filter.ReleasedFrom != nil {
+		conditions = append(conditions, "release_dates.release_date >= ?")
+		args = append(args, *filter.ReleasedFrom)
+	}
+
+	if filter.ReleasedBefore != nil {
+		conditions = append(conditions, "release_dates.release_date < ?")
+		args = append(args, *filter.ReleasedBefore)
+	}
+
+	if filter.MaxMinimumAge != nil {
+		conditions = append(conditions, "release_dates.minimum_age <= ?")
+		args = append(args, *filter.MaxMinimumAge)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	if len(filter.Country) > 0 {
+		conditions = append([]string{"release_dates.country = ?"}, conditions...)
+		args = append([]interface{}{filter.Country}, args...)
+	}
+
+	if len(filter.Country) > 0 || len(filter.ReleaseType) > 0 || filter.MaxMinimumAge != nil {
+		return fmt.Sprintf(movieReleaseCondition, strings.Join(conditions, " AND ")), args
+	}
+
+	dateConditions := make([]string, 0)
+	if filter.ReleasedFrom != nil {
+		dateConditions = append(dateConditions, movieReleasePeriodEnd+" > ?")
+		args = append(args, *filter.ReleasedFrom)
+	}
+
+	if filter.ReleasedBefore != nil {
+		dateConditions = append(dateConditions, "movies.release_date < ?")
+		args = append(args, *filter.ReleasedBefore)
+	}
+
+	return fmt.Sprintf(movieReleaseDateCondition, strings.Join(conditions, " AND "),
+		strings.Join(dateConditions, " AND ")), args
+}
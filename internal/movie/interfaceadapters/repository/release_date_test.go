@@ -0,0 +1,128 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReleaseDateRepositorySuite struct {
+	suite.Suite
+}
+
+func TestReleaseDateRepositorySuite(t *testing.T) {
+	suite.Run(t, &testReleaseDateRepositorySuite{})
+}
+
+func (s *testReleaseDateRepositorySuite) TestFindByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		releaseDates []*entity.ReleaseDate
+		err          error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, movie_id, country, release_type, release_date, certification,
+	minimum_age
+	FROM release_dates
+	WHERE movie_id = ?
+	ORDER BY country ASC, release_date ASC, id ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_release_dates_of_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(releaseDatesTableRows)
+					rows.AddRow(3, 1, "JP", "theatrical", utils.MustRFC3339Time("2020-03-20T00:00:00+00:00"), "R15+", 15)
+					rows.AddRow(1, 1, "US", "theatrical", utils.MustRFC3339Time("2019-10-31T00:00:00+00:00"), "R", 17)
+					rows.AddRow(2, 1, "US", "digital", utils.MustRFC3339Time("2020-02-14T00:00:00+00:00"), nil, nil)
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				releaseDates: []*entity.ReleaseDate{
+					{
+						ID:            3,
+						MovieID:       1,
+						Country:       "JP",
+						ReleaseType:   entity.ReleaseTypeTheatrical,
+						ReleaseDate:   mustDate("2020-03-20"),
+						Certification: utils.StringPtr("R15+"),
+						MinimumAge:    utils.Uint8Ptr(15),
+					},
+					{
+						ID:            1,
+						MovieID:       1,
+						Country:       "US",
+						ReleaseType:   entity.ReleaseTypeTheatrical,
+						ReleaseDate:   mustDate("2019-10-31"),
+						Certification: utils.StringPtr("R"),
+						MinimumAge:    utils.Uint8Ptr(17),
+					},
+					{
+						ID:          2,
+						MovieID:     1,
+						Country:     "US",
+						ReleaseType: entity.ReleaseTypeDigital,
+						ReleaseDate: mustDate("2020-02-14"),
+					},
+				},
+			},
+		},
+		{
+			name: "returns_empty_when_movie_has_no_release_date",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(releaseDatesTableRows))
+				},
+			},
+			expected: testOutput{
+				releaseDates: []*entity.ReleaseDate{},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			releaseDateRepository := repository.NewReleaseDateRepository(manager)
+
+			ctx := context.Background()
+			res, err := releaseDateRepository.FindByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.releaseDates, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type reportRepository struct {
+	connManager ConnManager
+}
+
+func NewReportRepository(connManager ConnManager) *reportRepository {
+	return &reportRepository{connManager: connManager}
+}
+
+const reportColumns = `content_reports.id, content_reports.user_id, users.username, content_reports.target_type,
+content_reports.target_id, content_reports.reason, content_reports.status, content_reports.created_at,
+content_reports.updated_at`
+
+const createReportQuery = `INSERT INTO content_reports(user_id, target_type, target_id, reason) VALUES (?,?,?,?)`
+
+func (r *reportRepository) CreateReport(ctx context.Context, args repository.CreateReportParams) (*entity.Report, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, createReportQuery, args.UserID, args.TargetType, args.TargetID,
+		args.Reason)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, repository.ErrReportExists
+		}
+
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	createdReportID, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("LastInsertId: %w", err)
+	}
+
+	report := &Report{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findReportByIDQuery, createdReportID).StructScan(report); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return report.toEntity(), nil
+}
+
+const createFilterReportQuery = `INSERT INTO content_reports(target_type, target_id, reason) VALUES (?,?,?)`
+
+func (r *reportRepository) CreateFilterReport(ctx context.Context, args repository.CreateFilterReportParams) (*entity.Report, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, createFilterReportQuery, args.TargetType, args.TargetID, args.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	createdReportID, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("LastInsertId: %w", err)
+	}
+
+	report := &Report{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findReportByIDQuery, createdReportID).StructScan(report); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return report.toEntity(), nil
+}
+
+const findReportByIDQuery = `SELECT ` + reportColumns + `
+FROM content_reports
+LEFT JOIN users
+ON content_reports.user_id = users.id
+WHERE content_reports.id = ?`
+
+const findReportByUserIDAndTargetQuery = `SELECT ` + reportColumns + `
+FROM content_reports
+LEFT JOIN users
+ON content_reports.user_id = users.id
+WHERE content_reports.user_id = ? AND content_reports.target_type = ? AND content_reports.target_id = ?`
+
+func (r *reportRepository) FindByUserIDAndTarget(ctx context.Context,
+	args repository.FindReportByUserIDAndTargetParams) (*entity.Report, error) {
+	report := &Report{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findReportByUserIDAndTargetQuery, args.UserID,
+		args.TargetType, args.TargetID).StructScan(report); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return report.toEntity(), nil
+}
+
+// findReportsQuery lists the reports of a status, the %s is the condition of the cursor
+const findReportsQuery = `SELECT ` + reportColumns + `
+FROM content_reports
+LEFT JOIN users
+ON content_reports.user_id = users.id
+WHERE content_reports.status = ?%s
+ORDER BY content_reports.id ASC
+LIMIT ?`
+
+const reportAfterCondition = `
+AND content_reports.id > ?`
+
+func (r *reportRepository) FindReports(ctx context.Context, args repository.FindReportsParams) (*repository.ReportPage, error) {
+	after, queryArgs := "", []interface{}{args.Status}
+	if args.After != nil {
+		after = reportAfterCondition
+		queryArgs = append(queryArgs, args.After.ID)
+	}
+	queryArgs = append(queryArgs, args.Limit+1)
+
+	reports := make([]*entity.Report, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(findReportsQuery, after), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		report := &Report{}
+		if err = rows.StructScan(report); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		reports = append(reports, report.toEntity())
+	}
+
+	// the queue is queried with one more report than the limit so that the next page exists only when it is found
+	if uint(len(reports)) <= args.Limit {
+		return &repository.ReportPage{Reports: reports}, nil
+	}
+
+	reports = reports[:args.Limit]
+	if args.Limit == 0 {
+		// a page without any report has no report to continue after
+		return &repository.ReportPage{Reports: reports}, nil
+	}
+
+	return &repository.ReportPage{Reports: reports,
+		Next: &repository.ReportCursor{ID: reports[len(reports)-1].ID}}, nil
+}
+
+func (r *Report) toEntity() *entity.Report {
+	report := &entity.Report{
+		ID:         r.ID,
+		TargetType: entity.ReportTargetType(r.TargetType),
+		TargetID:   r.TargetID,
+		Reason:     r.Reason,
+		Status:     entity.ReportStatus(r.Status),
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+
+	// the reports made by the content filters have no reporter
+	if r.UserID != nil {
+		report.UserID, report.Username = *r.UserID, *r.Username
+	}
+
+	return report
+}
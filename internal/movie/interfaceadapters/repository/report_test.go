@@ -0,0 +1,442 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReportRepositorySuite struct {
+	suite.Suite
+}
+
+func TestReportRepositorySuite(t *testing.T) {
+	suite.Run(t, &testReportRepositorySuite{})
+}
+
+func (s *testReportRepositorySuite) TestCreateReport() {
+	type testInput struct {
+		args  usecaserepository.CreateReportParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		report *entity.Report
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_report_when_insert_successfully",
+			input: testInput{
+				args: usecaserepository.CreateReportParams{
+					UserID:     2,
+					TargetType: entity.ReportTargetReview,
+					TargetID:   5,
+					Reason:     "offensive language",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO content_reports(user_id, target_type, target_id, reason) VALUES (?,?,?,?)")).
+						WithArgs(2, "review", 5, "offensive language").
+						WillReturnResult(sqlmock.NewResult(3, 1))
+
+					rows := sqlmock.NewRows(reportsTableRows)
+					rows.AddRow(3, 2, "otheruser", "review", 5, "offensive language", "open",
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + reportColumnsQuery + `
+						FROM content_reports
+						LEFT JOIN users
+						ON content_reports.user_id = users.id
+						WHERE content_reports.id = ?`)).
+						WithArgs(3).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				report: &entity.Report{
+					ID:         3,
+					UserID:     2,
+					Username:   "otheruser",
+					TargetType: entity.ReportTargetReview,
+					TargetID:   5,
+					Reason:     "offensive language",
+					Status:     entity.ReportStatusOpen,
+					CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_error_when_insert_failed",
+			input: testInput{
+				args: usecaserepository.CreateReportParams{
+					UserID:     2,
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+					Reason:     "spam",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO content_reports")).
+						WithArgs(2, "comment", 7, "spam").
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+		{
+			name: "returns_ErrReportExists_when_user_already_reported_target",
+			input: testInput{
+				args: usecaserepository.CreateReportParams{
+					UserID:     2,
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+					Reason:     "spam",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO content_reports")).
+						WithArgs(2, "comment", 7, "spam").
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '2-comment-7' for key 'unique_content_reports_user_id_target'"})
+				},
+			},
+			expected: testOutput{
+				err: usecaserepository.ErrReportExists,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reportRepository := repository.NewReportRepository(manager)
+
+			ctx := context.Background()
+			res, err := reportRepository.CreateReport(ctx, c.input.args)
+			assert.Equal(t, c.expected.report, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReportRepositorySuite) TestCreateFilterReport() {
+	type testInput struct {
+		args  usecaserepository.CreateFilterReportParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		report *entity.Report
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_report_without_reporter_when_insert_successfully",
+			input: testInput{
+				args: usecaserepository.CreateFilterReportParams{
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+					Reason:     "content has 3 links",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO content_reports(target_type, target_id, reason) VALUES (?,?,?)")).
+						WithArgs("comment", 7, "content has 3 links").
+						WillReturnResult(sqlmock.NewResult(4, 1))
+
+					rows := sqlmock.NewRows(reportsTableRows)
+					rows.AddRow(4, nil, nil, "comment", 7, "content has 3 links", "open",
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + reportColumnsQuery + `
+						FROM content_reports
+						LEFT JOIN users
+						ON content_reports.user_id = users.id
+						WHERE content_reports.id = ?`)).
+						WithArgs(4).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				report: &entity.Report{
+					ID:         4,
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+					Reason:     "content has 3 links",
+					Status:     entity.ReportStatusOpen,
+					CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_error_when_insert_failed",
+			input: testInput{
+				args: usecaserepository.CreateFilterReportParams{
+					TargetType: entity.ReportTargetReview,
+					TargetID:   5,
+					Reason:     "content has 3 links",
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO content_reports")).
+						WithArgs("review", 5, "content has 3 links").
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reportRepository := repository.NewReportRepository(manager)
+
+			ctx := context.Background()
+			res, err := reportRepository.CreateFilterReport(ctx, c.input.args)
+			assert.Equal(t, c.expected.report, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReportRepositorySuite) TestFindByUserIDAndTarget() {
+	type testInput struct {
+		args  usecaserepository.FindReportByUserIDAndTargetParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		report *entity.Report
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_report_when_exist_record",
+			input: testInput{
+				args: usecaserepository.FindReportByUserIDAndTargetParams{
+					UserID:     2,
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reportsTableRows)
+					rows.AddRow(3, 2, "otheruser", "comment", 7, "spam", "resolved",
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE content_reports.user_id = ? AND content_reports.target_type = ?
+						AND content_reports.target_id = ?`)).
+						WithArgs(2, "comment", 7).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				report: &entity.Report{
+					ID:         3,
+					UserID:     2,
+					Username:   "otheruser",
+					TargetType: entity.ReportTargetComment,
+					TargetID:   7,
+					Reason:     "spam",
+					Status:     entity.ReportStatusResolved,
+					CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:  utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_there_is_no_record",
+			input: testInput{
+				args: usecaserepository.FindReportByUserIDAndTargetParams{
+					UserID:     2,
+					TargetType: entity.ReportTargetReview,
+					TargetID:   5,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM content_reports`)).
+						WithArgs(2, "review", 5).
+						WillReturnRows(sqlmock.NewRows(reportsTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reportRepository := repository.NewReportRepository(manager)
+
+			ctx := context.Background()
+			res, err := reportRepository.FindByUserIDAndTarget(ctx, c.input.args)
+			assert.Equal(t, c.expected.report, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReportRepositorySuite) TestFindReports() {
+	type testInput struct {
+		args  usecaserepository.FindReportsParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.ReportPage
+		err  error
+	}
+
+	report := func(id uint64) *entity.Report {
+		return &entity.Report{
+			ID:         id,
+			UserID:     2,
+			Username:   "otheruser",
+			TargetType: entity.ReportTargetReview,
+			TargetID:   5,
+			Reason:     "offensive language",
+			Status:     entity.ReportStatusOpen,
+			CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			UpdatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		}
+	}
+
+	addReport := func(rows *sqlmock.Rows, id uint64) *sqlmock.Rows {
+		return rows.AddRow(id, 2, "otheruser", "review", 5, "offensive language", "open",
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_first_page_of_reports_of_status_oldest_first",
+			input: testInput{
+				args: usecaserepository.FindReportsParams{Status: entity.ReportStatusOpen, Limit: 1},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reportsTableRows)
+					addReport(rows, 3)
+					addReport(rows, 4)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE content_reports.status = ?
+						ORDER BY content_reports.id ASC
+						LIMIT ?`)).
+						WithArgs("open", 2).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReportPage{
+					Reports: []*entity.Report{report(3)},
+					Next:    &usecaserepository.ReportCursor{ID: 3},
+				},
+			},
+		},
+		{
+			name: "returns_last_page_of_reports_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindReportsParams{Status: entity.ReportStatusOpen, Limit: 50,
+					After: &usecaserepository.ReportCursor{ID: 3}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE content_reports.status = ?
+						AND content_reports.id > ?
+						ORDER BY content_reports.id ASC
+						LIMIT ?`)).
+						WithArgs("open", 3, 51).
+						WillReturnRows(addReport(sqlmock.NewRows(reportsTableRows), 4))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReportPage{Reports: []*entity.Report{report(4)}},
+			},
+		},
+		{
+			name: "returns_empty_page_without_next_when_limit_is_zero",
+			input: testInput{
+				args: usecaserepository.FindReportsParams{Status: entity.ReportStatusOpen},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM content_reports`)).
+						WithArgs("open", 1).
+						WillReturnRows(addReport(sqlmock.NewRows(reportsTableRows), 3))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReportPage{Reports: []*entity.Report{}},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindReportsParams{Status: entity.ReportStatusOpen, Limit: 50},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM content_reports`)).
+						WithArgs("open", 51).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reportRepository := repository.NewReportRepository(manager)
+
+			ctx := context.Background()
+			res, err := reportRepository.FindReports(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
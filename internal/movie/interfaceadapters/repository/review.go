@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type reviewRepository struct {
+	connManager ConnManager
+}
+
+func NewReviewRepository(connManager ConnManager) *reviewRepository {
+	return &reviewRepository{connManager: connManager}
+}
+
+// reviewColumns are the columns which are needed to build an entity.Review, the query using them has to
+// join the tables of reviewJoins
+const reviewColumns = `reviews.id, reviews.movie_id, reviews.user_id, users.username,
+users.is_critic AS author_is_critic, reviews.title, reviews.content, reviews.is_spoiler, reviews.moderation_status, reviews.helpful_count,
+reviews.not_helpful_count, ratings.score AS author_rating, reviews.edited_at, reviews.created_at,
+reviews.updated_at`
+
+const reviewJoins = `INNER JOIN users
+ON reviews.user_id = users.id
+LEFT JOIN ratings
+ON reviews.user_id = ratings.user_id AND reviews.movie_id = ratings.movie_id`
+
+const createReviewQuery = `INSERT INTO reviews(user_id, movie_id, title, content, is_spoiler, moderation_status)
+VALUES (?,?,?,?,?,?)`
+
+func (r *reviewRepository) CreateReview(ctx context.Context, args repository.CreateReviewParams) (*entity.Review, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, createReviewQuery, args.UserID, args.MovieID, args.Title, args.Content,
+		args.IsSpoiler, args.ModerationStatus)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, repository.ErrReviewExists
+		}
+
+		return nil, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	createdReviewID, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("LastInsertId: %w", err)
+	}
+
+	review := &Review{}
+	if err := r.connManager.GetWriter().QueryRowxContext(ctx, findReviewByIDQuery, createdReviewID).StructScan(review); err != nil {
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return review.toEntity(), nil
+}
+
+const findReviewByIDQuery = `SELECT ` + reviewColumns + `
+FROM reviews
+` + reviewJoins + `
+WHERE reviews.id = ?`
+
+func (r *reviewRepository) FindByID(ctx context.Context, reviewID uint64) (*entity.Review, error) {
+	review := &Review{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findReviewByIDQuery, reviewID).StructScan(review); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return review.toEntity(), nil
+}
+
+const findReviewByUserIDAndMovieIDQuery = `SELECT ` + reviewColumns + `
+FROM reviews
+` + reviewJoins + `
+WHERE reviews.user_id = ? AND reviews.movie_id = ?`
+
+func (r *reviewRepository) FindByUserIDAndMovieID(ctx context.Context,
+	args repository.FindReviewByUserIDAndMovieIDParams) (*entity.Review, error) {
+	review := &Review{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findReviewByUserIDAndMovieIDQuery, args.UserID,
+		args.MovieID).StructScan(review); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return review.toEntity(), nil
+}
+
+// findReviewsByMovieIDQuery lists the reviews of a movie with their helpfulness, the %s are the conditions of the
+// author and of the cursor, the HAVING clause and the ORDER BY expressions
+const findReviewsByMovieIDQuery = `SELECT ` + reviewColumns + `,
+` + reviewHelpfulness + ` AS helpfulness
+FROM reviews
+` + reviewJoins + `
+WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'%s%s%s
+ORDER BY %s
+LIMIT ?`
+
+// reviewHelpfulness is the lower bound of the wilson score confidence interval (z = 1.96) for the
+// proportion of helpful votes, so a review with few votes is not ranked above one with many mostly
+// helpful votes
+const reviewHelpfulness = `IF(reviews.helpful_count + reviews.not_helpful_count = 0, 0,
+((reviews.helpful_count + 1.9208) / (reviews.helpful_count + reviews.not_helpful_count)
+- 1.96 * SQRT(reviews.helpful_count * reviews.not_helpful_count / (reviews.helpful_count + reviews.not_helpful_count) + 0.9604)
+/ (reviews.helpful_count + reviews.not_helpful_count))
+/ (1 + 3.8416 / (reviews.helpful_count + reviews.not_helpful_count)))`
+
+var reviewAuthorConditions = map[repository.ReviewAuthorType]string{
+	repository.ReviewAuthorAll:      ``,
+	repository.ReviewAuthorCritic:   ` AND users.is_critic = TRUE`,
+	repository.ReviewAuthorAudience: ` AND users.is_critic = FALSE`,
+}
+
+// reviewOrder is the part of a review list query which depends on its sort, after continues the list after the
+// cursor in the WHERE clause and having does it in the HAVING clause when it compares the selected helpfulness
+type reviewOrder struct {
+	after      string
+	afterArgs  []interface{}
+	having     string
+	havingArgs []interface{}
+	orderBy    string
+}
+
+// orderReviews returns the part of the query which orders the reviews by the sort and continues the list after the
+// cursor. The reviews whose author has not rated the movie are put last when they are ordered by the author rating
+func orderReviews(sort repository.ReviewSort, after *repository.ReviewCursor) (reviewOrder, error) {
+	switch sort {
+	case repository.ReviewSortHelpful:
+		order := reviewOrder{orderBy: "helpfulness DESC, reviews.id ASC"}
+		if after != nil {
+			if after.Helpfulness == nil {
+				return reviewOrder{}, ErrCursorWithoutSortValue
+			}
+
+			order.having = "\nHAVING (helpfulness < ? OR (helpfulness = ? AND reviews.id > ?))"
+			order.havingArgs = []interface{}{*after.Helpfulness, *after.Helpfulness, after.ID}
+		}
+
+		return order, nil
+	case repository.ReviewSortNewest:
+		order := reviewOrder{orderBy: "reviews.created_at DESC, reviews.id DESC"}
+		if after != nil {
+			if after.CreatedAt == nil {
+				return reviewOrder{}, ErrCursorWithoutSortValue
+			}
+
+			order.after = "\nAND (reviews.created_at < ? OR (reviews.created_at = ? AND reviews.id < ?))"
+			order.afterArgs = []interface{}{*after.CreatedAt, *after.CreatedAt, after.ID}
+		}
+
+		return order, nil
+	case repository.ReviewSortRating:
+		order := reviewOrder{orderBy: "ratings.score IS NULL, ratings.score DESC, reviews.id ASC"}
+		switch {
+		case after == nil:
+		case after.AuthorRating == nil:
+			order.after = "\nAND ratings.score IS NULL AND reviews.id > ?"
+			order.afterArgs = []interface{}{after.ID}
+		default:
+			order.after = "\nAND (ratings.score < ? OR (ratings.score = ? AND reviews.id > ?) OR ratings.score IS NULL)"
+			order.afterArgs = []interface{}{*after.AuthorRating, *after.AuthorRating, after.ID}
+		}
+
+		return order, nil
+	}
+
+	return reviewOrder{}, fmt.Errorf("invalid review sort: %s", sort)
+}
+
+func (r *reviewRepository) FindByMovieID(ctx context.Context, args repository.FindReviewsByMovieIDParams) (*repository.ReviewPage, error) {
+	order, err := orderReviews(args.Sort, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	authorCondition, ok := reviewAuthorConditions[args.AuthorType]
+	if !ok {
+		return nil, fmt.Errorf("invalid review author type: %s", args.AuthorType)
+	}
+
+	queryArgs := []interface{}{args.MovieID}
+	queryArgs = append(queryArgs, order.afterArgs...)
+	queryArgs = append(queryArgs, order.havingArgs...)
+	queryArgs = append(queryArgs, args.Limit+1)
+	reviews := make([]*entity.Review, 0)
+	helpfulness := make([]float64, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(findReviewsByMovieIDQuery, authorCondition,
+		order.after, order.having, order.orderBy), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		review := &struct {
+			*Review
+			Helpfulness float64 `json:"helpfulness" db:"helpfulness"`
+		}{}
+		if err = rows.StructScan(review); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		reviews = append(reviews, review.toEntity())
+		helpfulness = append(helpfulness, review.Helpfulness)
+	}
+
+	// the list is queried with one more review than the limit so that the next page exists only when it is found
+	if uint(len(reviews)) <= args.Limit {
+		return &repository.ReviewPage{Reviews: reviews}, nil
+	}
+
+	reviews = reviews[:args.Limit]
+	if args.Limit == 0 {
+		// a page without any review has no review to continue after
+		return &repository.ReviewPage{Reviews: reviews}, nil
+	}
+
+	last := reviews[len(reviews)-1]
+	next := &repository.ReviewCursor{ID: last.ID, Sort: args.Sort}
+	switch args.Sort {
+	case repository.ReviewSortHelpful:
+		next.Helpfulness = &helpfulness[len(reviews)-1]
+	case repository.ReviewSortNewest:
+		next.CreatedAt = &last.CreatedAt
+	case repository.ReviewSortRating:
+		next.AuthorRating = last.AuthorRating
+	}
+
+	return &repository.ReviewPage{Reviews: reviews, Next: next}, nil
+}
+
+const createReviewRevisionQuery = `INSERT INTO review_revisions(review_id, title, content, is_spoiler, created_at)
+SELECT id, title, content, is_spoiler, COALESCE(edited_at, created_at) FROM reviews WHERE id = ?`
+
+const updateReviewQuery = `UPDATE reviews SET title = ?, content = ?, is_spoiler = ?, moderation_status = ?,
+edited_at = CURRENT_TIMESTAMP WHERE id = ?`
+
+func (r *reviewRepository) UpdateReview(ctx context.Context, args repository.UpdateReviewParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, createReviewRevisionQuery, args.ID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, updateReviewQuery, args.Title, args.Content, args.IsSpoiler,
+			args.ModerationStatus, args.ID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const findReviewRevisionsQuery = `SELECT id, review_id, title, content, is_spoiler, created_at
+FROM review_revisions WHERE review_id = ? ORDER BY id ASC`
+
+func (r *reviewRepository) FindRevisions(ctx context.Context, reviewID uint64) ([]*entity.ReviewRevision, error) {
+	revisions := make([]*entity.ReviewRevision, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findReviewRevisionsQuery, reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		revision := &ReviewRevision{}
+		if err = rows.StructScan(revision); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		revisions = append(revisions, &entity.ReviewRevision{
+			Title:     revision.Title,
+			Content:   revision.Content,
+			IsSpoiler: revision.IsSpoiler,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	return revisions, nil
+}
+
+const deleteReviewQuery = `DELETE FROM reviews WHERE id = ?`
+
+func (r *reviewRepository) DeleteReview(ctx context.Context, reviewID uint64) error {
+	_, err := r.connManager.GetWriter().ExecContext(ctx, deleteReviewQuery, reviewID)
+	if err != nil {
+		return fmt.Errorf("ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Review) toEntity() *entity.Review {
+	return &entity.Review{
+		ID:               r.ID,
+		MovieID:          r.MovieID,
+		UserID:           r.UserID,
+		Username:         r.Username,
+		AuthorIsCritic:   r.AuthorIsCritic,
+		Title:            r.Title,
+		Content:          r.Content,
+		IsSpoiler:        r.IsSpoiler,
+		ModerationStatus: entity.ModerationStatus(r.ModerationStatus),
+		HelpfulCount:     r.HelpfulCount,
+		NotHelpfulCount:  r.NotHelpfulCount,
+		AuthorRating:     r.AuthorRating,
+		Edited:           r.EditedAt != nil,
+		EditedAt:         r.EditedAt,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
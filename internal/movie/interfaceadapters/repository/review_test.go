@@ -0,0 +1,750 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReviewRepositorySuite struct {
+	suite.Suite
+}
+
+func TestReviewRepositorySuite(t *testing.T) {
+	suite.Run(t, &testReviewRepositorySuite{})
+}
+
+func (s *testReviewRepositorySuite) TestCreateReview() {
+	type testInput struct {
+		args  usecaserepository.CreateReviewParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_review_when_insert_successfully",
+			input: testInput{
+				args: usecaserepository.CreateReviewParams{
+					UserID:           1,
+					MovieID:          10,
+					Title:            "great movie",
+					Content:          "really enjoyed it",
+					ModerationStatus: entity.ModerationStatusVisible,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO reviews(user_id, movie_id, title, content, is_spoiler, moderation_status)
+VALUES (?,?,?,?,?,?)`)).
+						WithArgs(1, 10, "great movie", "really enjoyed it", false, "visible").
+						WillReturnResult(sqlmock.NewResult(5, 1))
+
+					rows := sqlmock.NewRows(reviewsTableRows)
+					rows.AddRow(5, 10, 1, "testuser", "great movie", "really enjoyed it", false, "visible", 0, 0, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), nil)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT ` + reviewColumnsQuery + `
+						FROM reviews
+						` + reviewJoinsQuery + `
+						WHERE reviews.id = ?`)).
+						WithArgs(5).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				review: &entity.Review{
+					ID:               5,
+					MovieID:          10,
+					UserID:           1,
+					Username:         "testuser",
+					Title:            "great movie",
+					Content:          "really enjoyed it",
+					ModerationStatus: entity.ModerationStatusVisible,
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_error_when_insert_failed",
+			input: testInput{
+				args: usecaserepository.CreateReviewParams{
+					UserID:           1,
+					MovieID:          10,
+					Title:            "great movie",
+					Content:          "really enjoyed it",
+					ModerationStatus: entity.ModerationStatusVisible,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO reviews(user_id, movie_id, title, content, is_spoiler, moderation_status)
+VALUES (?,?,?,?,?,?)`)).
+						WithArgs(1, 10, "great movie", "really enjoyed it", false, "visible").
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+		{
+			name: "returns_ErrReviewExists_when_user_already_reviewed_movie",
+			input: testInput{
+				args: usecaserepository.CreateReviewParams{
+					UserID:           1,
+					MovieID:          10,
+					Title:            "great movie",
+					Content:          "really enjoyed it",
+					ModerationStatus: entity.ModerationStatusVisible,
+				},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta(`INSERT INTO reviews(user_id, movie_id, title, content, is_spoiler, moderation_status)
+VALUES (?,?,?,?,?,?)`)).
+						WithArgs(1, 10, "great movie", "really enjoyed it", false, "visible").
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1-10' for key 'user_id'"})
+				},
+			},
+			expected: testOutput{
+				err: usecaserepository.ErrReviewExists,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			res, err := reviewRepository.CreateReview(ctx, c.input.args)
+			assert.Equal(t, c.expected.review, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewRepositorySuite) TestFindByID() {
+	type testInput struct {
+		reviewID uint64
+		mocks    func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_review_when_exist_record",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewsTableRows)
+					rows.AddRow(5, 10, 1, "testuser", "great movie", "really enjoyed it", false, "hidden", 3, 1, 8,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM reviews
+						` + reviewJoinsQuery + `
+						WHERE reviews.id = ?`)).
+						WithArgs(5).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				review: &entity.Review{
+					ID:               5,
+					MovieID:          10,
+					UserID:           1,
+					Username:         "testuser",
+					Title:            "great movie",
+					Content:          "really enjoyed it",
+					ModerationStatus: entity.ModerationStatusHidden,
+					HelpfulCount:     3,
+					NotHelpfulCount:  1,
+					AuthorRating:     utils.Uint8Ptr(8),
+					Edited:           true,
+					EditedAt:         utils.TimePtr(utils.MustRFC3339Time("2022-08-21T22:00:00+00:00")),
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_there_is_no_record",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.id = ?`)).
+						WithArgs(5).
+						WillReturnRows(sqlmock.NewRows(reviewsTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.id = ?`)).
+						WithArgs(5).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			res, err := reviewRepository.FindByID(ctx, c.input.reviewID)
+			assert.Equal(t, c.expected.review, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewRepositorySuite) TestFindByMovieID() {
+	type testInput struct {
+		args  usecaserepository.FindReviewsByMovieIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.ReviewPage
+		err  error
+	}
+
+	helpfulness := 0.3
+	createdAt := utils.MustRFC3339Time("2022-08-21T22:00:00+00:00")
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_first_page_of_reviews_sorted_by_helpfulness_with_next_cursor",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortHelpful,
+					Limit: 1},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(reviewsTableRows, "helpfulness"))
+					rows.AddRow(5, 10, 1, "testuser", "great movie", "really enjoyed it", false, "visible", 3, 1, 8,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), nil, 0.3)
+					rows.AddRow(6, 10, 2, "otheruser", "boring", "fell asleep", true, "visible", 0, 2, nil,
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"), nil, 0)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT `+reviewColumnsQuery+`,
+						IF(reviews.helpful_count + reviews.not_helpful_count = 0, 0,
+						((reviews.helpful_count + 1.9208) / (reviews.helpful_count + reviews.not_helpful_count)
+						- 1.96 * SQRT(reviews.helpful_count * reviews.not_helpful_count / (reviews.helpful_count + reviews.not_helpful_count) + 0.9604)
+						/ (reviews.helpful_count + reviews.not_helpful_count))
+						/ (1 + 3.8416 / (reviews.helpful_count + reviews.not_helpful_count))) AS helpfulness
+						FROM reviews
+						`+reviewJoinsQuery+`
+						WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'
+						ORDER BY helpfulness DESC, reviews.id ASC
+						LIMIT ?`)).
+						WithArgs(10, 2).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{
+					Reviews: []*entity.Review{
+						{
+							ID:               5,
+							MovieID:          10,
+							UserID:           1,
+							Username:         "testuser",
+							Title:            "great movie",
+							Content:          "really enjoyed it",
+							ModerationStatus: entity.ModerationStatusVisible,
+							HelpfulCount:     3,
+							NotHelpfulCount:  1,
+							AuthorRating:     utils.Uint8Ptr(8),
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						},
+					},
+					Next: &usecaserepository.ReviewCursor{ID: 5, Sort: usecaserepository.ReviewSortHelpful,
+						Helpfulness: &helpfulness},
+				},
+			},
+		},
+		{
+			name: "continues_reviews_sorted_by_helpfulness_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortHelpful,
+					Limit: 20, After: &usecaserepository.ReviewCursor{ID: 5, Sort: usecaserepository.ReviewSortHelpful,
+						Helpfulness: &helpfulness}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(reviewsTableRows, "helpfulness"))
+					rows.AddRow(6, 10, 2, "otheruser", "boring", "fell asleep", true, "visible", 0, 2, nil,
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"), nil, 0)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'
+						HAVING (helpfulness < ? OR (helpfulness = ? AND reviews.id > ?))
+						ORDER BY helpfulness DESC, reviews.id ASC
+						LIMIT ?`)).
+						WithArgs(10, 0.3, 0.3, 5, 21).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{
+					Reviews: []*entity.Review{
+						{
+							ID:               6,
+							MovieID:          10,
+							UserID:           2,
+							Username:         "otheruser",
+							Title:            "boring",
+							Content:          "fell asleep",
+							IsSpoiler:        true,
+							ModerationStatus: entity.ModerationStatusVisible,
+							NotHelpfulCount:  2,
+							CreatedAt:        utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "continues_reviews_sorted_by_newest_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortNewest,
+					Limit: 20, After: &usecaserepository.ReviewCursor{ID: 6, Sort: usecaserepository.ReviewSortNewest,
+						CreatedAt: &createdAt}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'
+						AND (reviews.created_at < ? OR (reviews.created_at = ? AND reviews.id < ?))
+						ORDER BY reviews.created_at DESC, reviews.id DESC
+						LIMIT ?`)).
+						WithArgs(10, createdAt, createdAt, 6, 21).
+						WillReturnRows(sqlmock.NewRows(append(reviewsTableRows, "helpfulness")))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{Reviews: []*entity.Review{}},
+			},
+		},
+		{
+			name: "continues_reviews_sorted_by_author_rating_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortRating,
+					Limit: 20, After: &usecaserepository.ReviewCursor{ID: 5, Sort: usecaserepository.ReviewSortRating,
+						AuthorRating: utils.Uint8Ptr(8)}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'
+						AND (ratings.score < ? OR (ratings.score = ? AND reviews.id > ?) OR ratings.score IS NULL)
+						ORDER BY ratings.score IS NULL, ratings.score DESC, reviews.id ASC
+						LIMIT ?`)).
+						WithArgs(10, 8, 8, 5, 21).
+						WillReturnRows(sqlmock.NewRows(append(reviewsTableRows, "helpfulness")))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{Reviews: []*entity.Review{}},
+			},
+		},
+		{
+			name: "continues_reviews_without_author_rating_after_cursor_without_author_rating",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortRating,
+					Limit: 20, After: &usecaserepository.ReviewCursor{ID: 6, Sort: usecaserepository.ReviewSortRating}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible'
+						AND ratings.score IS NULL AND reviews.id > ?
+						ORDER BY ratings.score IS NULL, ratings.score DESC, reviews.id ASC`)).
+						WithArgs(10, 6, 21).
+						WillReturnRows(sqlmock.NewRows(append(reviewsTableRows, "helpfulness")))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{Reviews: []*entity.Review{}},
+			},
+		},
+		{
+			name: "returns_reviews_of_critics_when_author_type_is_critic",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortNewest,
+					AuthorType: usecaserepository.ReviewAuthorCritic, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(append(reviewsTableRows, "author_is_critic", "helpfulness"))
+					rows.AddRow(1, 10, 1, "testcritic", "Great", "Loved it", false, "visible", 0, 0, nil,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						nil, true, 0)
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible' AND users.is_critic = TRUE
+						ORDER BY reviews.created_at DESC, reviews.id DESC`)).
+						WithArgs(10, 21).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{
+					Reviews: []*entity.Review{
+						{
+							ID:               1,
+							MovieID:          10,
+							UserID:           1,
+							Username:         "testcritic",
+							AuthorIsCritic:   true,
+							Title:            "Great",
+							Content:          "Loved it",
+							ModerationStatus: entity.ModerationStatusVisible,
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_reviews_of_other_users_when_author_type_is_audience",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortNewest,
+					AuthorType: usecaserepository.ReviewAuthorAudience, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ? AND reviews.moderation_status = 'visible' AND users.is_critic = FALSE
+						ORDER BY reviews.created_at DESC, reviews.id DESC`)).
+						WithArgs(10, 21).
+						WillReturnRows(sqlmock.NewRows(append(reviewsTableRows, "helpfulness")))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.ReviewPage{Reviews: []*entity.Review{}},
+			},
+		},
+		{
+			name: "returns_error_when_author_type_is_invalid",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortNewest,
+					AuthorType: "director"},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("invalid review author type: %s", "director"),
+			},
+		},
+		{
+			name: "returns_error_when_sort_is_invalid",
+			input: testInput{
+				args:  usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: "oldest"},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("invalid review sort: %s", "oldest"),
+			},
+		},
+		{
+			name: "returns_error_when_newest_cursor_has_no_created_at",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortNewest,
+					After: &usecaserepository.ReviewCursor{ID: 6, Sort: usecaserepository.ReviewSortNewest}},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{
+				err: repository.ErrCursorWithoutSortValue,
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindReviewsByMovieIDParams{MovieID: 10, Sort: usecaserepository.ReviewSortHelpful},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`WHERE reviews.movie_id = ?`)).
+						WithArgs(10, 1).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			res, err := reviewRepository.FindByMovieID(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewRepositorySuite) TestUpdateReview() {
+	type testInput struct {
+		args  usecaserepository.UpdateReviewParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	args := usecaserepository.UpdateReviewParams{ID: 5, Title: "updated", Content: "changed my mind", IsSpoiler: true,
+		ModerationStatus: entity.ModerationStatusPending}
+	createRevisionQuery := regexp.QuoteMeta(`INSERT INTO review_revisions(review_id, title, content, is_spoiler, created_at)
+	SELECT id, title, content, is_spoiler, COALESCE(edited_at, created_at) FROM reviews WHERE id = ?`)
+	updateQuery := regexp.QuoteMeta(`UPDATE reviews SET title = ?, content = ?, is_spoiler = ?, moderation_status = ?,
+	edited_at = CURRENT_TIMESTAMP WHERE id = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_keep_revision_and_update_successfully",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.
+						ExpectExec(createRevisionQuery).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(2, 1))
+					mock.
+						ExpectExec(updateQuery).
+						WithArgs("updated", "changed my mind", true, "pending", 5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_and_rollback_when_update_failed",
+			input: testInput{
+				args: args,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.
+						ExpectExec(createRevisionQuery).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(2, 1))
+					mock.
+						ExpectExec(updateQuery).
+						WithArgs("updated", "changed my mind", true, "pending", 5).
+						WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			err := reviewRepository.UpdateReview(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewRepositorySuite) TestFindRevisions() {
+	type testInput struct {
+		reviewID uint64
+		mocks    func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		revisions []*entity.ReviewRevision
+		err       error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, review_id, title, content, is_spoiler, created_at
+	FROM review_revisions WHERE review_id = ? ORDER BY id ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_revisions_oldest_first",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewRevisionsTableRows)
+					rows.AddRow(1, 5, "great movie", "really enjoyed it", false,
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					rows.AddRow(2, 5, "good movie", "enjoyed it", true,
+						utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"))
+					mock.
+						ExpectQuery(query).
+						WithArgs(5).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				revisions: []*entity.ReviewRevision{
+					{
+						Title:     "great movie",
+						Content:   "really enjoyed it",
+						CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					},
+					{
+						Title:     "good movie",
+						Content:   "enjoyed it",
+						IsSpoiler: true,
+						CreatedAt: utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+					},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(query).
+						WithArgs(5).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			res, err := reviewRepository.FindRevisions(ctx, c.input.reviewID)
+			assert.Equal(t, c.expected.revisions, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewRepositorySuite) TestDeleteReview() {
+	type testInput struct {
+		reviewID uint64
+		mocks    func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_delete_successfully",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("DELETE FROM reviews WHERE id = ?")).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_delete_failed",
+			input: testInput{
+				reviewID: 5,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectExec(regexp.QuoteMeta("DELETE FROM reviews WHERE id = ?")).
+						WithArgs(5).
+						WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewRepository := repository.NewReviewRepository(manager)
+
+			ctx := context.Background()
+			err := reviewRepository.DeleteReview(ctx, c.input.reviewID)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type reviewVoteRepository struct {
+	connManager ConnManager
+}
+
+func NewReviewVoteRepository(connManager ConnManager) *reviewVoteRepository {
+	return &reviewVoteRepository{connManager: connManager}
+}
+
+const findReviewVoteByUserIDAndReviewIDQuery = `SELECT user_id, review_id, helpful, created_at, updated_at
+FROM review_votes WHERE user_id = ? AND review_id = ?`
+
+func (r *reviewVoteRepository) FindByUserIDAndReviewID(ctx context.Context,
+	args repository.FindReviewVoteByUserIDAndReviewIDParams) (*entity.ReviewVote, error) {
+	vote := &ReviewVote{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findReviewVoteByUserIDAndReviewIDQuery, args.UserID,
+		args.ReviewID).StructScan(vote); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.ReviewVote{
+		UserID:    vote.UserID,
+		ReviewID:  vote.ReviewID,
+		Helpful:   vote.Helpful,
+		CreatedAt: vote.CreatedAt,
+		UpdatedAt: vote.UpdatedAt,
+	}, nil
+}
+
+const lockReviewVoteQuery = `SELECT user_id, review_id, helpful, created_at, updated_at
+FROM review_votes WHERE user_id = ? AND review_id = ? FOR UPDATE`
+
+const insertReviewVoteQuery = `INSERT INTO review_votes(user_id, review_id, helpful) VALUES (?,?,?)`
+
+const updateReviewVoteQuery = `UPDATE review_votes SET helpful = ? WHERE user_id = ? AND review_id = ?`
+
+const deleteReviewVoteQuery = `DELETE FROM review_votes WHERE user_id = ? AND review_id = ?`
+
+// the helpful counts are not a change of the review itself so updated_at is kept as it is
+const addReviewVoteCountQuery = `UPDATE reviews SET %[1]s = %[1]s + 1, updated_at = updated_at WHERE id = ?`
+
+const changeReviewVoteCountQuery = `UPDATE reviews SET %[1]s = %[1]s - 1, %[2]s = %[2]s + 1, updated_at = updated_at
+WHERE id = ?`
+
+const removeReviewVoteCountQuery = `UPDATE reviews SET %[1]s = %[1]s - 1, updated_at = updated_at WHERE id = ?`
+
+func (r *reviewVoteRepository) UpsertReviewVote(ctx context.Context, args repository.UpsertReviewVoteParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		current, err := lockReviewVote(ctx, tx, args.UserID, args.ReviewID)
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			if _, err := tx.ExecContext(ctx, insertReviewVoteQuery, args.UserID, args.ReviewID, args.Helpful); err != nil {
+				return fmt.Errorf("ExecContext: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(addReviewVoteCountQuery, reviewVoteCountColumn(args.Helpful)),
+				args.ReviewID); err != nil {
+				return fmt.Errorf("ExecContext: %w", err)
+			}
+
+			return nil
+		}
+
+		if current.Helpful == args.Helpful {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, updateReviewVoteQuery, args.Helpful, args.UserID, args.ReviewID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(changeReviewVoteCountQuery, reviewVoteCountColumn(current.Helpful),
+			reviewVoteCountColumn(args.Helpful)), args.ReviewID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *reviewVoteRepository) DeleteReviewVote(ctx context.Context, args repository.DeleteReviewVoteParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		current, err := lockReviewVote(ctx, tx, args.UserID, args.ReviewID)
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteReviewVoteQuery, args.UserID, args.ReviewID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(removeReviewVoteCountQuery, reviewVoteCountColumn(current.Helpful)),
+			args.ReviewID); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// lockReviewVote returns the vote of user for the review and locks it until the end of the transaction
+func lockReviewVote(ctx context.Context, tx *sqlx.Tx, userID uint64, reviewID uint64) (*ReviewVote, error) {
+	vote := &ReviewVote{}
+	if err := tx.QueryRowxContext(ctx, lockReviewVoteQuery, userID, reviewID).StructScan(vote); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return vote, nil
+}
+
+// reviewVoteCountColumn returns the reviews column which counts the votes of given kind
+func reviewVoteCountColumn(helpful bool) string {
+	if helpful {
+		return "helpful_count"
+	}
+
+	return "not_helpful_count"
+}
@@ -0,0 +1,284 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReviewVoteRepositorySuite struct {
+	suite.Suite
+}
+
+func TestReviewVoteRepositorySuite(t *testing.T) {
+	suite.Run(t, &testReviewVoteRepositorySuite{})
+}
+
+func (s *testReviewVoteRepositorySuite) TestFindByUserIDAndReviewID() {
+	type testInput struct {
+		args  usecaserepository.FindReviewVoteByUserIDAndReviewIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		vote *entity.ReviewVote
+		err  error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_vote_when_exist_record",
+			input: testInput{
+				args: usecaserepository.FindReviewVoteByUserIDAndReviewIDParams{UserID: 2, ReviewID: 5},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewVotesTableRows)
+					rows.AddRow(2, 5, true, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`SELECT user_id, review_id, helpful, created_at, updated_at
+						FROM review_votes WHERE user_id = ? AND review_id = ?`)).
+						WithArgs(2, 5).
+						WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				vote: &entity.ReviewVote{
+					UserID:    2,
+					ReviewID:  5,
+					Helpful:   true,
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_nil_when_there_is_no_record",
+			input: testInput{
+				args: usecaserepository.FindReviewVoteByUserIDAndReviewIDParams{UserID: 2, ReviewID: 5},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.
+						ExpectQuery(regexp.QuoteMeta(`FROM review_votes WHERE user_id = ? AND review_id = ?`)).
+						WithArgs(2, 5).
+						WillReturnRows(sqlmock.NewRows(reviewVotesTableRows))
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewVoteRepository := repository.NewReviewVoteRepository(manager)
+
+			ctx := context.Background()
+			res, err := reviewVoteRepository.FindByUserIDAndReviewID(ctx, c.input.args)
+			assert.Equal(t, c.expected.vote, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewVoteRepositorySuite) TestUpsertReviewVote() {
+	type testInput struct {
+		args  usecaserepository.UpsertReviewVoteParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	lockQuery := regexp.QuoteMeta(`SELECT user_id, review_id, helpful, created_at, updated_at
+	FROM review_votes WHERE user_id = ? AND review_id = ? FOR UPDATE`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "inserts_vote_and_increases_count_when_review_is_not_voted_by_user",
+			input: testInput{
+				args: usecaserepository.UpsertReviewVoteParams{UserID: 2, ReviewID: 5, Helpful: true},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(sqlmock.NewRows(reviewVotesTableRows))
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO review_votes(user_id, review_id, helpful) VALUES (?,?,?)")).
+						WithArgs(2, 5, true).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE reviews SET helpful_count = helpful_count + 1, updated_at = updated_at
+						WHERE id = ?`)).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "updates_vote_and_moves_count_when_vote_is_changed",
+			input: testInput{
+				args: usecaserepository.UpsertReviewVoteParams{UserID: 2, ReviewID: 5, Helpful: false},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewVotesTableRows)
+					rows.AddRow(2, 5, true, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("UPDATE review_votes SET helpful = ? WHERE user_id = ? AND review_id = ?")).
+						WithArgs(false, 2, 5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE reviews
+						SET helpful_count = helpful_count - 1, not_helpful_count = not_helpful_count + 1, updated_at = updated_at
+						WHERE id = ?`)).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "does_nothing_when_vote_is_not_changed",
+			input: testInput{
+				args: usecaserepository.UpsertReviewVoteParams{UserID: 2, ReviewID: 5, Helpful: true},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewVotesTableRows)
+					rows.AddRow(2, 5, true, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(rows)
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "rollbacks_when_update_count_failed",
+			input: testInput{
+				args: usecaserepository.UpsertReviewVoteParams{UserID: 2, ReviewID: 5, Helpful: false},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(sqlmock.NewRows(reviewVotesTableRows))
+					mock.
+						ExpectExec(regexp.QuoteMeta("INSERT INTO review_votes(user_id, review_id, helpful) VALUES (?,?,?)")).
+						WithArgs(2, 5, false).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE reviews SET not_helpful_count = not_helpful_count + 1`)).
+						WithArgs(5).
+						WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewVoteRepository := repository.NewReviewVoteRepository(manager)
+
+			ctx := context.Background()
+			err := reviewVoteRepository.UpsertReviewVote(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testReviewVoteRepositorySuite) TestDeleteReviewVote() {
+	type testInput struct {
+		args  usecaserepository.DeleteReviewVoteParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	lockQuery := regexp.QuoteMeta(`FROM review_votes WHERE user_id = ? AND review_id = ? FOR UPDATE`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "deletes_vote_and_decreases_count",
+			input: testInput{
+				args: usecaserepository.DeleteReviewVoteParams{UserID: 2, ReviewID: 5},
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(reviewVotesTableRows)
+					rows.AddRow(2, 5, false, utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"))
+
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(rows)
+					mock.
+						ExpectExec(regexp.QuoteMeta("DELETE FROM review_votes WHERE user_id = ? AND review_id = ?")).
+						WithArgs(2, 5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.
+						ExpectExec(regexp.QuoteMeta(`UPDATE reviews SET not_helpful_count = not_helpful_count - 1, updated_at = updated_at
+						WHERE id = ?`)).
+						WithArgs(5).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "does_nothing_when_review_is_not_voted_by_user",
+			input: testInput{
+				args: usecaserepository.DeleteReviewVoteParams{UserID: 2, ReviewID: 5},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).WithArgs(2, 5).WillReturnRows(sqlmock.NewRows(reviewVotesTableRows))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			reviewVoteRepository := repository.NewReviewVoteRepository(manager)
+
+			ctx := context.Background()
+			err := reviewVoteRepository.DeleteReviewVote(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+type tagRepository struct {
+	connManager ConnManager
+}
+
+func NewTagRepository(connManager ConnManager) *tagRepository {
+	return &tagRepository{connManager: connManager}
+}
+
+const findTagBySlugQuery = `SELECT id, slug FROM tags WHERE slug = ?`
+
+func (r *tagRepository) FindBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
+	tag := &Tag{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findTagBySlugQuery, slug).StructScan(tag); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("QueryRowxContext: %w", err)
+	}
+
+	return &entity.Tag{ID: tag.ID, Slug: tag.Slug}, nil
+}
+
+// findMoviesByTagIDQuery lists the movies of a tag, the %s is the condition of the cursor
+const findMoviesByTagIDQuery = `SELECT ` + movieColumns + `, tagged_movies.user_count
+FROM (SELECT movie_id, COUNT(*) AS user_count FROM movie_tags WHERE tag_id = ? GROUP BY movie_id) AS tagged_movies
+INNER JOIN movies
+ON tagged_movies.movie_id = movies.id
+` + movieRatingStatsJoin + `
+WHERE movies.deleted_at IS NULL%s
+ORDER BY tagged_movies.user_count DESC, movies.id ASC
+LIMIT ?`
+
+const taggedMovieAfterCondition = `
+AND (tagged_movies.user_count < ? OR (tagged_movies.user_count = ? AND movies.id > ?))`
+
+func (r *tagRepository) FindMoviesByTagID(ctx context.Context,
+	args repository.FindMoviesByTagIDParams) (*repository.TaggedMoviePage, error) {
+	after, queryArgs := "", []interface{}{args.TagID}
+	if args.After != nil {
+		after = taggedMovieAfterCondition
+		queryArgs = append(queryArgs, args.After.UserCount, args.After.UserCount, args.After.ID)
+	}
+	queryArgs = append(queryArgs, args.Limit+1)
+
+	taggedMovies := make([]*entity.TaggedMovie, 0)
+	movies := make([]*entity.Movie, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, fmt.Sprintf(findMoviesByTagIDQuery, after), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		taggedMovie := &TaggedMovie{}
+		if err = rows.StructScan(taggedMovie); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		movie := taggedMovie.toEntity()
+		movies = append(movies, movie)
+		taggedMovies = append(taggedMovies, &entity.TaggedMovie{
+			UserCount: taggedMovie.UserCount,
+			Movie:     movie,
+		})
+	}
+
+	// the movies are queried with one more movie than the limit so that the next page exists only when it is found
+	page := &repository.TaggedMoviePage{Movies: taggedMovies}
+	if uint(len(taggedMovies)) > args.Limit {
+		page.Movies, movies = taggedMovies[:args.Limit], movies[:args.Limit]
+		// a page without any movie has no movie to continue after
+		if args.Limit > 0 {
+			last := page.Movies[len(page.Movies)-1]
+			page.Next = &repository.TaggedMovieCursor{ID: last.Movie.ID, UserCount: last.UserCount}
+		}
+	}
+
+	if err := attachGenres(ctx, r.connManager.GetReader(), movies); err != nil {
+		return nil, fmt.Errorf("attachGenres: %w", err)
+	}
+
+	return page, nil
+}
+
+const findTagUsagesByMovieIDQuery = `SELECT tags.slug, COUNT(*) AS user_count
+FROM movie_tags
+INNER JOIN tags
+ON movie_tags.tag_id = tags.id
+WHERE movie_tags.movie_id = ?
+GROUP BY tags.id, tags.slug
+ORDER BY user_count DESC, tags.slug ASC`
+
+func (r *tagRepository) FindUsagesByMovieID(ctx context.Context, movieID uint64) ([]*entity.TagUsage, error) {
+	usages := make([]*entity.TagUsage, 0)
+
+	rows, err := r.connManager.GetReader().QueryxContext(ctx, findTagUsagesByMovieIDQuery, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("QueryxContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		usage := &TagUsage{}
+		if err = rows.StructScan(usage); err != nil {
+			return nil, fmt.Errorf("StructScan: %w", err)
+		}
+
+		usages = append(usages, &entity.TagUsage{Slug: usage.Slug, UserCount: usage.UserCount})
+	}
+
+	return usages, nil
+}
+
+// insertTagsQuery keeps the existing tags as they are, %s is the values of the slugs
+const insertTagsQuery = `INSERT INTO tags(slug) VALUES %s ON DUPLICATE KEY UPDATE slug = slug`
+
+const insertMovieTagsQuery = `INSERT INTO movie_tags(movie_id, tag_id, user_id)
+SELECT ?, tags.id, ? FROM tags
+WHERE tags.slug IN (?) AND NOT EXISTS (SELECT 1 FROM movie_tags AS applied
+WHERE applied.movie_id = ? AND applied.tag_id = tags.id AND applied.user_id = ?)`
+
+func (r *tagRepository) AddMovieTags(ctx context.Context, args repository.AddMovieTagsParams) error {
+	if len(args.Slugs) == 0 {
+		return nil
+	}
+
+	slugs := make([]interface{}, len(args.Slugs))
+	for i, slug := range args.Slugs {
+		slugs[i] = slug
+	}
+
+	insertTags := fmt.Sprintf(insertTagsQuery, strings.TrimSuffix(strings.Repeat("(?), ", len(slugs)), ", "))
+	insertMovieTags, insertMovieTagsArgs, err := sqlx.In(insertMovieTagsQuery, args.MovieID, args.UserID, args.Slugs,
+		args.MovieID, args.UserID)
+	if err != nil {
+		return fmt.Errorf("sqlx.In: %w", err)
+	}
+
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, insertTags, slugs...); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, tx.Rebind(insertMovieTags), insertMovieTagsArgs...); err != nil {
+			return fmt.Errorf("ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const deleteMovieTagQuery = `DELETE movie_tags FROM movie_tags
+INNER JOIN tags
+ON movie_tags.tag_id = tags.id
+WHERE movie_tags.movie_id = ? AND movie_tags.user_id = ? AND tags.slug = ?`
+
+func (r *tagRepository) DeleteMovieTag(ctx context.Context, args repository.DeleteMovieTagParams) (int64, error) {
+	res, err := r.connManager.GetWriter().ExecContext(ctx, deleteMovieTagQuery, args.MovieID, args.UserID, args.Slug)
+	if err != nil {
+		return 0, fmt.Errorf("ExecContext: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("RowsAffected: %w", err)
+	}
+
+	return deleted, nil
+}
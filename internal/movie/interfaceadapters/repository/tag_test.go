@@ -0,0 +1,439 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
+	usecaserepository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTagRepositorySuite struct {
+	suite.Suite
+}
+
+func TestTagRepositorySuite(t *testing.T) {
+	suite.Run(t, &testTagRepositorySuite{})
+}
+
+func (s *testTagRepositorySuite) TestFindBySlug() {
+	type testInput struct {
+		slug  string
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		tag *entity.Tag
+		err error
+	}
+
+	query := regexp.QuoteMeta(`SELECT id, slug FROM tags WHERE slug = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_tag_when_exist_record",
+			input: testInput{
+				slug: "time-travel",
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(tagsTableRows)
+					rows.AddRow(1, "time-travel")
+					mock.ExpectQuery(query).WithArgs("time-travel").WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				tag: &entity.Tag{ID: 1, Slug: "time-travel"},
+			},
+		},
+		{
+			name: "returns_nil_when_not_exist_record",
+			input: testInput{
+				slug: "time-travel",
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs("time-travel").WillReturnError(sql.ErrNoRows)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				slug: "time-travel",
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs("time-travel").WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryRowxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			tagRepository := repository.NewTagRepository(manager)
+
+			ctx := context.Background()
+			res, err := tagRepository.FindBySlug(ctx, c.input.slug)
+			assert.Equal(t, c.expected.tag, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testTagRepositorySuite) TestFindMoviesByTagID() {
+	type testInput struct {
+		args  usecaserepository.FindMoviesByTagIDParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		page *usecaserepository.TaggedMoviePage
+		err  error
+	}
+
+	query := regexp.QuoteMeta(`SELECT ` + movieColumnsQuery + `, tagged_movies.user_count
+	FROM (SELECT movie_id, COUNT(*) AS user_count FROM movie_tags WHERE tag_id = ? GROUP BY movie_id) AS tagged_movies
+	INNER JOIN movies
+	ON tagged_movies.movie_id = movies.id
+	LEFT JOIN movie_rating_stats
+	ON movies.id = movie_rating_stats.movie_id
+	LEFT JOIN movie_critic_rating_stats
+	ON movies.id = movie_critic_rating_stats.movie_id
+	WHERE movies.deleted_at IS NULL
+	ORDER BY tagged_movies.user_count DESC, movies.id ASC
+	LIMIT ?`)
+
+	afterQuery := regexp.QuoteMeta(`WHERE movies.deleted_at IS NULL
+	AND (tagged_movies.user_count < ? OR (tagged_movies.user_count = ? AND movies.id > ?))
+	ORDER BY tagged_movies.user_count DESC, movies.id ASC
+	LIMIT ?`)
+
+	movieRows := func() *sqlmock.Rows {
+		rows := sqlmock.NewRows(append(moviesTableRows, "user_count"))
+		rows.AddRow(1, "accumsan sed", "Nigeria", nil, nil, nil, false, nil, nil, nil,
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 3)
+		rows.AddRow(3, "vitae", "Vietnam", nil, nil, nil, false, nil, nil, nil,
+			utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"), 1)
+		return rows
+	}
+
+	firstMovie := &entity.TaggedMovie{
+		UserCount: 3,
+		Movie: &entity.Movie{
+			ID:               1,
+			OriginalTitle:    "accumsan sed",
+			Title:            "accumsan sed",
+			OriginalLanguage: "Nigeria",
+			CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			Genres:           []entity.Genre{},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_movies_ordered_by_user_count",
+			input: testInput{
+				args: usecaserepository.FindMoviesByTagIDParams{TagID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnRows(movieRows())
+					genreRows := sqlmock.NewRows(movieGenresTableRows)
+					genreRows.AddRow(3, 10, "Horror", "horror")
+					mock.ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).WithArgs(1, 3).WillReturnRows(genreRows)
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.TaggedMoviePage{
+					Movies: []*entity.TaggedMovie{
+						firstMovie,
+						{
+							UserCount: 1,
+							Movie: &entity.Movie{
+								ID:               3,
+								OriginalTitle:    "vitae",
+								Title:            "vitae",
+								OriginalLanguage: "Vietnam",
+								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+								UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+								Genres:           []entity.Genre{{ID: 10, Name: "Horror", Slug: "horror"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_next_cursor_when_there_are_more_movies_after_cursor",
+			input: testInput{
+				args: usecaserepository.FindMoviesByTagIDParams{TagID: 1, Limit: 1,
+					After: &usecaserepository.TaggedMovieCursor{ID: 5, UserCount: 4}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(afterQuery).WithArgs(1, 4, 4, 5, 2).WillReturnRows(movieRows())
+					mock.ExpectQuery(regexp.QuoteMeta(movieGenresQuery)).WithArgs(1).
+						WillReturnRows(sqlmock.NewRows(movieGenresTableRows))
+				},
+			},
+			expected: testOutput{
+				page: &usecaserepository.TaggedMoviePage{
+					Movies: []*entity.TaggedMovie{firstMovie},
+					Next:   &usecaserepository.TaggedMovieCursor{ID: 1, UserCount: 3},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				args: usecaserepository.FindMoviesByTagIDParams{TagID: 1, Limit: 20},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1, 21).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			tagRepository := repository.NewTagRepository(manager)
+
+			ctx := context.Background()
+			res, err := tagRepository.FindMoviesByTagID(ctx, c.input.args)
+			assert.Equal(t, c.expected.page, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testTagRepositorySuite) TestFindUsagesByMovieID() {
+	type testInput struct {
+		movieID uint64
+		mocks   func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		usages []*entity.TagUsage
+		err    error
+	}
+
+	query := regexp.QuoteMeta(`SELECT tags.slug, COUNT(*) AS user_count
+	FROM movie_tags
+	INNER JOIN tags
+	ON movie_tags.tag_id = tags.id
+	WHERE movie_tags.movie_id = ?
+	GROUP BY tags.id, tags.slug
+	ORDER BY user_count DESC, tags.slug ASC`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_tags_of_movie",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					rows := sqlmock.NewRows(tagUsagesTableRows)
+					rows.AddRow("time-travel", 3)
+					rows.AddRow("slow-burn", 1)
+					mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				},
+			},
+			expected: testOutput{
+				usages: []*entity.TagUsage{
+					{Slug: "time-travel", UserCount: 3},
+					{Slug: "slow-burn", UserCount: 1},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_query_failed",
+			input: testInput{
+				movieID: 1,
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectQuery(query).WithArgs(1).WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("QueryxContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			tagRepository := repository.NewTagRepository(manager)
+
+			ctx := context.Background()
+			res, err := tagRepository.FindUsagesByMovieID(ctx, c.input.movieID)
+			assert.Equal(t, c.expected.usages, res)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testTagRepositorySuite) TestAddMovieTags() {
+	type testInput struct {
+		args  usecaserepository.AddMovieTagsParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	insertTagsQuery := regexp.QuoteMeta(`INSERT INTO tags(slug) VALUES (?), (?) ON DUPLICATE KEY UPDATE slug = slug`)
+	insertMovieTagsQuery := regexp.QuoteMeta(`INSERT INTO movie_tags(movie_id, tag_id, user_id)
+	SELECT ?, tags.id, ? FROM tags
+	WHERE tags.slug IN (?, ?) AND NOT EXISTS (SELECT 1 FROM movie_tags AS applied
+	WHERE applied.movie_id = ? AND applied.tag_id = tags.id AND applied.user_id = ?)`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "creates_tags_and_applies_them_to_movie",
+			input: testInput{
+				args: usecaserepository.AddMovieTagsParams{UserID: 1, MovieID: 2, Slugs: []string{"time-travel", "slow-burn"}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectExec(insertTagsQuery).WithArgs("time-travel", "slow-burn").
+						WillReturnResult(sqlmock.NewResult(3, 1))
+					mock.ExpectExec(insertMovieTagsQuery).WithArgs(2, 1, "time-travel", "slow-burn", 2, 1).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+					mock.ExpectCommit()
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "does_nothing_when_no_slug",
+			input: testInput{
+				args:  usecaserepository.AddMovieTagsParams{UserID: 1, MovieID: 2},
+				mocks: func(mock sqlmock.Sqlmock) {},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "rolls_back_when_applying_tags_failed",
+			input: testInput{
+				args: usecaserepository.AddMovieTagsParams{UserID: 1, MovieID: 2, Slugs: []string{"time-travel", "slow-burn"}},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectBegin()
+					mock.ExpectExec(insertTagsQuery).WithArgs("time-travel", "slow-burn").
+						WillReturnResult(sqlmock.NewResult(3, 1))
+					mock.ExpectExec(insertMovieTagsQuery).WithArgs(2, 1, "time-travel", "slow-burn", 2, 1).
+						WillReturnError(fmt.Errorf("dummy error"))
+					mock.ExpectRollback()
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			tagRepository := repository.NewTagRepository(manager)
+
+			ctx := context.Background()
+			err := tagRepository.AddMovieTags(ctx, c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testTagRepositorySuite) TestDeleteMovieTag() {
+	type testInput struct {
+		args  usecaserepository.DeleteMovieTagParams
+		mocks func(mock sqlmock.Sqlmock)
+	}
+
+	type testOutput struct {
+		deleted int64
+		err     error
+	}
+
+	query := regexp.QuoteMeta(`DELETE movie_tags FROM movie_tags
+	INNER JOIN tags
+	ON movie_tags.tag_id = tags.id
+	WHERE movie_tags.movie_id = ? AND movie_tags.user_id = ? AND tags.slug = ?`)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_number_of_deleted_tags",
+			input: testInput{
+				args: usecaserepository.DeleteMovieTagParams{UserID: 1, MovieID: 2, Slug: "time-travel"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(query).WithArgs(2, 1, "time-travel").WillReturnResult(sqlmock.NewResult(0, 1))
+				},
+			},
+			expected: testOutput{
+				deleted: 1,
+			},
+		},
+		{
+			name: "returns_error_when_exec_failed",
+			input: testInput{
+				args: usecaserepository.DeleteMovieTagParams{UserID: 1, MovieID: 2, Slug: "time-travel"},
+				mocks: func(mock sqlmock.Sqlmock) {
+					mock.ExpectExec(query).WithArgs(2, 1, "time-travel").WillReturnError(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("ExecContext: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			manager, clean := initMockConnManager(t, c.input.mocks)
+			defer clean()
+
+			tagRepository := repository.NewTagRepository(manager)
+
+			ctx := context.Background()
+			deleted, err := tagRepository.DeleteMovieTag(ctx, c.input.args)
+			assert.Equal(t, c.expected.deleted, deleted)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -6,12 +6,95 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/interfaceadapters/repository"
 )
 
 var favoritesTableRows []string = []string{"user_id", "movie_id", "created_at", "updated_at"}
 var moviesTableRows []string = []string{"id", "original_title", "original_language", "overview",
 	"poster_path", "backdrop_path", "adult", "release_date", "budget", "revenue", "created_at", "updated_at"}
+var ratingStatsTableRows []string = []string{"rating_count", "rating_sum", "rating_1", "rating_2", "rating_3",
+	"rating_4", "rating_5", "rating_6", "rating_7", "rating_8", "rating_9", "rating_10"}
+var criticRatingStatsTableRows []string = []string{"critic_rating_count", "critic_rating_sum"}
+var movieGenresTableRows []string = []string{"movie_id", "id", "name", "slug"}
+var genresTableRows []string = []string{"id", "name", "slug"}
+var movieTranslationsTableRows []string = []string{"movie_id", "language", "title", "overview"}
+var peopleTableRows []string = []string{"id", "name", "biography", "profile_path", "created_at", "updated_at"}
+var creditsTableRows []string = []string{"id", "movie_id", "person_id", "person_name", "profile_path", "role",
+	"department", "character_name", "billing_order"}
+var filmographyTableRows []string = []string{"id", "movie_id", "original_title", "poster_path", "release_date",
+	"release_date_precision", "role", "department", "character_name", "billing_order"}
+var collectionsTableRows []string = []string{"id", "name", "overview", "poster_path", "created_at", "updated_at"}
+var collectionSummariesTableRows []string = []string{"id", "name", "position", "movie_count"}
+var tagsTableRows []string = []string{"id", "slug"}
+var tagUsagesTableRows []string = []string{"slug", "user_count"}
+var externalIDsTableRows []string = []string{"movie_id", "source", "external_id"}
+var boxOfficeGrossesTableRows []string = []string{"id", "movie_id", "territory", "week_start", "gross", "currency"}
+var releaseDatesTableRows []string = []string{"id", "movie_id", "country", "release_type", "release_date",
+	"certification", "minimum_age"}
+var ratingsTableRows []string = []string{"user_id", "movie_id", "score", "created_at", "updated_at"}
+var reviewsTableRows []string = []string{"id", "movie_id", "user_id", "username", "title", "content",
+	"is_spoiler", "moderation_status", "helpful_count", "not_helpful_count", "author_rating", "created_at", "updated_at",
+	"edited_at"}
+var commentsTableRows []string = []string{"id", "review_id", "user_id", "username", "parent_id", "depth", "content",
+	"moderation_status", "deleted_at", "created_at", "updated_at", "reply_count"}
+var reviewVotesTableRows []string = []string{"user_id", "review_id", "helpful", "created_at", "updated_at"}
+var reportsTableRows []string = []string{"id", "user_id", "username", "target_type", "target_id", "reason", "status",
+	"created_at", "updated_at"}
+var postsTableRows []string = []string{"target_type", "id", "user_id", "content", "created_at"}
+var reviewRevisionsTableRows []string = []string{"id", "review_id", "title", "content", "is_spoiler", "created_at"}
+var moderationActionsTableRows []string = []string{"id", "moderator_id", "target_type", "target_id", "action", "note",
+	"created_at"}
+
+// mustDate returns the date formatted as 2006-01-02
+func mustDate(value string) entity.Date {
+	date, _, err := entity.ParseDate(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return date
+}
+
+func datePtr(value string) *entity.Date {
+	date := mustDate(value)
+	return &date
+}
+
+const movieColumnsQuery = `movies.id, movies.original_title, movies.original_language, movies.overview,
+movies.poster_path, movies.backdrop_path, movies.adult, movies.release_date, movies.release_date_precision,
+movies.budget, movies.revenue, movies.currency, movies.created_at, movies.updated_at,
+movie_rating_stats.rating_count, movie_rating_stats.rating_sum,
+movie_rating_stats.rating_1, movie_rating_stats.rating_2, movie_rating_stats.rating_3, movie_rating_stats.rating_4,
+movie_rating_stats.rating_5, movie_rating_stats.rating_6, movie_rating_stats.rating_7, movie_rating_stats.rating_8,
+movie_rating_stats.rating_9, movie_rating_stats.rating_10,
+movie_critic_rating_stats.rating_count AS critic_rating_count, movie_critic_rating_stats.rating_sum AS critic_rating_sum`
+
+const movieGenresQuery = `SELECT movie_genres.movie_id, genres.id, genres.name, genres.slug
+FROM movie_genres
+INNER JOIN genres
+ON movie_genres.genre_id = genres.id
+WHERE movie_genres.movie_id IN (`
+
+const reviewColumnsQuery = `reviews.id, reviews.movie_id, reviews.user_id, users.username,
+users.is_critic AS author_is_critic, reviews.title, reviews.content, reviews.is_spoiler, reviews.moderation_status, reviews.helpful_count,
+reviews.not_helpful_count, ratings.score AS author_rating, reviews.edited_at, reviews.created_at,
+reviews.updated_at`
+
+const reviewJoinsQuery = `INNER JOIN users
+ON reviews.user_id = users.id
+LEFT JOIN ratings
+ON reviews.user_id = ratings.user_id AND reviews.movie_id = ratings.movie_id`
+
+const commentColumnsQuery = `review_comments.id, review_comments.review_id, review_comments.user_id, users.username,
+review_comments.parent_id, review_comments.depth, review_comments.content, review_comments.moderation_status,
+review_comments.deleted_at, review_comments.created_at, review_comments.updated_at,
+(SELECT COUNT(*) FROM review_comments AS replies
+WHERE replies.parent_id = review_comments.id AND replies.moderation_status = 'visible') AS reply_count`
+
+const reportColumnsQuery = `content_reports.id, content_reports.user_id, users.username, content_reports.target_type,
+content_reports.target_id, content_reports.reason, content_reports.status, content_reports.created_at,
+content_reports.updated_at`
 
 type connManager struct {
 	db *sqlx.DB
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type boxOfficeUsecase struct {
+	cfg                 config.Config
+	movieRepository     repository.MovieRepository
+	boxOfficeRepository repository.BoxOfficeRepository
+	logger              logger.Logger
+}
+
+func NewBoxOfficeUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	boxOfficeRepository repository.BoxOfficeRepository) *boxOfficeUsecase {
+	return &boxOfficeUsecase{cfg: cfg, logger: log, movieRepository: movieRepository,
+		boxOfficeRepository: boxOfficeRepository}
+}
+
+// GetBoxOffice returns the budget, the revenue and the weekly grosses of the movie with their totals
+func (u *boxOfficeUsecase) GetBoxOffice(ctx context.Context, movieID uint64) (*entity.BoxOffice, error) {
+	movie, err := u.movieRepository.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	grosses, err := u.boxOfficeRepository.FindGrossesByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("boxOfficeRepository.FindGrossesByMovieID: %w", err))
+	}
+
+	boxOffice := &entity.BoxOffice{
+		MovieID:     movieID,
+		Weekly:      weeklyGrosses(grosses),
+		Territories: make([]*entity.TerritoryGrosses, 0),
+	}
+	boxOffice.Totals = totalGrosses(boxOffice.Weekly)
+
+	if movie.Currency != nil {
+		if movie.Budget != nil {
+			boxOffice.Budget = &entity.Money{Amount: int64(*movie.Budget), Currency: *movie.Currency}
+		}
+
+		if movie.Revenue != nil {
+			boxOffice.Revenue = &entity.Money{Amount: *movie.Revenue, Currency: *movie.Currency}
+		}
+	}
+
+	// the grosses are ordered by territory, so the grosses of a territory are next to each other
+	for start := 0; start < len(grosses); {
+		end := start + 1
+		for end < len(grosses) && grosses[end].Territory == grosses[start].Territory {
+			end++
+		}
+
+		weekly := weeklyGrosses(grosses[start:end])
+		boxOffice.Territories = append(boxOffice.Territories, &entity.TerritoryGrosses{
+			Territory: grosses[start].Territory,
+			Totals:    totalGrosses(weekly),
+			Weekly:    weekly,
+		})
+
+		start = end
+	}
+
+	return boxOffice, nil
+}
+
+// weeklyGrosses adds up the grosses of every week and returns them ordered by week together with the cumulative
+// grosses
+func weeklyGrosses(grosses []*entity.BoxOfficeGross) []*entity.WeeklyGross {
+	weekly := make([]*entity.WeeklyGross, 0)
+	weeks := make(map[int64]*entity.WeeklyGross)
+	for _, gross := range grosses {
+		week, ok := weeks[gross.WeekStart.Unix()]
+		if !ok {
+			week = &entity.WeeklyGross{WeekStart: gross.WeekStart, Grosses: make([]entity.Money, 0)}
+			weeks[gross.WeekStart.Unix()] = week
+			weekly = append(weekly, week)
+		}
+
+		week.Grosses = addMoney(week.Grosses, gross.Gross)
+	}
+
+	sort.Slice(weekly, func(i, j int) bool {
+		return weekly[i].WeekStart.Before(weekly[j].WeekStart)
+	})
+
+	cumulative := make([]entity.Money, 0)
+	for _, week := range weekly {
+		for _, gross := range week.Grosses {
+			cumulative = addMoney(cumulative, gross)
+		}
+
+		week.Cumulative = append(make([]entity.Money, 0, len(cumulative)), cumulative...)
+	}
+
+	return weekly
+}
+
+// totalGrosses returns the cumulative grosses of the last week, which are the grosses of every week added up
+func totalGrosses(weekly []*entity.WeeklyGross) []entity.Money {
+	if len(weekly) == 0 {
+		return make([]entity.Money, 0)
+	}
+
+	return weekly[len(weekly)-1].Cumulative
+}
+
+// addMoney adds money to the amount of the same currency in amounts, the amounts are kept ordered by currency and
+// a new amount is inserted for a currency which is not in them yet
+func addMoney(amounts []entity.Money, money entity.Money) []entity.Money {
+	i := sort.Search(len(amounts), func(i int) bool {
+		return amounts[i].Currency >= money.Currency
+	})
+	if i < len(amounts) && amounts[i].Currency == money.Currency {
+		amounts[i].Amount += money.Amount
+		return amounts
+	}
+
+	amounts = append(amounts, entity.Money{})
+	copy(amounts[i+1:], amounts[i:])
+	amounts[i] = money
+
+	return amounts
+}
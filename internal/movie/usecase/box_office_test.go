@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBoxOfficeUsecase struct {
+	suite.Suite
+}
+
+func TestBoxOfficeUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testBoxOfficeUsecase{})
+}
+
+func (s *testBoxOfficeUsecase) TestGetBoxOffice() {
+	type testInput struct {
+		movieID                 uint64
+		mockMovieRepository     func(*mock_repository.MockMovieRepository)
+		mockBoxOfficeRepository func(*mock_repository.MockBoxOfficeRepository)
+	}
+
+	type testOutput struct {
+		boxOffice *entity.BoxOffice
+		err       error
+	}
+
+	movie := dummyMovie(1)
+	movie.Budget = utils.Uint64Ptr(35600000000)
+	movie.Revenue = utils.Int64Ptr(279750132800)
+	movie.Currency = utils.StringPtr("USD")
+
+	firstWeek := utils.MustRFC3339Time("2022-01-07T00:00:00+00:00")
+	secondWeek := utils.MustRFC3339Time("2022-01-14T00:00:00+00:00")
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_totals_and_weekly_grosses_by_currency",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(movie, nil)
+				},
+				mockBoxOfficeRepository: func(r *mock_repository.MockBoxOfficeRepository) {
+					r.EXPECT().FindGrossesByMovieID(gomock.Any(), uint64(1)).Return([]*entity.BoxOfficeGross{
+						{ID: 3, MovieID: 1, Territory: "JP", WeekStart: secondWeek,
+							Gross: entity.Money{Amount: 120000000, Currency: "JPY"}},
+						{ID: 1, MovieID: 1, Territory: "US", WeekStart: firstWeek,
+							Gross: entity.Money{Amount: 9813250000, Currency: "USD"}},
+						{ID: 2, MovieID: 1, Territory: "US", WeekStart: secondWeek,
+							Gross: entity.Money{Amount: 5120070000, Currency: "USD"}},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				boxOffice: &entity.BoxOffice{
+					MovieID: 1,
+					Budget:  &entity.Money{Amount: 35600000000, Currency: "USD"},
+					Revenue: &entity.Money{Amount: 279750132800, Currency: "USD"},
+					Totals:  []entity.Money{{Amount: 120000000, Currency: "JPY"}, {Amount: 14933320000, Currency: "USD"}},
+					Weekly: []*entity.WeeklyGross{
+						{
+							WeekStart:  firstWeek,
+							Grosses:    []entity.Money{{Amount: 9813250000, Currency: "USD"}},
+							Cumulative: []entity.Money{{Amount: 9813250000, Currency: "USD"}},
+						},
+						{
+							WeekStart: secondWeek,
+							Grosses: []entity.Money{{Amount: 120000000, Currency: "JPY"},
+								{Amount: 5120070000, Currency: "USD"}},
+							Cumulative: []entity.Money{{Amount: 120000000, Currency: "JPY"},
+								{Amount: 14933320000, Currency: "USD"}},
+						},
+					},
+					Territories: []*entity.TerritoryGrosses{
+						{
+							Territory: "JP",
+							Totals:    []entity.Money{{Amount: 120000000, Currency: "JPY"}},
+							Weekly: []*entity.WeeklyGross{
+								{
+									WeekStart:  secondWeek,
+									Grosses:    []entity.Money{{Amount: 120000000, Currency: "JPY"}},
+									Cumulative: []entity.Money{{Amount: 120000000, Currency: "JPY"}},
+								},
+							},
+						},
+						{
+							Territory: "US",
+							Totals:    []entity.Money{{Amount: 14933320000, Currency: "USD"}},
+							Weekly: []*entity.WeeklyGross{
+								{
+									WeekStart:  firstWeek,
+									Grosses:    []entity.Money{{Amount: 9813250000, Currency: "USD"}},
+									Cumulative: []entity.Money{{Amount: 9813250000, Currency: "USD"}},
+								},
+								{
+									WeekStart:  secondWeek,
+									Grosses:    []entity.Money{{Amount: 5120070000, Currency: "USD"}},
+									Cumulative: []entity.Money{{Amount: 14933320000, Currency: "USD"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_empty_grosses_when_movie_has_no_gross",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockBoxOfficeRepository: func(r *mock_repository.MockBoxOfficeRepository) {
+					r.EXPECT().FindGrossesByMovieID(gomock.Any(), uint64(1)).Return([]*entity.BoxOfficeGross{}, nil)
+				},
+			},
+			expected: testOutput{
+				boxOffice: &entity.BoxOffice{
+					MovieID:     1,
+					Totals:      []entity.Money{},
+					Weekly:      []*entity.WeeklyGross{},
+					Territories: []*entity.TerritoryGrosses{},
+				},
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_does_not_exist",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockBoxOfficeRepository: func(r *mock_repository.MockBoxOfficeRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindGrossesByMovieID_when_it_happened",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockBoxOfficeRepository: func(r *mock_repository.MockBoxOfficeRepository) {
+					r.EXPECT().FindGrossesByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("boxOfficeRepository.FindGrossesByMovieID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockBoxOfficeRepository := mock_repository.NewMockBoxOfficeRepository(ctrl)
+			c.input.mockBoxOfficeRepository(mockBoxOfficeRepository)
+
+			u := usecase.NewBoxOfficeUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockBoxOfficeRepository)
+			res, err := u.GetBoxOffice(context.Background(), c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.boxOffice, res)
+		})
+	}
+}
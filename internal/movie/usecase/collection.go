@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type collectionUsecase struct {
+	cfg                        config.Config
+	collectionRepository       repository.CollectionRepository
+	favoriteRepository         repository.FavoriteRepository
+	movieTranslationRepository repository.MovieTranslationRepository
+	logger                     logger.Logger
+}
+
+func NewCollectionUsecase(cfg config.Config, log logger.Logger, collectionRepository repository.CollectionRepository,
+	favoriteRepository repository.FavoriteRepository,
+	movieTranslationRepository repository.MovieTranslationRepository) *collectionUsecase {
+	return &collectionUsecase{cfg: cfg, logger: log, collectionRepository: collectionRepository,
+		favoriteRepository: favoriteRepository, movieTranslationRepository: movieTranslationRepository}
+}
+
+// GetCollectionParams gets the collection with its movies ordered by position, the movies are translated into
+// the best of Languages and are paged with the page sizes of MovieList
+type GetCollectionParams struct {
+	CollectionID uint64     `json:"collection_id"`
+	Languages    []string   `json:"languages"`
+	Page         PageParams `json:"page"`
+}
+
+func (u *collectionUsecase) GetCollection(ctx context.Context, args GetCollectionParams) (*entity.Collection, error) {
+	params := repository.FindMoviesByCollectionIDParams{
+		CollectionID: args.CollectionID,
+		Limit:        pageSize(args.Page.Limit, u.cfg.MovieList.DefaultPageSize, u.cfg.MovieList.MaxPageSize),
+	}
+	if args.Page.Cursor != "" {
+		params.After = &repository.CollectionMovieCursor{}
+		if err := decodeCursor(args.Page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+	}
+
+	collection, err := u.findCollection(ctx, args.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionMovies, err := u.collectionRepository.FindMoviesByCollectionID(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindMoviesByCollectionID: %w", err))
+	}
+
+	movies := make([]*entity.Movie, len(collectionMovies.Movies))
+	for i, collectionMovie := range collectionMovies.Movies {
+		movies[i] = collectionMovie.Movie
+	}
+
+	if err := translateMovies(ctx, u.movieTranslationRepository, movies, args.Languages); err != nil {
+		return nil, err
+	}
+
+	collection.Movies = collectionMovies.Movies
+	if collectionMovies.Next != nil {
+		if collection.NextCursor, err = encodeCursor(collectionMovies.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return collection, nil
+}
+
+type AddFavoriteCollectionParams struct {
+	UserID       uint64 `json:"user_id"`
+	CollectionID uint64 `json:"collection_id"`
+}
+
+// AddFavoriteCollection adds every movie of the collection to the user's favorite list, the movies which are
+// already favorite are skipped. It returns the number of the movies which are added
+func (u *collectionUsecase) AddFavoriteCollection(ctx context.Context, args AddFavoriteCollectionParams) (int64, error) {
+	if _, err := u.findCollection(ctx, args.CollectionID); err != nil {
+		return 0, err
+	}
+
+	added, err := u.favoriteRepository.AddFavoriteCollection(ctx, repository.AddFavoriteCollectionParams{
+		UserID:       args.UserID,
+		CollectionID: args.CollectionID,
+	})
+	if err != nil {
+		return 0, httperrors.NewInternalServerError(fmt.Errorf("favoriteRepository.AddFavoriteCollection: %w", err))
+	}
+
+	return added, nil
+}
+
+func (u *collectionUsecase) findCollection(ctx context.Context, collectionID uint64) (*entity.Collection, error) {
+	collection, err := u.collectionRepository.FindByID(ctx, collectionID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindByID: %w", err))
+	}
+
+	if collection == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("collectionRepository.FindByID: not found"))
+	}
+
+	return collection, nil
+}
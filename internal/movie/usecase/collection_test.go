@@ -0,0 +1,307 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCollectionUsecase struct {
+	suite.Suite
+}
+
+func TestCollectionUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testCollectionUsecase{})
+}
+
+func dummyCollection(collectionID uint64) *entity.Collection {
+	return &entity.Collection{
+		ID:        collectionID,
+		Name:      "The Stranger Collection",
+		CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testCollectionUsecase) TestGetCollection() {
+	type testInput struct {
+		args                           usecase.GetCollectionParams
+		mockCollectionRepository       func(*mock_repository.MockCollectionRepository)
+		mockMovieTranslationRepository func(*mock_repository.MockMovieTranslationRepository)
+	}
+
+	type testOutput struct {
+		collection *entity.Collection
+		err        error
+	}
+
+	collectionCursor, err := cursor.Encode(repository.CollectionMovieCursor{Position: 1})
+	assert.NoError(s.T(), err)
+	nextCursor, err := cursor.Encode(repository.CollectionMovieCursor{Position: 2})
+	assert.NoError(s.T(), err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_collection_with_movies_ordered_by_position",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyCollection(1), nil)
+					r.EXPECT().FindMoviesByCollectionID(gomock.Any(), repository.FindMoviesByCollectionIDParams{
+						CollectionID: 1,
+						Limit:        20,
+					}).Return(&repository.CollectionMoviePage{Movies: []*entity.CollectionMovie{
+						{Position: 1, Movie: dummyMovie(1)},
+						{Position: 2, Movie: dummyMovie(3)},
+					}}, nil)
+				},
+			},
+			expected: testOutput{
+				collection: &entity.Collection{
+					ID:        1,
+					Name:      "The Stranger Collection",
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Movies: []*entity.CollectionMovie{
+						{Position: 1, Movie: dummyMovie(1)},
+						{Position: 2, Movie: dummyMovie(3)},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_movies_translated_into_best_language",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1, Languages: []string{"vi"}},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyCollection(1), nil)
+					r.EXPECT().FindMoviesByCollectionID(gomock.Any(), gomock.Any()).Return(&repository.CollectionMoviePage{
+						Movies: []*entity.CollectionMovie{
+							{Position: 1, Movie: &entity.Movie{ID: 1, OriginalTitle: "accumsan sed", Title: "accumsan sed"}},
+							{Position: 2, Movie: &entity.Movie{ID: 3, OriginalTitle: "vitae", Title: "vitae"}},
+						},
+					}, nil)
+				},
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1, 3}).Return([]*entity.MovieTranslation{
+						{MovieID: 3, Language: "vi", Title: "Mãi mãi"},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				collection: &entity.Collection{
+					ID:        1,
+					Name:      "The Stranger Collection",
+					CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Movies: []*entity.CollectionMovie{
+						{Position: 1, Movie: &entity.Movie{ID: 1, OriginalTitle: "accumsan sed", Title: "accumsan sed"}},
+						{Position: 2, Movie: &entity.Movie{ID: 3, OriginalTitle: "vitae", Title: "Mãi mãi",
+							TranslationLanguage: "vi"}},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_page_of_movies_with_next_cursor_after_cursor",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1,
+					Page: usecase.PageParams{Cursor: collectionCursor, Limit: 1}},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyCollection(1), nil)
+					r.EXPECT().FindMoviesByCollectionID(gomock.Any(), repository.FindMoviesByCollectionIDParams{
+						CollectionID: 1,
+						Limit:        1,
+						After:        &repository.CollectionMovieCursor{Position: 1},
+					}).Return(&repository.CollectionMoviePage{
+						Movies: []*entity.CollectionMovie{{Position: 2, Movie: dummyMovie(3)}},
+						Next:   &repository.CollectionMovieCursor{Position: 2},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				collection: &entity.Collection{
+					ID:         1,
+					Name:       "The Stranger Collection",
+					CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					Movies:     []*entity.CollectionMovie{{Position: 2, Movie: dummyMovie(3)}},
+					NextCursor: &nextCursor,
+				},
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_invalid",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1,
+					Page: usecase.PageParams{Cursor: "not a cursor"}},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_not_found_when_collection_does_not_exist",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("collectionRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindByID_when_it_happened",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindByID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_error_of_FindMoviesByCollectionID_when_it_happened",
+			input: testInput{
+				args: usecase.GetCollectionParams{CollectionID: 1},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyCollection(1), nil)
+					r.EXPECT().FindMoviesByCollectionID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindMoviesByCollectionID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCollectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			c.input.mockCollectionRepository(mockCollectionRepository)
+			mockMovieTranslationRepository := mock_repository.NewMockMovieTranslationRepository(ctrl)
+			if c.input.mockMovieTranslationRepository != nil {
+				c.input.mockMovieTranslationRepository(mockMovieTranslationRepository)
+			}
+
+			u := usecase.NewCollectionUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockCollectionRepository,
+				nil, mockMovieTranslationRepository)
+			res, err := u.GetCollection(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.collection, res)
+		})
+	}
+}
+
+func (s *testCollectionUsecase) TestAddFavoriteCollection() {
+	type testInput struct {
+		args                     usecase.AddFavoriteCollectionParams
+		mockCollectionRepository func(*mock_repository.MockCollectionRepository)
+		mockFavoriteRepository   func(*mock_repository.MockFavoriteRepository)
+	}
+
+	type testOutput struct {
+		added int64
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_number_of_added_movies",
+			input: testInput{
+				args: usecase.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(dummyCollection(2), nil)
+				},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().AddFavoriteCollection(gomock.Any(), repository.AddFavoriteCollectionParams{
+						UserID:       1,
+						CollectionID: 2,
+					}).Return(int64(2), nil)
+				},
+			},
+			expected: testOutput{
+				added: 2,
+			},
+		},
+		{
+			name: "returns_not_found_when_collection_does_not_exist",
+			input: testInput{
+				args: usecase.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("collectionRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_AddFavoriteCollection_when_it_happened",
+			input: testInput{
+				args: usecase.AddFavoriteCollectionParams{UserID: 1, CollectionID: 2},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(dummyCollection(2), nil)
+				},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().AddFavoriteCollection(gomock.Any(), gomock.Any()).Return(int64(0), fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("favoriteRepository.AddFavoriteCollection: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCollectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			c.input.mockCollectionRepository(mockCollectionRepository)
+			mockFavoriteRepository := mock_repository.NewMockFavoriteRepository(ctrl)
+			if c.input.mockFavoriteRepository != nil {
+				c.input.mockFavoriteRepository(mockFavoriteRepository)
+			}
+
+			u := usecase.NewCollectionUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockCollectionRepository,
+				mockFavoriteRepository, nil)
+			added, err := u.AddFavoriteCollection(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.added, added)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type commentUsecase struct {
+	cfg               config.Config
+	reviewRepository  repository.ReviewRepository
+	commentRepository repository.CommentRepository
+	reportRepository  repository.ReportRepository
+	contentFilter     contentfilter.ContentFilter
+	logger            logger.Logger
+}
+
+func NewCommentUsecase(cfg config.Config, log logger.Logger, reviewRepository repository.ReviewRepository,
+	commentRepository repository.CommentRepository, reportRepository repository.ReportRepository,
+	contentFilter contentfilter.ContentFilter) *commentUsecase {
+	return &commentUsecase{cfg: cfg, logger: log, reviewRepository: reviewRepository, commentRepository: commentRepository,
+		reportRepository: reportRepository, contentFilter: contentFilter}
+}
+
+// defaultMaxCommentDepth is used when the config of the comments has no max depth
+const defaultMaxCommentDepth = 3
+
+type CreateCommentParams struct {
+	ReviewID uint64 `json:"review_id"`
+	UserID   uint64 `json:"user_id"`
+	// ParentID is the comment which is replied, the comment is written on the review when it is nil
+	ParentID *uint64 `json:"parent_id"`
+	Content  string  `json:"content"`
+}
+
+func (u *commentUsecase) CreateComment(ctx context.Context, args CreateCommentParams) (*entity.Comment, error) {
+	review, err := u.reviewRepository.FindByID(ctx, args.ReviewID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByID: %w", err))
+	}
+
+	if review == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found"))
+	}
+
+	viewer := Viewer{UserID: args.UserID}
+	if !viewer.canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	var depth uint8 = 1
+	if args.ParentID != nil {
+		parent, err := u.findComment(ctx, *args.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !viewer.canSee(parent.ModerationStatus, parent.UserID) {
+			return nil, httperrors.NewNotFoundError(fmt.Errorf("comment %d is hidden", parent.ID))
+		}
+
+		if parent.ReviewID != args.ReviewID {
+			return nil, httperrors.NewBadRequestError(fmt.Errorf("comment %d is not a comment of review %d",
+				parent.ID, args.ReviewID))
+		}
+
+		if parent.Deleted {
+			return nil, httperrors.NewRestError(http.StatusBadRequest, "can not reply to deleted comment", nil)
+		}
+
+		depth = parent.Depth + 1
+	}
+
+	maxDepth := u.cfg.Comment.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = defaultMaxCommentDepth
+	}
+
+	if depth > maxDepth {
+		return nil, httperrors.NewRestError(http.StatusBadRequest,
+			fmt.Sprintf("comments can be nested at most %d levels", maxDepth), nil)
+	}
+
+	status, verdict, err := filterContent(ctx, u.contentFilter, contentfilter.Content{
+		TargetType: entity.ReportTargetComment,
+		UserID:     args.UserID,
+		Text:       args.Content,
+	}, entity.ModerationStatusVisible)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := u.commentRepository.CreateComment(ctx, repository.CreateCommentParams{
+		ReviewID:         args.ReviewID,
+		UserID:           args.UserID,
+		ParentID:         args.ParentID,
+		Depth:            depth,
+		Content:          args.Content,
+		ModerationStatus: status,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("commentRepository.CreateComment: %w", err))
+	}
+
+	if err := reportFilteredContent(ctx, u.reportRepository, entity.ReportTargetComment, comment.ID, verdict); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+type GetCommentByIDParams struct {
+	CommentID uint64 `json:"comment_id"`
+	Viewer    Viewer `json:"viewer"`
+}
+
+func (u *commentUsecase) GetCommentByID(ctx context.Context, args GetCommentByIDParams) (*entity.Comment, error) {
+	comment, err := u.findComment(ctx, args.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.Viewer.canSee(comment.ModerationStatus, comment.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("comment %d is hidden", comment.ID))
+	}
+
+	return comment, nil
+}
+
+func (u *commentUsecase) findComment(ctx context.Context, commentID uint64) (*entity.Comment, error) {
+	comment, err := u.commentRepository.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("commentRepository.FindByID: %w", err))
+	}
+
+	if comment == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("commentRepository.FindByID: not found"))
+	}
+
+	return comment, nil
+}
+
+type ListCommentsParams struct {
+	ReviewID uint64     `json:"review_id"`
+	Page     PageParams `json:"page"`
+	Viewer   Viewer     `json:"viewer"`
+}
+
+// ListComments lists the comments written on the review, the replies are listed by ListReplies
+func (u *commentUsecase) ListComments(ctx context.Context, args ListCommentsParams) (*entity.CommentPage, error) {
+	review, err := u.reviewRepository.FindByID(ctx, args.ReviewID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByID: %w", err))
+	}
+
+	if review == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found"))
+	}
+
+	if !args.Viewer.canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	return u.listComments(ctx, args.ReviewID, nil, args.Page)
+}
+
+type ListRepliesParams struct {
+	CommentID uint64     `json:"comment_id"`
+	Page      PageParams `json:"page"`
+	Viewer    Viewer     `json:"viewer"`
+}
+
+func (u *commentUsecase) ListReplies(ctx context.Context, args ListRepliesParams) (*entity.CommentPage, error) {
+	comment, err := u.GetCommentByID(ctx, GetCommentByIDParams{CommentID: args.CommentID, Viewer: args.Viewer})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.listComments(ctx, comment.ReviewID, &comment.ID, args.Page)
+}
+
+func (u *commentUsecase) listComments(ctx context.Context, reviewID uint64, parentID *uint64,
+	page PageParams) (*entity.CommentPage, error) {
+	params := repository.FindCommentsParams{
+		ReviewID: reviewID,
+		ParentID: parentID,
+		Limit:    pageSize(page.Limit, u.cfg.Comment.DefaultPageSize, u.cfg.Comment.MaxPageSize),
+	}
+	if page.Cursor != "" {
+		params.After = &repository.CommentCursor{}
+		if err := decodeCursor(page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+	}
+
+	comments, err := u.commentRepository.FindComments(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("commentRepository.FindComments: %w", err))
+	}
+
+	commentPage := &entity.CommentPage{Comments: comments.Comments}
+	if comments.Next != nil {
+		if commentPage.NextCursor, err = encodeCursor(comments.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return commentPage, nil
+}
+
+type UpdateCommentParams struct {
+	CommentID uint64 `json:"comment_id"`
+	UserID    uint64 `json:"user_id"`
+	Content   string `json:"content"`
+}
+
+func (u *commentUsecase) UpdateComment(ctx context.Context, args UpdateCommentParams) (*entity.Comment, error) {
+	comment, err := u.findOwnComment(ctx, args.CommentID, args.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, verdict, err := filterContent(ctx, u.contentFilter, contentfilter.Content{
+		TargetType: entity.ReportTargetComment,
+		ID:         comment.ID,
+		UserID:     args.UserID,
+		Text:       args.Content,
+	}, comment.ModerationStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.commentRepository.UpdateComment(ctx, repository.UpdateCommentParams{
+		ID:               comment.ID,
+		Content:          args.Content,
+		ModerationStatus: status,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("commentRepository.UpdateComment: %w", err))
+	}
+
+	if err := reportFilteredContent(ctx, u.reportRepository, entity.ReportTargetComment, comment.ID, verdict); err != nil {
+		return nil, err
+	}
+
+	return u.findComment(ctx, comment.ID)
+}
+
+type DeleteCommentParams struct {
+	CommentID uint64 `json:"comment_id"`
+	UserID    uint64 `json:"user_id"`
+}
+
+func (u *commentUsecase) DeleteComment(ctx context.Context, args DeleteCommentParams) error {
+	comment, err := u.findOwnComment(ctx, args.CommentID, args.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.commentRepository.DeleteComment(ctx, comment.ID); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("commentRepository.DeleteComment: %w", err))
+	}
+
+	return nil
+}
+
+// findOwnComment returns the comment only when it was written by the given user and is not deleted
+func (u *commentUsecase) findOwnComment(ctx context.Context, commentID uint64, userID uint64) (*entity.Comment, error) {
+	comment, err := u.findComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Deleted {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("comment %d is deleted", commentID))
+	}
+
+	if comment.UserID != userID {
+		return nil, httperrors.NewForbiddenError(fmt.Errorf("comment %d is not written by user %d", commentID, userID))
+	}
+
+	return comment, nil
+}
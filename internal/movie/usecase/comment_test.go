@@ -0,0 +1,512 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCommentUsecase struct {
+	suite.Suite
+}
+
+func TestCommentUsecasesuite(t *testing.T) {
+	suite.Run(t, &testCommentUsecase{})
+}
+
+var commentConfig = config.Config{
+	Comment: config.CommentConfig{MaxDepth: 3, DefaultPageSize: 20, MaxPageSize: 100},
+}
+
+func dummyComment(commentID uint64, userID uint64, parentID *uint64, depth uint8) *entity.Comment {
+	return &entity.Comment{
+		ID:        commentID,
+		ReviewID:  5,
+		UserID:    userID,
+		Username:  "testuser",
+		ParentID:  parentID,
+		Depth:     depth,
+		Content:   "nice review",
+		CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testCommentUsecase) TestCreateComment() {
+	type testInput struct {
+		args                  usecase.CreateCommentParams
+		contentFilter         contentfilter.ContentFilter
+		mockReviewRepository  func(*mock_repository.MockReviewRepository)
+		mockCommentRepository func(*mock_repository.MockCommentRepository)
+		mockReportRepository  func(*mock_repository.MockReportRepository)
+	}
+
+	type testOutput struct {
+		comment *entity.Comment
+		err     error
+	}
+
+	deletedComment := dummyComment(7, 1, nil, 1)
+	deletedComment.Deleted = true
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_comment_on_review",
+			input: testInput{
+				args: usecase.CreateCommentParams{ReviewID: 5, UserID: 1, Content: "nice review"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().CreateComment(gomock.Any(), repository.CreateCommentParams{
+						ReviewID:         5,
+						UserID:           1,
+						Depth:            1,
+						Content:          "nice review",
+						ModerationStatus: entity.ModerationStatusVisible,
+					}).Return(dummyComment(7, 1, nil, 1), nil)
+				},
+			},
+			expected: testOutput{
+				comment: dummyComment(7, 1, nil, 1),
+			},
+		},
+		{
+			name: "creates_pending_comment_and_reports_it_when_content_filter_sends_it_to_moderation",
+			input: testInput{
+				args:          usecase.CreateCommentParams{ReviewID: 5, UserID: 1, Content: "see www.example.com www.example.org"},
+				contentFilter: contentfilter.NewLinkFilter(1, nil),
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().CreateComment(gomock.Any(), repository.CreateCommentParams{
+						ReviewID:         5,
+						UserID:           1,
+						Depth:            1,
+						Content:          "see www.example.com www.example.org",
+						ModerationStatus: entity.ModerationStatusPending,
+					}).Return(dummyComment(7, 1, nil, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().CreateFilterReport(gomock.Any(), repository.CreateFilterReportParams{
+						TargetType: entity.ReportTargetComment,
+						TargetID:   7,
+						Reason:     "content has 2 links",
+					}).Return(&entity.Report{ID: 3}, nil)
+				},
+			},
+			expected: testOutput{
+				comment: dummyComment(7, 1, nil, 1),
+			},
+		},
+		{
+			name: "returns_error_when_content_filter_rejects_comment",
+			input: testInput{
+				args:          usecase.CreateCommentParams{ReviewID: 5, UserID: 1, Content: "see https://bit.ly/abc"},
+				contentFilter: contentfilter.NewLinkFilter(2, []string{"bit.ly"}),
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "content contains blocked link", nil),
+			},
+		},
+		{
+			name: "returns_created_reply_one_level_deeper_than_parent",
+			input: testInput{
+				args: usecase.CreateCommentParams{ReviewID: 5, UserID: 2, ParentID: utils.Uint64Ptr(7), Content: "nice review"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, utils.Uint64Ptr(6), 2), nil)
+					r.EXPECT().CreateComment(gomock.Any(), repository.CreateCommentParams{
+						ReviewID:         5,
+						UserID:           2,
+						ParentID:         utils.Uint64Ptr(7),
+						Depth:            3,
+						Content:          "nice review",
+						ModerationStatus: entity.ModerationStatusVisible,
+					}).Return(dummyComment(8, 2, utils.Uint64Ptr(7), 3), nil)
+				},
+			},
+			expected: testOutput{
+				comment: dummyComment(8, 2, utils.Uint64Ptr(7), 3),
+			},
+		},
+		{
+			name: "returns_error_when_reply_is_deeper_than_max_depth",
+			input: testInput{
+				args: usecase.CreateCommentParams{ReviewID: 5, UserID: 2, ParentID: utils.Uint64Ptr(8), Content: "nice review"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(8)).Return(dummyComment(8, 1, utils.Uint64Ptr(7), 3), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "comments can be nested at most 3 levels", nil),
+			},
+		},
+		{
+			name: "returns_error_when_reply_to_deleted_comment",
+			input: testInput{
+				args: usecase.CreateCommentParams{ReviewID: 5, UserID: 2, ParentID: utils.Uint64Ptr(7), Content: "nice review"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(deletedComment, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "can not reply to deleted comment", nil),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.CreateCommentParams{ReviewID: 5, UserID: 1, Content: "nice review"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+			c.input.mockCommentRepository(mockCommentRepository)
+			if c.input.mockReportRepository != nil {
+				c.input.mockReportRepository(mockReportRepository)
+			}
+
+			contentFilter := c.input.contentFilter
+			if contentFilter == nil {
+				contentFilter = contentfilter.NewPipeline()
+			}
+
+			u := usecase.NewCommentUsecase(commentConfig, logger.NewApiLogger(&config.Config{}), mockReviewRepository,
+				mockCommentRepository, mockReportRepository, contentFilter)
+			res, err := u.CreateComment(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.comment, res)
+		})
+	}
+}
+
+func (s *testCommentUsecase) TestListComments() {
+	type testInput struct {
+		args                  usecase.ListCommentsParams
+		mockReviewRepository  func(*mock_repository.MockReviewRepository)
+		mockCommentRepository func(*mock_repository.MockCommentRepository)
+	}
+
+	type testOutput struct {
+		page *entity.CommentPage
+		err  error
+	}
+
+	hiddenReview := dummyReview(5, 1)
+	hiddenReview.ModerationStatus = entity.ModerationStatusHidden
+
+	commentCursor, err := cursor.Encode(repository.CommentCursor{ID: 7})
+	assert.NoError(s.T(), err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_first_page_with_default_size_when_page_is_not_given",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindComments(gomock.Any(), repository.FindCommentsParams{
+						ReviewID: 5,
+						Limit:    20,
+					}).Return(&repository.CommentPage{Comments: []*entity.Comment{dummyComment(7, 1, nil, 1)}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.CommentPage{
+					Comments: []*entity.Comment{dummyComment(7, 1, nil, 1)},
+				},
+			},
+		},
+		{
+			name: "returns_page_with_next_cursor_after_cursor",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5, Page: usecase.PageParams{Cursor: commentCursor, Limit: 1}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindComments(gomock.Any(), repository.FindCommentsParams{
+						ReviewID: 5,
+						Limit:    1,
+						After:    &repository.CommentCursor{ID: 7},
+					}).Return(&repository.CommentPage{
+						Comments: []*entity.Comment{dummyComment(7, 1, nil, 1)},
+						Next:     &repository.CommentCursor{ID: 7},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.CommentPage{
+					Comments:   []*entity.Comment{dummyComment(7, 1, nil, 1)},
+					NextCursor: &commentCursor,
+				},
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_invalid",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5, Page: usecase.PageParams{Cursor: "not a cursor"}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_error_when_review_is_hidden_from_user",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5, Viewer: usecase.Viewer{UserID: 2}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", 5)),
+			},
+		},
+		{
+			name: "limits_size_to_max_page_size",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5, Page: usecase.PageParams{Limit: 1000}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindComments(gomock.Any(), repository.FindCommentsParams{
+						ReviewID: 5,
+						Limit:    100,
+					}).Return(&repository.CommentPage{Comments: []*entity.Comment{}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.CommentPage{
+					Comments: []*entity.Comment{},
+				},
+			},
+		},
+		{
+			name: "returns_error_of_FindComments",
+			input: testInput{
+				args: usecase.ListCommentsParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindComments(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("commentRepository.FindComments: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+			c.input.mockCommentRepository(mockCommentRepository)
+
+			u := usecase.NewCommentUsecase(commentConfig, logger.NewApiLogger(&config.Config{}), mockReviewRepository,
+				mockCommentRepository, nil, nil)
+			res, err := u.ListComments(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.page, res)
+		})
+	}
+}
+
+func (s *testCommentUsecase) TestListReplies() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+	mockCommentRepository.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, nil, 1), nil)
+	mockCommentRepository.EXPECT().FindComments(gomock.Any(), repository.FindCommentsParams{
+		ReviewID: 5,
+		ParentID: utils.Uint64Ptr(7),
+		Limit:    10,
+	}).Return(&repository.CommentPage{
+		Comments: []*entity.Comment{dummyComment(8, 2, utils.Uint64Ptr(7), 2)},
+		Next:     &repository.CommentCursor{ID: 8},
+	}, nil)
+	nextCursor, err := cursor.Encode(repository.CommentCursor{ID: 8})
+	assert.NoError(s.T(), err)
+
+	u := usecase.NewCommentUsecase(commentConfig, logger.NewApiLogger(&config.Config{}), nil, mockCommentRepository,
+		nil, nil)
+	res, err := u.ListReplies(context.Background(), usecase.ListRepliesParams{CommentID: 7,
+		Page: usecase.PageParams{Limit: 10}})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &entity.CommentPage{
+		Comments:   []*entity.Comment{dummyComment(8, 2, utils.Uint64Ptr(7), 2)},
+		NextCursor: &nextCursor,
+	}, res)
+}
+
+func (s *testCommentUsecase) TestDefaultsConfigWhichIsNotGiven() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+	mockReviewRepository.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil).Times(2)
+	mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+	mockCommentRepository.EXPECT().CreateComment(gomock.Any(), repository.CreateCommentParams{
+		ReviewID:         5,
+		UserID:           1,
+		Depth:            1,
+		Content:          "nice review",
+		ModerationStatus: entity.ModerationStatusVisible,
+	}).Return(dummyComment(7, 1, nil, 1), nil)
+	mockCommentRepository.EXPECT().FindComments(gomock.Any(), repository.FindCommentsParams{
+		ReviewID: 5,
+		Limit:    20,
+	}).Return(&repository.CommentPage{Comments: []*entity.Comment{dummyComment(7, 1, nil, 1)}}, nil)
+
+	u := usecase.NewCommentUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockReviewRepository,
+		mockCommentRepository, nil, contentfilter.NewPipeline())
+	comment, err := u.CreateComment(context.Background(), usecase.CreateCommentParams{ReviewID: 5, UserID: 1,
+		Content: "nice review"})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), dummyComment(7, 1, nil, 1), comment)
+
+	page, err := u.ListComments(context.Background(), usecase.ListCommentsParams{ReviewID: 5})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &entity.CommentPage{
+		Comments: []*entity.Comment{dummyComment(7, 1, nil, 1)},
+	}, page)
+}
+
+func (s *testCommentUsecase) TestDeleteComment() {
+	type testInput struct {
+		args                  usecase.DeleteCommentParams
+		mockCommentRepository func(*mock_repository.MockCommentRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	deletedComment := dummyComment(7, 1, nil, 1)
+	deletedComment.Deleted = true
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_delete_own_comment",
+			input: testInput{
+				args: usecase.DeleteCommentParams{CommentID: 7, UserID: 1},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, nil, 1), nil)
+					r.EXPECT().DeleteComment(gomock.Any(), uint64(7)).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_comment_is_written_by_other_user",
+			input: testInput{
+				args: usecase.DeleteCommentParams{CommentID: 7, UserID: 2},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, nil, 1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewForbiddenError(fmt.Errorf("comment %d is not written by user %d", 7, 2)),
+			},
+		},
+		{
+			name: "returns_error_when_comment_is_already_deleted",
+			input: testInput{
+				args: usecase.DeleteCommentParams{CommentID: 7, UserID: 1},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(deletedComment, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("comment %d is deleted", 7)),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			c.input.mockCommentRepository(mockCommentRepository)
+
+			u := usecase.NewCommentUsecase(commentConfig, logger.NewApiLogger(&config.Config{}), nil, mockCommentRepository,
+				nil, nil)
+			err := u.DeleteComment(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
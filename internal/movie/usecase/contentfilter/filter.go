@@ -0,0 +1,97 @@
+// Package contentfilter checks the reviews and comments written by users before they are saved.
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+)
+
+type Decision int
+
+const (
+	DecisionAccept Decision = iota
+	DecisionReject
+	DecisionModerate
+)
+
+// Verdict is the decision of a filter, Err is returned to the user when the content is rejected and
+// Reason tells the moderators why the content is sent to moderation
+type Verdict struct {
+	Decision Decision
+	Err      httperrors.RestErr
+	Reason   string
+}
+
+func Accept() Verdict {
+	return Verdict{Decision: DecisionAccept}
+}
+
+func Reject(err httperrors.RestErr) Verdict {
+	return Verdict{Decision: DecisionReject, Err: err}
+}
+
+func Moderate(reason string) Verdict {
+	return Verdict{Decision: DecisionModerate, Reason: reason}
+}
+
+// Content is the text of a review or a comment, ID is 0 until the content is saved
+type Content struct {
+	TargetType entity.ReportTargetType
+	ID         uint64
+	UserID     uint64
+	Title      string
+	Text       string
+}
+
+// text returns the whole text of the content which is checked by the filters
+func (c Content) text() string {
+	if c.Title == "" {
+		return c.Text
+	}
+
+	return c.Title + "\n" + c.Text
+}
+
+// ContentFilter decides whether the content can be saved, the error is returned only when the filter
+// can not decide
+type ContentFilter interface {
+	Filter(ctx context.Context, content Content) (Verdict, error)
+}
+
+type pipeline struct {
+	filters []ContentFilter
+}
+
+// NewPipeline chains the filters, the content passes through them in the given order
+func NewPipeline(filters ...ContentFilter) *pipeline {
+	return &pipeline{filters: filters}
+}
+
+// Filter stops at the first filter which rejects the content, the content is sent to moderation when any
+// filter asks for it and the reasons of all of them are joined
+func (p *pipeline) Filter(ctx context.Context, content Content) (Verdict, error) {
+	reasons := make([]string, 0)
+	for _, filter := range p.filters {
+		verdict, err := filter.Filter(ctx, content)
+		if err != nil {
+			return Verdict{}, fmt.Errorf("%T.Filter: %w", filter, err)
+		}
+
+		switch verdict.Decision {
+		case DecisionReject:
+			return verdict, nil
+		case DecisionModerate:
+			reasons = append(reasons, verdict.Reason)
+		}
+	}
+
+	if len(reasons) > 0 {
+		return Moderate(strings.Join(reasons, "; ")), nil
+	}
+
+	return Accept(), nil
+}
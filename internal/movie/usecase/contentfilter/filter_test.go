@@ -0,0 +1,382 @@
+package contentfilter_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testContentFilter struct {
+	suite.Suite
+}
+
+func TestContentFilterSuite(t *testing.T) {
+	suite.Run(t, &testContentFilter{})
+}
+
+func dummyPost(targetType entity.ReportTargetType, postID uint64, content string) *entity.Post {
+	return &entity.Post{
+		TargetType: targetType,
+		ID:         postID,
+		UserID:     1,
+		Content:    content,
+		CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testContentFilter) TestPipeline() {
+	type testInput struct {
+		filters []contentfilter.ContentFilter
+		content contentfilter.Content
+	}
+
+	type testOutput struct {
+		verdict contentfilter.Verdict
+		err     error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "accepts_content_when_no_filter_complains",
+			input: testInput{
+				filters: []contentfilter.ContentFilter{
+					contentfilter.NewProfanityFilter([]string{"shit"}),
+					contentfilter.NewLinkFilter(2, []string{"bit.ly"}),
+				},
+				content: contentfilter.Content{UserID: 1, Title: "great movie", Text: "really enjoyed it"},
+			},
+			expected: testOutput{
+				verdict: contentfilter.Accept(),
+			},
+		},
+		{
+			name: "stops_at_first_filter_which_rejects_content",
+			input: testInput{
+				filters: []contentfilter.ContentFilter{
+					contentfilter.NewLinkFilter(0, nil),
+					contentfilter.NewProfanityFilter([]string{"shit"}),
+					contentfilter.NewLinkFilter(2, []string{"bit.ly"}),
+				},
+				content: contentfilter.Content{UserID: 1, Text: "Shit, see https://bit.ly/abc"},
+			},
+			expected: testOutput{
+				verdict: contentfilter.Reject(httperrors.NewRestError(http.StatusBadRequest,
+					"content contains prohibited words", nil)),
+			},
+		},
+		{
+			name: "joins_reasons_of_filters_which_send_content_to_moderation",
+			input: testInput{
+				filters: []contentfilter.ContentFilter{
+					contentfilter.NewLinkFilter(1, nil),
+					contentfilter.NewLinkFilter(1, nil),
+				},
+				content: contentfilter.Content{UserID: 1, Title: "www.example.com", Text: "https://example.org"},
+			},
+			expected: testOutput{
+				verdict: contentfilter.Moderate("content has 2 links; content has 2 links"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			res, err := contentfilter.NewPipeline(c.input.filters...).Filter(context.Background(), c.input.content)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.verdict, res)
+		})
+	}
+}
+
+func (s *testContentFilter) TestProfanityFilter() {
+	filter := contentfilter.NewProfanityFilter([]string{"Shit", "bitch"})
+	rejected := contentfilter.Reject(httperrors.NewRestError(http.StatusBadRequest, "content contains prohibited words", nil))
+
+	cases := []struct {
+		name     string
+		input    contentfilter.Content
+		expected contentfilter.Verdict
+	}{
+		{
+			name:     "rejects_prohibited_word_ignoring_case",
+			input:    contentfilter.Content{Text: "what a SHIT movie"},
+			expected: rejected,
+		},
+		{
+			name:     "rejects_prohibited_word_in_title",
+			input:    contentfilter.Content{Title: "bitch, please", Text: "fine movie"},
+			expected: rejected,
+		},
+		{
+			name:     "accepts_word_which_only_contains_prohibited_word",
+			input:    contentfilter.Content{Text: "shitake mushrooms in the opening scene"},
+			expected: contentfilter.Accept(),
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			res, err := filter.Filter(context.Background(), c.input)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
+func (s *testContentFilter) TestLinkFilter() {
+	filter := contentfilter.NewLinkFilter(2, []string{"Bit.ly"})
+	blocked := contentfilter.Reject(httperrors.NewRestError(http.StatusBadRequest, "content contains blocked link", nil))
+
+	cases := []struct {
+		name     string
+		input    contentfilter.Content
+		expected contentfilter.Verdict
+	}{
+		{
+			name:     "accepts_content_with_allowed_number_of_links",
+			input:    contentfilter.Content{Text: "trailer https://youtube.com/watch?v=1 and www.imdb.com/title/1"},
+			expected: contentfilter.Accept(),
+		},
+		{
+			name:     "sends_content_with_too_many_links_to_moderation",
+			input:    contentfilter.Content{Text: "http://a.com http://b.com http://c.com"},
+			expected: contentfilter.Moderate("content has 3 links"),
+		},
+		{
+			name:     "rejects_link_to_blocked_domain",
+			input:    contentfilter.Content{Text: "free tickets at HTTPS://BIT.LY/abc"},
+			expected: blocked,
+		},
+		{
+			name:     "rejects_link_to_subdomain_of_blocked_domain",
+			input:    contentfilter.Content{Text: "free tickets at www.bit.ly/abc"},
+			expected: blocked,
+		},
+		{
+			name:     "accepts_domain_which_only_ends_with_blocked_domain",
+			input:    contentfilter.Content{Text: "see https://orbit.ly/abc"},
+			expected: contentfilter.Accept(),
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			res, err := filter.Filter(context.Background(), c.input)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
+func (s *testContentFilter) TestDuplicateFilter() {
+	type testInput struct {
+		content            contentfilter.Content
+		mockPostRepository func(*mock_repository.MockPostRepository)
+	}
+
+	type testOutput struct {
+		verdict contentfilter.Verdict
+		err     error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "rejects_text_which_is_same_as_recent_post_ignoring_case_and_spaces",
+			input: testInput{
+				content: contentfilter.Content{TargetType: entity.ReportTargetComment, UserID: 1, Text: "Nice  review\n"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {
+					r.EXPECT().FindRecentPosts(gomock.Any(), gomock.Any()).Return([]*entity.Post{
+						dummyPost(entity.ReportTargetReview, 5, "really enjoyed it"),
+						dummyPost(entity.ReportTargetComment, 7, "nice review"),
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				verdict: contentfilter.Reject(httperrors.NewRestError(http.StatusBadRequest,
+					"content is the same as your comment 7", nil)),
+			},
+		},
+		{
+			name: "accepts_edited_post_which_is_same_as_before",
+			input: testInput{
+				content: contentfilter.Content{TargetType: entity.ReportTargetComment, ID: 7, UserID: 1, Text: "nice review"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {
+					r.EXPECT().FindRecentPosts(gomock.Any(), gomock.Any()).Return([]*entity.Post{
+						dummyPost(entity.ReportTargetComment, 7, "nice review"),
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				verdict: contentfilter.Accept(),
+			},
+		},
+		{
+			name: "returns_error_of_FindRecentPosts",
+			input: testInput{
+				content: contentfilter.Content{TargetType: entity.ReportTargetComment, UserID: 1, Text: "nice review"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {
+					r.EXPECT().FindRecentPosts(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: fmt.Errorf("postRepository.FindRecentPosts: %w", fmt.Errorf("dummy error")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPostRepository := mock_repository.NewMockPostRepository(ctrl)
+			c.input.mockPostRepository(mockPostRepository)
+
+			filter := contentfilter.NewDuplicateFilter(mockPostRepository, 24*time.Hour, 50)
+			res, err := filter.Filter(context.Background(), c.input.content)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.verdict, res)
+		})
+	}
+}
+
+func (s *testContentFilter) TestRateFilter() {
+	type testInput struct {
+		content            contentfilter.Content
+		mockPostRepository func(*mock_repository.MockPostRepository)
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected contentfilter.Verdict
+	}{
+		{
+			name: "rejects_new_post_when_user_reached_limit",
+			input: testInput{
+				content: contentfilter.Content{TargetType: entity.ReportTargetComment, UserID: 1, Text: "nice review"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {
+					r.EXPECT().FindRecentPosts(gomock.Any(), gomock.Any()).Return([]*entity.Post{
+						dummyPost(entity.ReportTargetComment, 8, "first"),
+						dummyPost(entity.ReportTargetComment, 7, "second"),
+					}, nil)
+				},
+			},
+			expected: contentfilter.Reject(httperrors.NewRestError(http.StatusTooManyRequests,
+				"can not post more than 2 times in 1m0s", nil)),
+		},
+		{
+			name: "accepts_new_post_when_user_is_under_limit",
+			input: testInput{
+				content: contentfilter.Content{TargetType: entity.ReportTargetComment, UserID: 1, Text: "nice review"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {
+					r.EXPECT().FindRecentPosts(gomock.Any(), gomock.Any()).Return([]*entity.Post{
+						dummyPost(entity.ReportTargetComment, 7, "first"),
+					}, nil)
+				},
+			},
+			expected: contentfilter.Accept(),
+		},
+		{
+			name: "accepts_edited_post_without_counting_posts",
+			input: testInput{
+				content:            contentfilter.Content{TargetType: entity.ReportTargetComment, ID: 7, UserID: 1, Text: "nice review"},
+				mockPostRepository: func(r *mock_repository.MockPostRepository) {},
+			},
+			expected: contentfilter.Accept(),
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPostRepository := mock_repository.NewMockPostRepository(ctrl)
+			c.input.mockPostRepository(mockPostRepository)
+
+			filter := contentfilter.NewRateFilter(mockPostRepository, time.Minute, 2)
+			res, err := filter.Filter(context.Background(), c.input.content)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
+func (s *testContentFilter) TestFiltersDisabledByZeroConfig() {
+	content := contentfilter.Content{
+		TargetType: entity.ReportTargetComment,
+		UserID:     1,
+		Text:       "nice review, see http://a.com http://b.com http://c.com",
+	}
+
+	cases := []struct {
+		name   string
+		filter func(repository.PostRepository) contentfilter.ContentFilter
+	}{
+		{
+			name: "link_filter_accepts_any_number_of_links_when_max_links_is_0",
+			filter: func(r repository.PostRepository) contentfilter.ContentFilter {
+				return contentfilter.NewLinkFilter(0, nil)
+			},
+		},
+		{
+			name: "rate_filter_accepts_new_post_when_limit_is_0",
+			filter: func(r repository.PostRepository) contentfilter.ContentFilter {
+				return contentfilter.NewRateFilter(r, time.Minute, 0)
+			},
+		},
+		{
+			name: "rate_filter_accepts_new_post_when_window_is_0",
+			filter: func(r repository.PostRepository) contentfilter.ContentFilter {
+				return contentfilter.NewRateFilter(r, 0, 5)
+			},
+		},
+		{
+			name: "duplicate_filter_accepts_post_when_posts_is_0",
+			filter: func(r repository.PostRepository) contentfilter.ContentFilter {
+				return contentfilter.NewDuplicateFilter(r, 24*time.Hour, 0)
+			},
+		},
+		{
+			name: "duplicate_filter_accepts_post_when_window_is_0",
+			filter: func(r repository.PostRepository) contentfilter.ContentFilter {
+				return contentfilter.NewDuplicateFilter(r, 0, 50)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// the posts of the user are never read
+			mockPostRepository := mock_repository.NewMockPostRepository(ctrl)
+
+			res, err := c.filter(mockPostRepository).Filter(context.Background(), content)
+			assert.NoError(t, err)
+			assert.Equal(t, contentfilter.Accept(), res)
+		})
+	}
+}
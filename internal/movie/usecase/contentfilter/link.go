@@ -0,0 +1,66 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+`)
+
+type linkFilter struct {
+	maxLinks       int
+	blockedDomains []string
+}
+
+// NewLinkFilter rejects the content which links to one of the blocked domains or their subdomains and
+// sends the content which has more than maxLinks links to moderation, the number of links is not limited when
+// maxLinks is 0
+func NewLinkFilter(maxLinks int, blockedDomains []string) *linkFilter {
+	f := &linkFilter{maxLinks: maxLinks, blockedDomains: make([]string, 0, len(blockedDomains))}
+	for _, domain := range blockedDomains {
+		f.blockedDomains = append(f.blockedDomains, strings.ToLower(domain))
+	}
+
+	return f
+}
+
+func (f *linkFilter) Filter(ctx context.Context, content Content) (Verdict, error) {
+	links := linkPattern.FindAllString(content.text(), -1)
+	for _, link := range links {
+		if f.isBlocked(link) {
+			return Reject(httperrors.NewRestError(http.StatusBadRequest, "content contains blocked link", nil)), nil
+		}
+	}
+
+	if f.maxLinks > 0 && len(links) > f.maxLinks {
+		return Moderate(fmt.Sprintf("content has %d links", len(links))), nil
+	}
+
+	return Accept(), nil
+}
+
+func (f *linkFilter) isBlocked(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range f.blockedDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
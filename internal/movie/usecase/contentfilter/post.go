@@ -0,0 +1,92 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+)
+
+type duplicateFilter struct {
+	postRepository repository.PostRepository
+	window         time.Duration
+	posts          uint
+}
+
+// NewDuplicateFilter rejects the content which is the same as one of the last posts of the user within
+// the window, the texts are compared ignoring case and spaces. The filter is disabled when window or posts is 0
+func NewDuplicateFilter(postRepository repository.PostRepository, window time.Duration, posts uint) *duplicateFilter {
+	return &duplicateFilter{postRepository: postRepository, window: window, posts: posts}
+}
+
+func (f *duplicateFilter) Filter(ctx context.Context, content Content) (Verdict, error) {
+	if f.window == 0 || f.posts == 0 {
+		return Accept(), nil
+	}
+
+	posts, err := f.postRepository.FindRecentPosts(ctx, repository.FindRecentPostsParams{
+		UserID: content.UserID,
+		Since:  time.Now().Add(-f.window),
+		Limit:  f.posts,
+	})
+	if err != nil {
+		return Verdict{}, fmt.Errorf("postRepository.FindRecentPosts: %w", err)
+	}
+
+	text := normalize(content.Text)
+	for _, post := range posts {
+		// an edited post is compared only with the other posts
+		if post.TargetType == content.TargetType && post.ID == content.ID {
+			continue
+		}
+
+		if normalize(post.Content) == text {
+			return Reject(httperrors.NewRestError(http.StatusBadRequest,
+				fmt.Sprintf("content is the same as your %s %d", post.TargetType, post.ID), nil)), nil
+		}
+	}
+
+	return Accept(), nil
+}
+
+func normalize(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+type rateFilter struct {
+	postRepository repository.PostRepository
+	window         time.Duration
+	limit          uint
+}
+
+// NewRateFilter rejects the new content of the user who already wrote limit posts within the window,
+// editing a post is not limited. The filter is disabled when window or limit is 0
+func NewRateFilter(postRepository repository.PostRepository, window time.Duration, limit uint) *rateFilter {
+	return &rateFilter{postRepository: postRepository, window: window, limit: limit}
+}
+
+func (f *rateFilter) Filter(ctx context.Context, content Content) (Verdict, error) {
+	if content.ID != 0 || f.window == 0 || f.limit == 0 {
+		return Accept(), nil
+	}
+
+	posts, err := f.postRepository.FindRecentPosts(ctx, repository.FindRecentPostsParams{
+		UserID: content.UserID,
+		Since:  time.Now().Add(-f.window),
+		Limit:  f.limit,
+	})
+	if err != nil {
+		return Verdict{}, fmt.Errorf("postRepository.FindRecentPosts: %w", err)
+	}
+
+	if uint(len(posts)) >= f.limit {
+		return Reject(httperrors.NewRestError(http.StatusTooManyRequests,
+			fmt.Sprintf("can not post more than %d times in %s", f.limit, f.window), nil)), nil
+	}
+
+	return Accept(), nil
+}
@@ -0,0 +1,37 @@
+package contentfilter
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+)
+
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}']+`)
+
+type profanityFilter struct {
+	words map[string]struct{}
+}
+
+// NewProfanityFilter rejects the content which contains one of the words, the words are matched
+// case-insensitively and only as whole words
+func NewProfanityFilter(words []string) *profanityFilter {
+	f := &profanityFilter{words: make(map[string]struct{}, len(words))}
+	for _, word := range words {
+		f.words[strings.ToLower(word)] = struct{}{}
+	}
+
+	return f
+}
+
+func (f *profanityFilter) Filter(ctx context.Context, content Content) (Verdict, error) {
+	for _, word := range wordPattern.FindAllString(strings.ToLower(content.text()), -1) {
+		if _, ok := f.words[word]; ok {
+			return Reject(httperrors.NewRestError(http.StatusBadRequest, "content contains prohibited words", nil)), nil
+		}
+	}
+
+	return Accept(), nil
+}
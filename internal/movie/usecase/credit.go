@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type creditUsecase struct {
+	cfg              config.Config
+	movieRepository  repository.MovieRepository
+	creditRepository repository.CreditRepository
+	personRepository repository.PersonRepository
+	logger           logger.Logger
+}
+
+func NewCreditUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	creditRepository repository.CreditRepository, personRepository repository.PersonRepository) *creditUsecase {
+	return &creditUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, creditRepository: creditRepository,
+		personRepository: personRepository}
+}
+
+// ListMovieCredits returns the cast and the crew of the movie
+func (u *creditUsecase) ListMovieCredits(ctx context.Context, movieID uint64) (*entity.MovieCredits, error) {
+	movie, err := u.movieRepository.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	credits, err := u.creditRepository.FindByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("creditRepository.FindByMovieID: %w", err))
+	}
+
+	movieCredits := &entity.MovieCredits{
+		MovieID: movieID,
+		Cast:    make([]*entity.Credit, 0),
+		Crew:    make([]*entity.Credit, 0),
+	}
+	for _, credit := range credits {
+		if credit.Department == entity.DepartmentActing {
+			movieCredits.Cast = append(movieCredits.Cast, credit)
+		} else {
+			movieCredits.Crew = append(movieCredits.Crew, credit)
+		}
+	}
+
+	return movieCredits, nil
+}
+
+// GetPersonParams gets the person with a page of the filmography, which is paged with the page sizes of MovieList
+type GetPersonParams struct {
+	PersonID uint64     `json:"person_id"`
+	Page     PageParams `json:"page"`
+}
+
+// GetPerson returns the person together with the filmography
+func (u *creditUsecase) GetPerson(ctx context.Context, args GetPersonParams) (*entity.Person, error) {
+	params := repository.FindFilmographyParams{
+		PersonID: args.PersonID,
+		Limit:    pageSize(args.Page.Limit, u.cfg.MovieList.DefaultPageSize, u.cfg.MovieList.MaxPageSize),
+	}
+	if args.Page.Cursor != "" {
+		params.After = &repository.FilmographyCursor{}
+		if err := decodeCursor(args.Page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+
+		// a known release date is ordered by its precision as well
+		if params.After.ReleaseDate != nil && (params.After.ReleaseDatePrecision == nil ||
+			!entity.ValidDatePrecision(*params.After.ReleaseDatePrecision)) {
+			return nil, httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil)
+		}
+	}
+
+	person, err := u.personRepository.FindByID(ctx, args.PersonID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("personRepository.FindByID: %w", err))
+	}
+
+	if person == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("personRepository.FindByID: not found"))
+	}
+
+	filmography, err := u.creditRepository.FindFilmographyByPersonID(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("creditRepository.FindFilmographyByPersonID: %w", err))
+	}
+
+	person.Filmography = filmography.Credits
+	if filmography.Next != nil {
+		if person.NextCursor, err = encodeCursor(filmography.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return person, nil
+}
@@ -0,0 +1,279 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCreditUsecase struct {
+	suite.Suite
+}
+
+func TestCreditUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testCreditUsecase{})
+}
+
+func (s *testCreditUsecase) TestListMovieCredits() {
+	type testInput struct {
+		movieID              uint64
+		mockMovieRepository  func(*mock_repository.MockMovieRepository)
+		mockCreditRepository func(*mock_repository.MockCreditRepository)
+	}
+
+	type testOutput struct {
+		credits *entity.MovieCredits
+		err     error
+	}
+
+	director := &entity.Credit{ID: 1, MovieID: 1, PersonID: 1, PersonName: "Christopher Nolan", Role: "Director",
+		Department: "Directing"}
+	actor := &entity.Credit{ID: 2, MovieID: 1, PersonID: 2, PersonName: "Emma Thompson", Role: "Actor",
+		Department: entity.DepartmentActing, CharacterName: utils.StringPtr("Mara")}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_cast_and_crew_of_movie",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.Credit{director, actor}, nil)
+				},
+			},
+			expected: testOutput{
+				credits: &entity.MovieCredits{
+					MovieID: 1,
+					Cast:    []*entity.Credit{actor},
+					Crew:    []*entity.Credit{director},
+				},
+			},
+		},
+		{
+			name: "returns_empty_cast_and_crew_when_movie_has_no_credit",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.Credit{}, nil)
+				},
+			},
+			expected: testOutput{
+				credits: &entity.MovieCredits{
+					MovieID: 1,
+					Cast:    []*entity.Credit{},
+					Crew:    []*entity.Credit{},
+				},
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_does_not_exist",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindByMovieID_when_it_happened",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("creditRepository.FindByMovieID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockCreditRepository := mock_repository.NewMockCreditRepository(ctrl)
+			c.input.mockCreditRepository(mockCreditRepository)
+
+			u := usecase.NewCreditUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockCreditRepository, nil)
+			res, err := u.ListMovieCredits(context.Background(), c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.credits, res)
+		})
+	}
+}
+
+func (s *testCreditUsecase) TestGetPerson() {
+	type testInput struct {
+		args                 usecase.GetPersonParams
+		mockPersonRepository func(*mock_repository.MockPersonRepository)
+		mockCreditRepository func(*mock_repository.MockCreditRepository)
+	}
+
+	type testOutput struct {
+		person *entity.Person
+		err    error
+	}
+
+	filmography := []*entity.FilmographyCredit{
+		{ID: 1, MovieID: 1, OriginalTitle: "accumsan sed, facilisis vitae,", Role: "Director", Department: "Directing"},
+	}
+
+	filmographyCursor, err := cursor.Encode(repository.FilmographyCursor{ID: 1, MovieID: 1})
+	assert.NoError(s.T(), err)
+	noPrecisionCursor, err := cursor.Encode(repository.FilmographyCursor{ID: 1, MovieID: 1,
+		ReleaseDate: &entity.Date{Year: 2023, Month: time.April, Day: 24}})
+	assert.NoError(s.T(), err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_person_with_filmography",
+			input: testInput{
+				args: usecase.GetPersonParams{PersonID: 1},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Person{ID: 1, Name: "Christopher Nolan"}, nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindFilmographyByPersonID(gomock.Any(), repository.FindFilmographyParams{PersonID: 1, Limit: 20}).
+						Return(&repository.FilmographyPage{Credits: filmography}, nil)
+				},
+			},
+			expected: testOutput{
+				person: &entity.Person{ID: 1, Name: "Christopher Nolan", Filmography: filmography},
+			},
+		},
+		{
+			name: "returns_page_of_filmography_with_next_cursor_after_cursor",
+			input: testInput{
+				args: usecase.GetPersonParams{PersonID: 1, Page: usecase.PageParams{Cursor: filmographyCursor, Limit: 1}},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Person{ID: 1, Name: "Christopher Nolan"}, nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindFilmographyByPersonID(gomock.Any(), repository.FindFilmographyParams{
+						PersonID: 1,
+						Limit:    1,
+						After:    &repository.FilmographyCursor{ID: 1, MovieID: 1},
+					}).Return(&repository.FilmographyPage{
+						Credits: filmography,
+						Next:    &repository.FilmographyCursor{ID: 1, MovieID: 1},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				person: &entity.Person{ID: 1, Name: "Christopher Nolan", Filmography: filmography,
+					NextCursor: &filmographyCursor},
+			},
+		},
+		{
+			name: "returns_error_when_release_date_cursor_has_no_precision",
+			input: testInput{
+				args:                 usecase.GetPersonParams{PersonID: 1, Page: usecase.PageParams{Cursor: noPrecisionCursor}},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_not_found_when_person_does_not_exist",
+			input: testInput{
+				args: usecase.GetPersonParams{PersonID: 1},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("personRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindByID_when_it_happened",
+			input: testInput{
+				args: usecase.GetPersonParams{PersonID: 1},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("personRepository.FindByID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_error_of_FindFilmographyByPersonID_when_it_happened",
+			input: testInput{
+				args: usecase.GetPersonParams{PersonID: 1},
+				mockPersonRepository: func(r *mock_repository.MockPersonRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Person{ID: 1, Name: "Christopher Nolan"}, nil)
+				},
+				mockCreditRepository: func(r *mock_repository.MockCreditRepository) {
+					r.EXPECT().FindFilmographyByPersonID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("creditRepository.FindFilmographyByPersonID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPersonRepository := mock_repository.NewMockPersonRepository(ctrl)
+			c.input.mockPersonRepository(mockPersonRepository)
+			mockCreditRepository := mock_repository.NewMockCreditRepository(ctrl)
+			c.input.mockCreditRepository(mockCreditRepository)
+
+			u := usecase.NewCreditUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil,
+				mockCreditRepository, mockPersonRepository)
+			res, err := u.GetPerson(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.person, res)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type genreUsecase struct {
+	cfg             config.Config
+	genreRepository repository.GenreRepository
+	logger          logger.Logger
+}
+
+func NewGenreUsecase(cfg config.Config, log logger.Logger, genreRepository repository.GenreRepository) *genreUsecase {
+	return &genreUsecase{cfg: cfg, logger: log, genreRepository: genreRepository}
+}
+
+func (u *genreUsecase) ListGenres(ctx context.Context) ([]*entity.Genre, error) {
+	genres, err := u.genreRepository.FindAll(ctx)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("genreRepository.FindAll: %w", err))
+	}
+
+	return genres, nil
+}
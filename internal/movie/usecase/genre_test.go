@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testGenreUsecase struct {
+	suite.Suite
+}
+
+func TestGenreUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testGenreUsecase{})
+}
+
+func (s *testGenreUsecase) TestListGenres() {
+	type testInput struct {
+		mockGenreRepository func(*mock_repository.MockGenreRepository)
+	}
+
+	type testOutput struct {
+		genres []*entity.Genre
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_all_genres",
+			input: testInput{
+				mockGenreRepository: func(r *mock_repository.MockGenreRepository) {
+					r.EXPECT().FindAll(gomock.Any()).Return([]*entity.Genre{{ID: 1, Name: "Action", Slug: "action"}}, nil)
+				},
+			},
+			expected: testOutput{
+				genres: []*entity.Genre{{ID: 1, Name: "Action", Slug: "action"}},
+			},
+		},
+		{
+			name: "returns_error_of_FindAll_when_it_happened",
+			input: testInput{
+				mockGenreRepository: func(r *mock_repository.MockGenreRepository) {
+					r.EXPECT().FindAll(gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("genreRepository.FindAll: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGenreRepository := mock_repository.NewMockGenreRepository(ctrl)
+			c.input.mockGenreRepository(mockGenreRepository)
+
+			u := usecase.NewGenreUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockGenreRepository)
+			res, err := u.ListGenres(context.Background())
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.genres, res)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+// Viewer is the user who reads a review or a comment, UserID is 0 for anonymous users
+type Viewer struct {
+	UserID      uint64 `json:"user_id"`
+	IsModerator bool   `json:"is_moderator"`
+}
+
+// canSee tells whether the content written by author with the moderation status can be read by the viewer,
+// content which is not public is shown only to its author and the moderators
+func (v Viewer) canSee(status entity.ModerationStatus, authorID uint64) bool {
+	return status.IsPublic() || v.IsModerator || (v.UserID != 0 && v.UserID == authorID)
+}
+
+// filterContent runs the content filter and returns the moderation status to save the content with, the
+// content which the filter sends to moderation is pending and the rest keeps the given status
+func filterContent(ctx context.Context, contentFilter contentfilter.ContentFilter, content contentfilter.Content,
+	status entity.ModerationStatus) (entity.ModerationStatus, contentfilter.Verdict, error) {
+	verdict, err := contentFilter.Filter(ctx, content)
+	if err != nil {
+		return "", verdict, httperrors.NewInternalServerError(fmt.Errorf("contentFilter.Filter: %w", err))
+	}
+
+	switch verdict.Decision {
+	case contentfilter.DecisionReject:
+		return "", verdict, verdict.Err
+	case contentfilter.DecisionModerate:
+		return entity.ModerationStatusPending, verdict, nil
+	}
+
+	return status, verdict, nil
+}
+
+// reportFilteredContent puts the content which the filter sends to moderation into the moderation queue
+func reportFilteredContent(ctx context.Context, reportRepository repository.ReportRepository,
+	targetType entity.ReportTargetType, targetID uint64, verdict contentfilter.Verdict) error {
+	if verdict.Decision != contentfilter.DecisionModerate {
+		return nil
+	}
+
+	if _, err := reportRepository.CreateFilterReport(ctx, repository.CreateFilterReportParams{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     verdict.Reason,
+	}); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("reportRepository.CreateFilterReport: %w", err))
+	}
+
+	return nil
+}
+
+type moderationUsecase struct {
+	cfg                  config.Config
+	reviewRepository     repository.ReviewRepository
+	commentRepository    repository.CommentRepository
+	reportRepository     repository.ReportRepository
+	moderationRepository repository.ModerationRepository
+	logger               logger.Logger
+}
+
+func NewModerationUsecase(cfg config.Config, log logger.Logger, reviewRepository repository.ReviewRepository,
+	commentRepository repository.CommentRepository, reportRepository repository.ReportRepository,
+	moderationRepository repository.ModerationRepository) *moderationUsecase {
+	return &moderationUsecase{cfg: cfg, logger: log, reviewRepository: reviewRepository,
+		commentRepository: commentRepository, reportRepository: reportRepository,
+		moderationRepository: moderationRepository}
+}
+
+type ReportContentParams struct {
+	UserID   uint64 `json:"user_id"`
+	TargetID uint64 `json:"target_id"`
+	Reason   string `json:"reason"`
+}
+
+func (u *moderationUsecase) ReportReview(ctx context.Context, args ReportContentParams) (*entity.Report, error) {
+	review, err := u.findReview(ctx, args.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(Viewer{UserID: args.UserID}).canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	if review.UserID == args.UserID {
+		return nil, httperrors.NewRestError(http.StatusBadRequest, "can not report own review", nil)
+	}
+
+	return u.report(ctx, entity.ReportTargetReview, args)
+}
+
+func (u *moderationUsecase) ReportComment(ctx context.Context, args ReportContentParams) (*entity.Report, error) {
+	comment, err := u.findComment(ctx, args.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(Viewer{UserID: args.UserID}).canSee(comment.ModerationStatus, comment.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("comment %d is hidden", comment.ID))
+	}
+
+	if comment.UserID == args.UserID {
+		return nil, httperrors.NewRestError(http.StatusBadRequest, "can not report own comment", nil)
+	}
+
+	return u.report(ctx, entity.ReportTargetComment, args)
+}
+
+func (u *moderationUsecase) report(ctx context.Context, targetType entity.ReportTargetType,
+	args ReportContentParams) (*entity.Report, error) {
+	existing, err := u.reportRepository.FindByUserIDAndTarget(ctx, repository.FindReportByUserIDAndTargetParams{
+		UserID:     args.UserID,
+		TargetType: targetType,
+		TargetID:   args.TargetID,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reportRepository.FindByUserIDAndTarget: %w", err))
+	}
+
+	alreadyReported := httperrors.NewRestError(http.StatusBadRequest,
+		fmt.Sprintf("%s %d is already reported", targetType, args.TargetID), nil)
+	if existing != nil {
+		return nil, alreadyReported
+	}
+
+	report, err := u.reportRepository.CreateReport(ctx, repository.CreateReportParams{
+		UserID:     args.UserID,
+		TargetType: targetType,
+		TargetID:   args.TargetID,
+		Reason:     args.Reason,
+	})
+	if err != nil {
+		// a concurrent request of the user may create the report after it is checked above
+		if errors.Is(err, repository.ErrReportExists) {
+			return nil, alreadyReported
+		}
+
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reportRepository.CreateReport: %w", err))
+	}
+
+	return report, nil
+}
+
+type ListReportsParams struct {
+	// Status is open or resolved, the open reports are listed when it is empty
+	Status string     `json:"status"`
+	Page   PageParams `json:"page"`
+}
+
+// ListReports lists the moderation queue, the oldest report first
+func (u *moderationUsecase) ListReports(ctx context.Context, args ListReportsParams) (*entity.ReportPage, error) {
+	status := entity.ReportStatus(args.Status)
+	if status == "" {
+		status = entity.ReportStatusOpen
+	}
+
+	if status != entity.ReportStatusOpen && status != entity.ReportStatusResolved {
+		return nil, httperrors.NewBadRequestError(fmt.Errorf("invalid status: %s", args.Status))
+	}
+
+	params := repository.FindReportsParams{
+		Status: status,
+		Limit:  pageSize(args.Page.Limit, u.cfg.Moderation.DefaultPageSize, u.cfg.Moderation.MaxPageSize),
+	}
+	if args.Page.Cursor != "" {
+		params.After = &repository.ReportCursor{}
+		if err := decodeCursor(args.Page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+	}
+
+	reports, err := u.reportRepository.FindReports(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reportRepository.FindReports: %w", err))
+	}
+
+	reportPage := &entity.ReportPage{Reports: reports.Reports}
+	if reports.Next != nil {
+		if reportPage.NextCursor, err = encodeCursor(reports.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return reportPage, nil
+}
+
+type ModerateContentParams struct {
+	ModeratorID uint64 `json:"moderator_id"`
+	TargetID    uint64 `json:"target_id"`
+	// Action is one of hide, restore and delete
+	Action string `json:"action"`
+	// Note is the reason of the decision which is recorded with the action
+	Note string `json:"note"`
+}
+
+func (u *moderationUsecase) ModerateReview(ctx context.Context, args ModerateContentParams) (*entity.ModerationAction, error) {
+	if _, err := u.findReview(ctx, args.TargetID); err != nil {
+		return nil, err
+	}
+
+	return u.moderate(ctx, entity.ReportTargetReview, args)
+}
+
+func (u *moderationUsecase) ModerateComment(ctx context.Context, args ModerateContentParams) (*entity.ModerationAction, error) {
+	if _, err := u.findComment(ctx, args.TargetID); err != nil {
+		return nil, err
+	}
+
+	return u.moderate(ctx, entity.ReportTargetComment, args)
+}
+
+func (u *moderationUsecase) moderate(ctx context.Context, targetType entity.ReportTargetType,
+	args ModerateContentParams) (*entity.ModerationAction, error) {
+	action := entity.ModerationActionType(args.Action)
+	switch action {
+	case entity.ModerationActionHide, entity.ModerationActionRestore, entity.ModerationActionDelete:
+	default:
+		return nil, httperrors.NewBadRequestError(fmt.Errorf("invalid action: %s", args.Action))
+	}
+
+	moderationAction, err := u.moderationRepository.Moderate(ctx, repository.ModerateParams{
+		ModeratorID: args.ModeratorID,
+		TargetType:  targetType,
+		TargetID:    args.TargetID,
+		Action:      action,
+		Note:        args.Note,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("moderationRepository.Moderate: %w", err))
+	}
+
+	return moderationAction, nil
+}
+
+func (u *moderationUsecase) findReview(ctx context.Context, reviewID uint64) (*entity.Review, error) {
+	review, err := u.reviewRepository.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByID: %w", err))
+	}
+
+	if review == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found"))
+	}
+
+	return review, nil
+}
+
+// findComment returns the comment only when it is not deleted, a deleted comment has nothing left to moderate
+func (u *moderationUsecase) findComment(ctx context.Context, commentID uint64) (*entity.Comment, error) {
+	comment, err := u.commentRepository.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("commentRepository.FindByID: %w", err))
+	}
+
+	if comment == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("commentRepository.FindByID: not found"))
+	}
+
+	if comment.Deleted {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("comment %d is deleted", commentID))
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,525 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testModerationUsecase struct {
+	suite.Suite
+}
+
+func TestModerationUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testModerationUsecase{})
+}
+
+var moderationConfig = config.Config{
+	Moderation: config.ModerationConfig{DefaultPageSize: 50, MaxPageSize: 200},
+}
+
+func dummyReport(reportID uint64, targetType entity.ReportTargetType, targetID uint64) *entity.Report {
+	return &entity.Report{
+		ID:         reportID,
+		UserID:     2,
+		Username:   "otheruser",
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     "offensive language",
+		Status:     entity.ReportStatusOpen,
+		CreatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt:  utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testModerationUsecase) TestReportReview() {
+	type testInput struct {
+		args                 usecase.ReportContentParams
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+		mockReportRepository func(*mock_repository.MockReportRepository)
+	}
+
+	type testOutput struct {
+		report *entity.Report
+		err    error
+	}
+
+	hiddenReview := dummyReview(5, 1)
+	hiddenReview.ModerationStatus = entity.ModerationStatusHidden
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_report",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					gomock.InOrder(
+						r.EXPECT().FindByUserIDAndTarget(gomock.Any(), repository.FindReportByUserIDAndTargetParams{
+							UserID:     2,
+							TargetType: entity.ReportTargetReview,
+							TargetID:   5,
+						}).Return(nil, nil),
+						r.EXPECT().CreateReport(gomock.Any(), repository.CreateReportParams{
+							UserID:     2,
+							TargetType: entity.ReportTargetReview,
+							TargetID:   5,
+							Reason:     "offensive language",
+						}).Return(dummyReport(3, entity.ReportTargetReview, 5), nil),
+					)
+				},
+			},
+			expected: testOutput{
+				report: dummyReport(3, entity.ReportTargetReview, 5),
+			},
+		},
+		{
+			name: "returns_error_when_user_reports_own_review",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 1, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "can not report own review", nil),
+			},
+		},
+		{
+			name: "returns_error_when_review_is_already_reported_by_user",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindByUserIDAndTarget(gomock.Any(), gomock.Any()).
+						Return(dummyReport(3, entity.ReportTargetReview, 5), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "review 5 is already reported", nil),
+			},
+		},
+		{
+			name: "returns_error_when_review_is_reported_by_user_concurrently",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindByUserIDAndTarget(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReport(gomock.Any(), gomock.Any()).Return(nil, repository.ErrReportExists)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "review 5 is already reported", nil),
+			},
+		},
+		{
+			name: "returns_error_when_review_is_hidden",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", 5)),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 5, Reason: "offensive language"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+			c.input.mockReportRepository(mockReportRepository)
+
+			u := usecase.NewModerationUsecase(moderationConfig, logger.NewApiLogger(&config.Config{}), mockReviewRepository,
+				nil, mockReportRepository, nil)
+			res, err := u.ReportReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.report, res)
+		})
+	}
+}
+
+func (s *testModerationUsecase) TestReportComment() {
+	type testInput struct {
+		args                  usecase.ReportContentParams
+		mockCommentRepository func(*mock_repository.MockCommentRepository)
+		mockReportRepository  func(*mock_repository.MockReportRepository)
+	}
+
+	type testOutput struct {
+		report *entity.Report
+		err    error
+	}
+
+	deletedComment := dummyComment(7, 1, nil, 1)
+	deletedComment.Deleted = true
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_report",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 7, Reason: "offensive language"},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, nil, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindByUserIDAndTarget(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReport(gomock.Any(), repository.CreateReportParams{
+						UserID:     2,
+						TargetType: entity.ReportTargetComment,
+						TargetID:   7,
+						Reason:     "offensive language",
+					}).Return(dummyReport(3, entity.ReportTargetComment, 7), nil)
+				},
+			},
+			expected: testOutput{
+				report: dummyReport(3, entity.ReportTargetComment, 7),
+			},
+		},
+		{
+			name: "returns_error_when_comment_is_deleted",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 7, Reason: "offensive language"},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(deletedComment, nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("comment %d is deleted", 7)),
+			},
+		},
+		{
+			name: "returns_error_of_CreateReport",
+			input: testInput{
+				args: usecase.ReportContentParams{UserID: 2, TargetID: 7, Reason: "offensive language"},
+				mockCommentRepository: func(r *mock_repository.MockCommentRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(7)).Return(dummyComment(7, 1, nil, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindByUserIDAndTarget(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReport(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reportRepository.CreateReport: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCommentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+			c.input.mockCommentRepository(mockCommentRepository)
+			c.input.mockReportRepository(mockReportRepository)
+
+			u := usecase.NewModerationUsecase(moderationConfig, logger.NewApiLogger(&config.Config{}), nil,
+				mockCommentRepository, mockReportRepository, nil)
+			res, err := u.ReportComment(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.report, res)
+		})
+	}
+}
+
+func (s *testModerationUsecase) TestListReports() {
+	type testInput struct {
+		args                 usecase.ListReportsParams
+		cfg                  *config.Config
+		mockReportRepository func(*mock_repository.MockReportRepository)
+	}
+
+	type testOutput struct {
+		page *entity.ReportPage
+		err  error
+	}
+
+	reportCursor, err := cursor.Encode(repository.ReportCursor{ID: 3})
+	assert.NoError(s.T(), err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_first_page_of_open_reports_when_nothing_is_given",
+			input: testInput{
+				args: usecase.ListReportsParams{},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindReports(gomock.Any(), repository.FindReportsParams{
+						Status: entity.ReportStatusOpen,
+						Limit:  50,
+					}).Return(&repository.ReportPage{
+						Reports: []*entity.Report{dummyReport(3, entity.ReportTargetReview, 5)},
+						Next:    &repository.ReportCursor{ID: 3},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReportPage{
+					Reports:    []*entity.Report{dummyReport(3, entity.ReportTargetReview, 5)},
+					NextCursor: &reportCursor,
+				},
+			},
+		},
+		{
+			name: "returns_page_after_cursor",
+			input: testInput{
+				args: usecase.ListReportsParams{Page: usecase.PageParams{Cursor: reportCursor, Limit: 10}},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindReports(gomock.Any(), repository.FindReportsParams{
+						Status: entity.ReportStatusOpen,
+						Limit:  10,
+						After:  &repository.ReportCursor{ID: 3},
+					}).Return(&repository.ReportPage{Reports: []*entity.Report{}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReportPage{Reports: []*entity.Report{}},
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_invalid",
+			input: testInput{
+				args:                 usecase.ListReportsParams{Page: usecase.PageParams{Cursor: "not a cursor"}},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "limits_size_to_max_page_size",
+			input: testInput{
+				args: usecase.ListReportsParams{Status: "resolved", Page: usecase.PageParams{Limit: 1000}},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindReports(gomock.Any(), repository.FindReportsParams{
+						Status: entity.ReportStatusResolved,
+						Limit:  200,
+					}).Return(&repository.ReportPage{Reports: []*entity.Report{}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReportPage{
+					Reports: []*entity.Report{},
+				},
+			},
+		},
+		{
+			name: "uses_default_page_size_when_page_sizes_are_not_configured",
+			input: testInput{
+				args: usecase.ListReportsParams{},
+				cfg:  &config.Config{},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().FindReports(gomock.Any(), repository.FindReportsParams{
+						Status: entity.ReportStatusOpen,
+						Limit:  20,
+					}).Return(&repository.ReportPage{
+						Reports: []*entity.Report{dummyReport(3, entity.ReportTargetReview, 5)},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReportPage{
+					Reports: []*entity.Report{dummyReport(3, entity.ReportTargetReview, 5)},
+				},
+			},
+		},
+		{
+			name: "returns_error_when_status_is_invalid",
+			input: testInput{
+				args:                 usecase.ListReportsParams{Status: "closed"},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("invalid status: %s", "closed")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+			c.input.mockReportRepository(mockReportRepository)
+
+			cfg := moderationConfig
+			if c.input.cfg != nil {
+				cfg = *c.input.cfg
+			}
+
+			u := usecase.NewModerationUsecase(cfg, logger.NewApiLogger(&config.Config{}), nil, nil,
+				mockReportRepository, nil)
+			res, err := u.ListReports(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.page, res)
+		})
+	}
+}
+
+func (s *testModerationUsecase) TestModerateReview() {
+	type testInput struct {
+		args                     usecase.ModerateContentParams
+		mockReviewRepository     func(*mock_repository.MockReviewRepository)
+		mockModerationRepository func(*mock_repository.MockModerationRepository)
+	}
+
+	type testOutput struct {
+		action *entity.ModerationAction
+		err    error
+	}
+
+	hideAction := &entity.ModerationAction{
+		ID:          4,
+		ModeratorID: 9,
+		TargetType:  entity.ReportTargetReview,
+		TargetID:    5,
+		Action:      entity.ModerationActionHide,
+		Note:        "harassment",
+		CreatedAt:   utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_recorded_action",
+			input: testInput{
+				args: usecase.ModerateContentParams{ModeratorID: 9, TargetID: 5, Action: "hide", Note: "harassment"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockModerationRepository: func(r *mock_repository.MockModerationRepository) {
+					r.EXPECT().Moderate(gomock.Any(), repository.ModerateParams{
+						ModeratorID: 9,
+						TargetType:  entity.ReportTargetReview,
+						TargetID:    5,
+						Action:      entity.ModerationActionHide,
+						Note:        "harassment",
+					}).Return(hideAction, nil)
+				},
+			},
+			expected: testOutput{
+				action: hideAction,
+			},
+		},
+		{
+			name: "returns_error_when_action_is_invalid",
+			input: testInput{
+				args: usecase.ModerateContentParams{ModeratorID: 9, TargetID: 5, Action: "ban", Note: "harassment"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockModerationRepository: func(r *mock_repository.MockModerationRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("invalid action: %s", "ban")),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.ModerateContentParams{ModeratorID: 9, TargetID: 5, Action: "delete", Note: "harassment"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+				mockModerationRepository: func(r *mock_repository.MockModerationRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_Moderate",
+			input: testInput{
+				args: usecase.ModerateContentParams{ModeratorID: 9, TargetID: 5, Action: "restore", Note: "not abusive"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockModerationRepository: func(r *mock_repository.MockModerationRepository) {
+					r.EXPECT().Moderate(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("moderationRepository.Moderate: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockModerationRepository := mock_repository.NewMockModerationRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+			c.input.mockModerationRepository(mockModerationRepository)
+
+			u := usecase.NewModerationUsecase(moderationConfig, logger.NewApiLogger(&config.Config{}), mockReviewRepository,
+				nil, nil, mockModerationRepository)
+			res, err := u.ModerateReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.action, res)
+		})
+	}
+}
@@ -4,28 +4,106 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/samthehai/ml-backend-test-samthehai/config"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/language"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
 )
 
-const limitPopularMovieNumber = 100
-
 type movieUsecase struct {
-	cfg                config.Config
-	movieRepository    repository.MovieRepository
-	favoriteRepository repository.FavoriteRepository
-	logger             logger.Logger
+	cfg                        config.Config
+	movieRepository            repository.MovieRepository
+	movieSearcher              repository.MovieSearcher
+	favoriteRepository         repository.FavoriteRepository
+	movieTranslationRepository repository.MovieTranslationRepository
+	collectionRepository       repository.CollectionRepository
+	releaseDateRepository      repository.ReleaseDateRepository
+	externalIDRepository       repository.ExternalIDRepository
+	logger                     logger.Logger
+}
+
+func NewMovieUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	movieSearcher repository.MovieSearcher, favoriteRepository repository.FavoriteRepository,
+	movieTranslationRepository repository.MovieTranslationRepository,
+	collectionRepository repository.CollectionRepository,
+	releaseDateRepository repository.ReleaseDateRepository,
+	externalIDRepository repository.ExternalIDRepository) *movieUsecase {
+	return &movieUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, movieSearcher: movieSearcher,
+		favoriteRepository: favoriteRepository, movieTranslationRepository: movieTranslationRepository, collectionRepository: collectionRepository,
+		releaseDateRepository: releaseDateRepository, externalIDRepository: externalIDRepository}
 }
 
-func NewMovieUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository, favoriteRepository repository.FavoriteRepository) *movieUsecase {
-	return &movieUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, favoriteRepository: favoriteRepository}
+// GetMovieByIDParams gets the movie translated into the best of Languages, which are ordered by preference,
+// together with the summary of the collection which it belongs to, its releases in every country and its external ids
+type GetMovieByIDParams struct {
+	MovieID   uint64   `json:"movie_id"`
+	Languages []string `json:"languages"`
 }
 
-func (u *movieUsecase) GetMovieByID(ctx context.Context, movieID uint64) (*entity.Movie, error) {
+func (u *movieUsecase) GetMovieByID(ctx context.Context, args GetMovieByIDParams) (*entity.Movie, error) {
+	movie, err := u.findMovie(ctx, args.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	movie.Collection, err = u.collectionRepository.FindSummaryByMovieID(ctx, movie.ID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindSummaryByMovieID: %w", err))
+	}
+
+	movie.ReleaseDates, err = u.releaseDateRepository.FindByMovieID(ctx, movie.ID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("releaseDateRepository.FindByMovieID: %w", err))
+	}
+
+	movie.ExternalIDs, err = u.externalIDRepository.FindByMovieID(ctx, movie.ID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindByMovieID: %w", err))
+	}
+
+	if err := translateMovies(ctx, u.movieTranslationRepository, []*entity.Movie{movie}, args.Languages); err != nil {
+		return nil, err
+	}
+
+	return movie, nil
+}
+
+// GetMovieByExternalIDParams gets the movie which ExternalID identifies in the system of Source like GetMovieByID
+type GetMovieByExternalIDParams struct {
+	Source     string   `json:"source"`
+	ExternalID string   `json:"external_id"`
+	Languages  []string `json:"languages"`
+}
+
+func (u *movieUsecase) GetMovieByExternalID(ctx context.Context, args GetMovieByExternalIDParams) (*entity.Movie, error) {
+	if err := validateExternalID(args.Source, args.ExternalID); err != nil {
+		return nil, err
+	}
+
+	movieID, err := u.externalIDRepository.FindMovieIDByExternalID(ctx, repository.FindMovieIDByExternalIDParams{
+		Source:     args.Source,
+		ExternalID: args.ExternalID,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindMovieIDByExternalID: %w", err))
+	}
+
+	if movieID == 0 {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("externalIDRepository.FindMovieIDByExternalID: not found"))
+	}
+
+	return u.GetMovieByID(ctx, GetMovieByIDParams{MovieID: movieID, Languages: args.Languages})
+}
+
+func (u *movieUsecase) findMovie(ctx context.Context, movieID uint64) (*entity.Movie, error) {
 	movie, err := u.movieRepository.FindByID(ctx, movieID)
 	if err != nil {
 		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
@@ -38,21 +116,296 @@ func (u *movieUsecase) GetMovieByID(ctx context.Context, movieID uint64) (*entit
 	return movie, nil
 }
 
-func (u *movieUsecase) SearchByKeyword(ctx context.Context, keyword string) ([]*entity.Movie, error) {
-	if len(keyword) == 0 {
-		movies, err := u.movieRepository.FindPopularMovies(ctx, limitPopularMovieNumber)
+// ReleaseFilter matches the movies which have a release satisfying every given condition, see
+// repository.ReleaseFilter
+type ReleaseFilter struct {
+	Country        string     `json:"country"`
+	ReleaseType    string     `json:"release_type"`
+	ReleasedFrom   *time.Time `json:"released_from"`
+	ReleasedBefore *time.Time `json:"released_before"`
+	MaxMinimumAge  *uint8     `json:"max_minimum_age"`
+}
+
+// PageParams gets at most Limit items of a list after Cursor which is the next cursor of the previous page, the first
+// page has no Cursor. Limit is the default page size of the list when it is 0 and is at most its max page size
+type PageParams struct {
+	Cursor string `json:"cursor"`
+	Limit  uint   `json:"limit"`
+}
+
+// defaultPageSize and defaultMaxPageSize are used when the config of a list has no page size
+const (
+	defaultPageSize    = 20
+	defaultMaxPageSize = 100
+)
+
+// pageSize returns the size of a page which is requested with size, it is defaultSize when size is 0 and is at most
+// maxSize. The sizes of the config which are 0 are defaulted to defaultPageSize and defaultMaxPageSize
+func pageSize(size uint, defaultSize uint, maxSize uint) uint {
+	if defaultSize == 0 {
+		defaultSize = defaultPageSize
+	}
+
+	if maxSize == 0 {
+		maxSize = defaultMaxPageSize
+	}
+
+	if size == 0 {
+		size = defaultSize
+	}
+
+	if size > maxSize {
+		size = maxSize
+	}
+
+	return size
+}
+
+// defaultRanking is used when the config has no ranking
+var defaultRanking = config.RankingConfig{MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5}
+
+// ranking returns the ranking of the config, it is defaultRanking when the config has no ranking. MinimumVotes which is
+// 0 is defaulted as well since the bayesian average of a movie which has no rating would be divided by 0
+func (u *movieUsecase) ranking() config.RankingConfig {
+	ranking := u.cfg.Ranking
+	if ranking == (config.RankingConfig{}) {
+		return defaultRanking
+	}
+
+	if ranking.MinimumVotes == 0 {
+		ranking.MinimumVotes = defaultRanking.MinimumVotes
+	}
+
+	return ranking
+}
+
+func (u *movieUsecase) toPageParams(args PageParams) (repository.PageParams, error) {
+	limit := pageSize(args.Limit, u.cfg.MovieList.DefaultPageSize, u.cfg.MovieList.MaxPageSize)
+	page := repository.PageParams{Limit: limit}
+	if args.Cursor == "" {
+		return page, nil
+	}
+
+	page.After = &repository.MovieCursor{}
+	if err := decodeCursor(args.Cursor, page.After); err != nil {
+		return repository.PageParams{}, err
+	}
+
+	return page, nil
+}
+
+// decodeCursor decodes the cursor of PageParams into the position of a list, an invalid cursor is a bad request
+func decodeCursor(value string, position interface{}) error {
+	if err := cursor.Decode(value, position); err != nil {
+		return httperrors.NewRestError(http.StatusBadRequest, err.Error(), nil)
+	}
+
+	return nil
+}
+
+// encodeCursor encodes the position of the next page of a list into its next cursor
+func encodeCursor(position interface{}) (*string, error) {
+	next, err := cursor.Encode(position)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("cursor.Encode: %w", err))
+	}
+
+	return &next, nil
+}
+
+// toMoviePage translates the movies of the page and encodes its next cursor
+func (u *movieUsecase) toMoviePage(ctx context.Context, page *repository.MoviePage,
+	languages []string) (*entity.MoviePage, error) {
+	if err := translateMovies(ctx, u.movieTranslationRepository, page.Movies, languages); err != nil {
+		return nil, err
+	}
+
+	moviePage := &entity.MoviePage{Movies: page.Movies}
+	if page.Next != nil {
+		next, err := encodeCursor(page.Next)
+		if err != nil {
+			return nil, err
+		}
+		moviePage.NextCursor = next
+	}
+
+	return moviePage, nil
+}
+
+// MovieFilter matches the movies satisfying every given condition, see repository.MovieFilter. Currency is required
+// with a budget or a revenue range since the amounts are meaningless without it
+type MovieFilter struct {
+	OriginalLanguage string   `json:"original_language"`
+	Adult            *bool    `json:"adult"`
+	ReleaseYearFrom  *int     `json:"release_year_from"`
+	ReleaseYearTo    *int     `json:"release_year_to"`
+	Currency         string   `json:"currency"`
+	MinBudget        *uint64  `json:"min_budget"`
+	MaxBudget        *uint64  `json:"max_budget"`
+	MinRevenue       *int64   `json:"min_revenue"`
+	MaxRevenue       *int64   `json:"max_revenue"`
+	MinRating        *float64 `json:"min_rating"`
+}
+
+func (f MovieFilter) validate() error {
+	hasAmount := f.MinBudget != nil || f.MaxBudget != nil || f.MinRevenue != nil || f.MaxRevenue != nil
+	if hasAmount && len(f.Currency) == 0 {
+		return httperrors.NewRestError(http.StatusBadRequest, "currency is required with a budget or revenue range", nil)
+	}
+
+	return nil
+}
+
+// MovieSort orders the movies by Field, which is one of the entity.MovieSort constants, in Order which is asc or
+// desc. Field is the relevance when there is a search and the popularity otherwise when it is empty, Order is asc
+// for the title and desc for the others when it is empty
+type MovieSort struct {
+	Field string `json:"field"`
+	Order string `json:"order"`
+}
+
+func (s MovieSort) toRepository(search bool) (repository.MovieSort, error) {
+	field := s.Field
+	if len(field) == 0 {
+		field = entity.MovieSortPopularity
+		if search {
+			field = entity.MovieSortRelevance
+		}
+	}
+
+	switch field {
+	case entity.MovieSortRelevance:
+		if !search {
+			return repository.MovieSort{}, httperrors.NewRestError(http.StatusBadRequest,
+				"sort by relevance requires a keyword", nil)
+		}
+	case entity.MovieSortReleaseDate, entity.MovieSortRevenue, entity.MovieSortPopularity, entity.MovieSortTitle:
+	default:
+		return repository.MovieSort{}, httperrors.NewBadRequestError(fmt.Errorf("invalid sort: %s", field))
+	}
+
+	sort := repository.MovieSort{Field: field, Desc: field != entity.MovieSortTitle}
+	switch s.Order {
+	case "":
+	case entity.SortOrderAsc:
+		sort.Desc = false
+	case entity.SortOrderDesc:
+		sort.Desc = true
+	default:
+		return repository.MovieSort{}, httperrors.NewBadRequestError(fmt.Errorf("invalid order: %s", s.Order))
+	}
+
+	return sort, nil
+}
+
+// continuesSort returns whether the cursor is the one of a list ordered by the sort and has the value of the sort,
+// the value of the release date and of the revenue may be unknown but a known release date has its precision
+func continuesSort(after *repository.MovieCursor, sort repository.MovieSort) bool {
+	if after.Sort != sort.Field || after.Desc != sort.Desc {
+		return false
+	}
+
+	switch sort.Field {
+	case entity.MovieSortPopularity, entity.MovieSortRelevance:
+		return after.Score != nil
+	case entity.MovieSortTitle:
+		return after.Title != nil
+	case entity.MovieSortReleaseDate:
+		return after.ReleaseDate == nil || (after.ReleaseDatePrecision != nil &&
+			entity.ValidDatePrecision(*after.ReleaseDatePrecision))
+	}
+
+	return true
+}
+
+// SearchByKeywordParams searches the movies by Keyword which is a query of the searchquery syntax, every movie is
+// listed when it is empty. The movies of a search have their relevance score and their highlights. Only the movies
+// of any of Genres (the genre slugs) are returned when it is not empty, and only the movies matching Filter and
+// Release are returned. They are ordered by Sort and translated into the best of Languages. The cursor of Page must
+// be the one of a list ordered by the same Sort
+type SearchByKeywordParams struct {
+	Keyword   string        `json:"keyword"`
+	Filter    MovieFilter   `json:"filter"`
+	Genres    []string      `json:"genres"`
+	Release   ReleaseFilter `json:"release"`
+	Sort      MovieSort     `json:"sort"`
+	Languages []string      `json:"languages"`
+	Page      PageParams    `json:"page"`
+}
+
+func (u *movieUsecase) SearchByKeyword(ctx context.Context, args SearchByKeywordParams) (*entity.MoviePage, error) {
+	page, err := u.toPageParams(args.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := args.Filter.validate(); err != nil {
+		return nil, err
+	}
+
+	ranking := u.ranking()
+	params := repository.FindMoviesParams{
+		Filter:         repository.MovieFilter(args.Filter),
+		Genres:         args.Genres,
+		Release:        repository.ReleaseFilter(args.Release),
+		MinimumVotes:   ranking.MinimumVotes,
+		RatingWeight:   ranking.RatingWeight,
+		FavoriteWeight: ranking.FavoriteWeight,
+		Page:           page,
+	}
+
+	if len(strings.TrimSpace(args.Keyword)) > 0 {
+		query, err := searchquery.Parse(args.Keyword)
 		if err != nil {
-			return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindPopularMovies: %w", err))
+			return nil, httperrors.NewRestError(http.StatusBadRequest, fmt.Sprintf("invalid search: %s", err), nil)
 		}
-		return movies, nil
+		params.Query = &query
+	}
+
+	params.Sort, err = args.Sort.toRepository(params.Query != nil)
+	if err != nil {
+		return nil, err
 	}
 
-	movies, err := u.movieRepository.FindByKeyword(ctx, keyword)
+	if page.After != nil && !continuesSort(page.After, params.Sort) {
+		return nil, httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil)
+	}
+
+	movies, err := u.movieSearcher.SearchMovies(ctx, params)
 	if err != nil {
-		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByKeyword: %w", err))
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieSearcher.SearchMovies: %w", err))
+	}
+
+	moviePage, err := u.toMoviePage(ctx, movies, args.Languages)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Query != nil {
+		u.highlightMovies(moviePage.Movies, *params.Query)
 	}
 
-	return movies, nil
+	return moviePage, nil
+}
+
+// highlightMovies sets the fragments of the original title and of the overview of every movie which match the
+// query, the overview is the translated one when the movie is translated since the search matches it too
+func (u *movieUsecase) highlightMovies(movies []*entity.Movie, query searchquery.Query) {
+	options := searchquery.HighlightOptions{
+		PreTag:       u.cfg.Search.HighlightPreTag,
+		PostTag:      u.cfg.Search.HighlightPostTag,
+		FragmentSize: u.cfg.Search.FragmentSize,
+		MaxFragments: u.cfg.Search.MaxFragments,
+	}
+
+	for _, movie := range movies {
+		movie.Highlights = &entity.MovieHighlights{
+			OriginalTitle: query.Highlight(movie.OriginalTitle, searchquery.FieldTitle, options),
+		}
+		if movie.Overview != nil {
+			movie.Highlights.Overview = query.Highlight(*movie.Overview, searchquery.FieldAny, options)
+		}
+	}
 }
 
 type AddFavoriteMovieParams struct {
@@ -93,11 +446,369 @@ func (u *movieUsecase) AddFavoriteMovie(ctx context.Context, args AddFavoriteMov
 	return nil
 }
 
-func (u *movieUsecase) ListFavoriteMoviesByUserID(ctx context.Context, userID uint64) ([]*entity.Movie, error) {
-	movies, err := u.favoriteRepository.FindFavoriteMoviesByUserID(ctx, userID)
+// ListFavoriteMoviesByUserIDParams lists the favorite movies of the user, only the movies of any of Genres
+// (the genre slugs) are listed when it is not empty. Only the movies matching Release are listed and they are
+// translated into the best of Languages
+type ListFavoriteMoviesByUserIDParams struct {
+	UserID    uint64        `json:"user_id"`
+	Genres    []string      `json:"genres"`
+	Release   ReleaseFilter `json:"release"`
+	Languages []string      `json:"languages"`
+	Page      PageParams    `json:"page"`
+}
+
+func (u *movieUsecase) ListFavoriteMoviesByUserID(ctx context.Context,
+	args ListFavoriteMoviesByUserIDParams) (*entity.MoviePage, error) {
+	page, err := u.toPageParams(args.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := u.favoriteRepository.FindFavoriteMoviesByUserID(ctx, repository.FindFavoriteMoviesByUserIDParams{
+		UserID:  args.UserID,
+		Genres:  args.Genres,
+		Release: repository.ReleaseFilter(args.Release),
+		Page:    page,
+	})
 	if err != nil {
 		return nil, httperrors.NewInternalServerError(fmt.Errorf("favoriteRepository.FindFavoriteMoviesByUserID: %w", err))
 	}
 
-	return movies, nil
+	return u.toMoviePage(ctx, movies, args.Languages)
+}
+
+// translateMovies replaces the title and the overview of every movie with its translation which matches best
+// the languages ordered by preference, the original ones are kept when no translation matches. The overview is
+// kept as well when the translation has none
+func translateMovies(ctx context.Context, movieTranslationRepository repository.MovieTranslationRepository,
+	movies []*entity.Movie, languages []string) error {
+	if len(movies) == 0 || len(languages) == 0 {
+		return nil
+	}
+
+	movieIDs := make([]uint64, len(movies))
+	for i, movie := range movies {
+		movieIDs[i] = movie.ID
+	}
+
+	translations, err := movieTranslationRepository.FindByMovieIDs(ctx, movieIDs)
+	if err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("movieTranslationRepository.FindByMovieIDs: %w", err))
+	}
+
+	translationsByMovieID := make(map[uint64]map[string]*entity.MovieTranslation)
+	availableLanguages := make(map[uint64][]string)
+	for _, translation := range translations {
+		if translationsByMovieID[translation.MovieID] == nil {
+			translationsByMovieID[translation.MovieID] = make(map[string]*entity.MovieTranslation)
+		}
+		translationsByMovieID[translation.MovieID][translation.Language] = translation
+		availableLanguages[translation.MovieID] = append(availableLanguages[translation.MovieID], translation.Language)
+	}
+
+	for _, movie := range movies {
+		matched, ok := language.Match(languages, availableLanguages[movie.ID])
+		if !ok {
+			continue
+		}
+
+		translation := translationsByMovieID[movie.ID][matched]
+		movie.Title = translation.Title
+		movie.TranslationLanguage = translation.Language
+		if translation.Overview != nil {
+			movie.Overview = translation.Overview
+		}
+	}
+
+	return nil
+}
+
+// MovieParams are the fields of a movie which are written by the admins, ReleaseDate is known only to
+// ReleaseDatePrecision and the parts of it which are not known are dropped
+type MovieParams struct {
+	OriginalTitle        string       `json:"original_title"`
+	OriginalLanguage     string       `json:"original_language"`
+	Overview             *string      `json:"overview"`
+	PosterPath           *string      `json:"poster_path"`
+	BackdropPath         *string      `json:"backdrop_path"`
+	Adult                bool         `json:"adult"`
+	ReleaseDate          *entity.Date `json:"release_date"`
+	ReleaseDatePrecision *string      `json:"release_date_precision"`
+	Budget               *uint64      `json:"budget"`
+	Revenue              *int64       `json:"revenue"`
+	Currency             *string      `json:"currency"`
+}
+
+// normalize checks that the currency of Budget and Revenue is given, the amounts are meaningless without it, and
+// that ReleaseDate has a precision. It returns the params whose ReleaseDate is truncated to the precision
+func (p MovieParams) normalize() (MovieParams, error) {
+	if (p.Budget != nil || p.Revenue != nil) && p.Currency == nil {
+		return p, httperrors.NewRestError(http.StatusBadRequest, "currency is required with budget or revenue", nil)
+	}
+
+	if p.ReleaseDate == nil {
+		p.ReleaseDatePrecision = nil
+		return p, nil
+	}
+
+	if p.ReleaseDatePrecision == nil {
+		return p, httperrors.NewRestError(http.StatusBadRequest, "release_date_precision is required with release_date",
+			nil)
+	}
+
+	if !entity.ValidDatePrecision(*p.ReleaseDatePrecision) {
+		return p, httperrors.NewBadRequestError(fmt.Errorf("invalid release_date_precision: %s",
+			*p.ReleaseDatePrecision))
+	}
+
+	releaseDate := p.ReleaseDate.Truncate(*p.ReleaseDatePrecision)
+	p.ReleaseDate = &releaseDate
+
+	return p, nil
+}
+
+func (u *movieUsecase) CreateMovie(ctx context.Context, args MovieParams) (*entity.Movie, error) {
+	args, err := args.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := u.movieRepository.CreateMovie(ctx, repository.MovieParams(args))
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.CreateMovie: %w", err))
+	}
+
+	indexMovie(ctx, u.movieSearcher, u.logger, movie.ID)
+
+	return movie, nil
+}
+
+// UpdateMovieParams replaces every field of the movie, the nil fields are cleared
+type UpdateMovieParams struct {
+	MovieID uint64 `json:"movie_id"`
+	MovieParams
+}
+
+func (u *movieUsecase) UpdateMovie(ctx context.Context, args UpdateMovieParams) (*entity.Movie, error) {
+	if _, err := u.findMovie(ctx, args.MovieID); err != nil {
+		return nil, err
+	}
+
+	return u.updateMovie(ctx, args.MovieID, args.MovieParams)
+}
+
+// PatchMovieParams changes only the fields which are not nil, a field can not be cleared by PatchMovie. The precision
+// of the current release date is changed when only ReleaseDatePrecision is given
+type PatchMovieParams struct {
+	MovieID              uint64       `json:"movie_id"`
+	OriginalTitle        *string      `json:"original_title"`
+	OriginalLanguage     *string      `json:"original_language"`
+	Overview             *string      `json:"overview"`
+	PosterPath           *string      `json:"poster_path"`
+	BackdropPath         *string      `json:"backdrop_path"`
+	Adult                *bool        `json:"adult"`
+	ReleaseDate          *entity.Date `json:"release_date"`
+	ReleaseDatePrecision *string      `json:"release_date_precision"`
+	Budget               *uint64      `json:"budget"`
+	Revenue              *int64       `json:"revenue"`
+	Currency             *string      `json:"currency"`
+}
+
+func (u *movieUsecase) PatchMovie(ctx context.Context, args PatchMovieParams) (*entity.Movie, error) {
+	movie, err := u.findMovie(ctx, args.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := MovieParams{
+		OriginalTitle:        movie.OriginalTitle,
+		OriginalLanguage:     movie.OriginalLanguage,
+		Overview:             movie.Overview,
+		PosterPath:           movie.PosterPath,
+		BackdropPath:         movie.BackdropPath,
+		Adult:                movie.Adult,
+		ReleaseDate:          movie.ReleaseDate,
+		ReleaseDatePrecision: movie.ReleaseDatePrecision,
+		Budget:               movie.Budget,
+		Revenue:              movie.Revenue,
+		Currency:             movie.Currency,
+	}
+
+	if args.OriginalTitle != nil {
+		params.OriginalTitle = *args.OriginalTitle
+	}
+
+	if args.OriginalLanguage != nil {
+		params.OriginalLanguage = *args.OriginalLanguage
+	}
+
+	if args.Overview != nil {
+		params.Overview = args.Overview
+	}
+
+	if args.PosterPath != nil {
+		params.PosterPath = args.PosterPath
+	}
+
+	if args.BackdropPath != nil {
+		params.BackdropPath = args.BackdropPath
+	}
+
+	if args.Adult != nil {
+		params.Adult = *args.Adult
+	}
+
+	if args.ReleaseDate != nil {
+		params.ReleaseDate = args.ReleaseDate
+	}
+
+	if args.ReleaseDatePrecision != nil {
+		params.ReleaseDatePrecision = args.ReleaseDatePrecision
+	}
+
+	if args.Budget != nil {
+		params.Budget = args.Budget
+	}
+
+	if args.Revenue != nil {
+		params.Revenue = args.Revenue
+	}
+
+	if args.Currency != nil {
+		params.Currency = args.Currency
+	}
+
+	return u.updateMovie(ctx, args.MovieID, params)
+}
+
+func (u *movieUsecase) updateMovie(ctx context.Context, movieID uint64, args MovieParams) (*entity.Movie, error) {
+	args, err := args.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := u.movieRepository.UpdateMovie(ctx, repository.UpdateMovieParams{
+		ID:          movieID,
+		MovieParams: repository.MovieParams(args),
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.UpdateMovie: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.UpdateMovie: movie %d not found", movieID))
+	}
+
+	indexMovie(ctx, u.movieSearcher, u.logger, movieID)
+
+	return movie, nil
+}
+
+// indexMovie updates the movie in the search index after the catalog is written. A failure is only logged since the
+// catalog is already written, the index catches up on the next write of the movie or on a reindex
+func indexMovie(ctx context.Context, movieSearcher repository.MovieSearcher, log logger.Logger, movieID uint64) {
+	if err := movieSearcher.IndexMovie(ctx, movieID); err != nil {
+		log.Errorf("movieSearcher.IndexMovie: movie %d: %v", movieID, err)
+	}
+}
+
+type SetMovieExternalIDParams struct {
+	MovieID    uint64 `json:"movie_id"`
+	Source     string `json:"source"`
+	ExternalID string `json:"external_id"`
+}
+
+// SetMovieExternalID replaces the id of the movie for the source and returns all the external ids of the movie,
+// an external id which already identifies another movie is rejected
+func (u *movieUsecase) SetMovieExternalID(ctx context.Context, args SetMovieExternalIDParams) (entity.ExternalIDs, error) {
+	if err := validateExternalID(args.Source, args.ExternalID); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.findMovie(ctx, args.MovieID); err != nil {
+		return nil, err
+	}
+
+	movieID, err := u.externalIDRepository.FindMovieIDByExternalID(ctx, repository.FindMovieIDByExternalIDParams{
+		Source:     args.Source,
+		ExternalID: args.ExternalID,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindMovieIDByExternalID: %w", err))
+	}
+
+	if movieID != 0 && movieID != args.MovieID {
+		return nil, httperrors.NewRestError(http.StatusConflict,
+			fmt.Sprintf("%s id %s already identifies movie %d", args.Source, args.ExternalID, movieID), nil)
+	}
+
+	if movieID == 0 {
+		if err := u.externalIDRepository.SetExternalID(ctx, repository.SetExternalIDParams{
+			MovieID:    args.MovieID,
+			Source:     args.Source,
+			ExternalID: args.ExternalID,
+		}); err != nil {
+			return nil, httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.SetExternalID: %w", err))
+		}
+	}
+
+	externalIDs, err := u.externalIDRepository.FindByMovieID(ctx, args.MovieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindByMovieID: %w", err))
+	}
+
+	return externalIDs, nil
+}
+
+// externalIDPatterns are the formats of the ids of every source such as tt0111161 for IMDb, 278 for TMDB and
+// Q172241 for Wikidata
+var externalIDPatterns = map[string]*regexp.Regexp{
+	entity.ExternalSourceIMDb:     regexp.MustCompile(`^tt[0-9]{7,10}$`),
+	entity.ExternalSourceTMDB:     regexp.MustCompile(`^[1-9][0-9]{0,9}$`),
+	entity.ExternalSourceWikidata: regexp.MustCompile(`^Q[1-9][0-9]{0,9}$`),
+}
+
+func validateExternalID(source string, externalID string) error {
+	pattern, ok := externalIDPatterns[source]
+	if !ok {
+		return httperrors.NewBadRequestError(fmt.Errorf("unknown external id source %q", source))
+	}
+
+	if !pattern.MatchString(externalID) {
+		return httperrors.NewBadRequestError(fmt.Errorf("%q is not a valid %s id", externalID, source))
+	}
+
+	return nil
+}
+
+// DeleteMovie soft deletes the movie, it is not returned by any api until it is restored
+func (u *movieUsecase) DeleteMovie(ctx context.Context, movieID uint64) error {
+	if _, err := u.findMovie(ctx, movieID); err != nil {
+		return err
+	}
+
+	if err := u.movieRepository.DeleteMovie(ctx, movieID); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("movieRepository.DeleteMovie: %w", err))
+	}
+
+	if err := u.movieSearcher.RemoveMovie(ctx, movieID); err != nil {
+		u.logger.Errorf("movieSearcher.RemoveMovie: movie %d: %v", movieID, err)
+	}
+
+	return nil
+}
+
+func (u *movieUsecase) RestoreMovie(ctx context.Context, movieID uint64) (*entity.Movie, error) {
+	movie, err := u.movieRepository.RestoreMovie(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.RestoreMovie: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.RestoreMovie: deleted movie %d not found", movieID))
+	}
+
+	indexMovie(ctx, u.movieSearcher, u.logger, movieID)
+
+	return movie, nil
 }
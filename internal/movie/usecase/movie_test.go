@@ -14,6 +14,7 @@ import (
 	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -23,14 +24,33 @@ type testMovieUsecase struct {
 	suite.Suite
 }
 
+// mustDate returns the date formatted as 2006-01-02
+func mustDate(value string) entity.Date {
+	date, _, err := entity.ParseDate(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return date
+}
+
+func datePtr(value string) *entity.Date {
+	date := mustDate(value)
+	return &date
+}
+
 func TestMovieUsecasesuite(t *testing.T) {
 	suite.Run(t, &testMovieUsecase{})
 }
 
 func (s *testMovieUsecase) TestGetMovieByID() {
 	type testInput struct {
-		movieID             uint64
-		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		args                           usecase.GetMovieByIDParams
+		mockMovieRepository            func(*mock_repository.MockMovieRepository)
+		mockMovieTranslationRepository func(*mock_repository.MockMovieTranslationRepository)
+		mockCollectionRepository       func(*mock_repository.MockCollectionRepository)
+		mockReleaseDateRepository      func(*mock_repository.MockReleaseDateRepository)
+		mockExternalIDRepository       func(*mock_repository.MockExternalIDRepository)
 	}
 
 	type testOutput struct {
@@ -46,7 +66,7 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 		{
 			name: "returns_movie_when_found",
 			input: testInput{
-				movieID: 1,
+				args: usecase.GetMovieByIDParams{MovieID: 1},
 				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
 					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(
 						&entity.Movie{
@@ -55,7 +75,7 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 							OriginalLanguage: "Nigeria",
 							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 							Adult:            false,
-							ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+							ReleaseDate:      datePtr("2022-08-20"),
 							Revenue:          utils.Int64Ptr(1000000),
 							Budget:           utils.Uint64Ptr(100000),
 							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -63,6 +83,15 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 						}, nil,
 					)
 				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
 			},
 			expected: testOutput{
 				movie: &entity.Movie{
@@ -71,18 +100,326 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 					OriginalLanguage: "Nigeria",
 					Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 					Adult:            false,
-					ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+					ReleaseDate:      datePtr("2022-08-20"),
 					Revenue:          utils.Int64Ptr(1000000),
 					Budget:           utils.Uint64Ptr(100000),
 					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					ReleaseDates:     []*entity.ReleaseDate{},
+					ExternalIDs:      entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "returns_movie_translated_into_best_language",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1, Languages: []string{"ja", "vi", "fr"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{
+						ID:            1,
+						OriginalTitle: "accumsan sed",
+						Title:         "accumsan sed",
+						Overview:      utils.StringPtr("risus. Donec nibh enim"),
+					}, nil)
+				},
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1}).Return([]*entity.MovieTranslation{
+						{MovieID: 1, Language: "fr", Title: "L'étranger"},
+						{MovieID: 1, Language: "vi", Title: "Người lạ", Overview: utils.StringPtr("Một người lạ")},
+					}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:                  1,
+					OriginalTitle:       "accumsan sed",
+					Title:               "Người lạ",
+					TranslationLanguage: "vi",
+					Overview:            utils.StringPtr("Một người lạ"),
+					ReleaseDates:        []*entity.ReleaseDate{},
+					ExternalIDs:         entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "keeps_original_overview_when_translation_has_no_overview",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1, Languages: []string{"fr-ca"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{
+						ID:            1,
+						OriginalTitle: "accumsan sed",
+						Title:         "accumsan sed",
+						Overview:      utils.StringPtr("risus. Donec nibh enim"),
+					}, nil)
+				},
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1}).Return([]*entity.MovieTranslation{
+						{MovieID: 1, Language: "fr", Title: "L'étranger"},
+					}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:                  1,
+					OriginalTitle:       "accumsan sed",
+					Title:               "L'étranger",
+					TranslationLanguage: "fr",
+					Overview:            utils.StringPtr("risus. Donec nibh enim"),
+					ReleaseDates:        []*entity.ReleaseDate{},
+					ExternalIDs:         entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "returns_original_movie_when_no_translation_matches",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1, Languages: []string{"ja"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{
+						ID:            1,
+						OriginalTitle: "accumsan sed",
+						Title:         "accumsan sed",
+					}, nil)
+				},
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1}).Return([]*entity.MovieTranslation{
+						{MovieID: 1, Language: "vi", Title: "Người lạ"},
+					}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:            1,
+					OriginalTitle: "accumsan sed",
+					Title:         "accumsan sed",
+					ReleaseDates:  []*entity.ReleaseDate{},
+					ExternalIDs:   entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "returns_error_of_FindByMovieIDs_when_error_happended",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1, Languages: []string{"vi"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1}).Return(nil, fmt.Errorf("dummy error"))
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("movieTranslationRepository.FindByMovieIDs: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_movie_with_collection_summary_when_movie_belongs_to_collection",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1, Title: "accumsan sed"}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(&entity.CollectionSummary{
+						ID:         1,
+						Name:       "The Stranger Collection",
+						Position:   1,
+						MovieCount: 2,
+					}, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:    1,
+					Title: "accumsan sed",
+					Collection: &entity.CollectionSummary{
+						ID:         1,
+						Name:       "The Stranger Collection",
+						Position:   1,
+						MovieCount: 2,
+					},
+					ReleaseDates: []*entity.ReleaseDate{},
+					ExternalIDs:  entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "returns_error_of_FindSummaryByMovieID_when_error_happended",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("collectionRepository.FindSummaryByMovieID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_movie_with_release_dates",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{
+						{
+							ID:            1,
+							MovieID:       1,
+							Country:       "JP",
+							ReleaseType:   entity.ReleaseTypeTheatrical,
+							ReleaseDate:   mustDate("2022-08-20"),
+							Certification: utils.StringPtr("PG12"),
+							MinimumAge:    utils.Uint8Ptr(12),
+						},
+					}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID: 1,
+					ReleaseDates: []*entity.ReleaseDate{
+						{
+							ID:            1,
+							MovieID:       1,
+							Country:       "JP",
+							ReleaseType:   entity.ReleaseTypeTheatrical,
+							ReleaseDate:   mustDate("2022-08-20"),
+							Certification: utils.StringPtr("PG12"),
+							MinimumAge:    utils.Uint8Ptr(12),
+						},
+					},
+					ExternalIDs: entity.ExternalIDs{},
+				},
+			},
+		},
+		{
+			name: "returns_error_of_FindByMovieID_when_error_happended",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("releaseDateRepository.FindByMovieID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_movie_with_external_ids",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{
+						entity.ExternalSourceIMDb: "tt0111161",
+						entity.ExternalSourceTMDB: "278",
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:           1,
+					ReleaseDates: []*entity.ReleaseDate{},
+					ExternalIDs: entity.ExternalIDs{
+						entity.ExternalSourceIMDb: "tt0111161",
+						entity.ExternalSourceTMDB: "278",
+					},
+				},
+			},
+		},
+		{
+			name: "returns_error_of_FindByMovieID_of_external_ids_when_error_happended",
+			input: testInput{
+				args: usecase.GetMovieByIDParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
 				},
 			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindByMovieID: %w", fmt.Errorf("dummy error"))),
+			},
 		},
 		{
 			name: "returns_notfound_error_when_not_found",
 			input: testInput{
-				movieID: 1,
+				args: usecase.GetMovieByIDParams{MovieID: 1},
 				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
 					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
 				},
@@ -95,7 +432,7 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 		{
 			name: "returns_error_of_FindByID_when_error_happended",
 			input: testInput{
-				movieID: 1,
+				args: usecase.GetMovieByIDParams{MovieID: 1},
 				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
 					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
 				},
@@ -114,9 +451,29 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 
 			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
 			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieTranslationRepository := mock_repository.NewMockMovieTranslationRepository(ctrl)
+			if c.input.mockMovieTranslationRepository != nil {
+				c.input.mockMovieTranslationRepository(mockMovieTranslationRepository)
+			}
 
-			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil)
-			res, err := u.GetMovieByID(context.Background(), c.input.movieID)
+			mockCollectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			if c.input.mockCollectionRepository != nil {
+				c.input.mockCollectionRepository(mockCollectionRepository)
+			}
+
+			mockReleaseDateRepository := mock_repository.NewMockReleaseDateRepository(ctrl)
+			if c.input.mockReleaseDateRepository != nil {
+				c.input.mockReleaseDateRepository(mockReleaseDateRepository)
+			}
+
+			mockExternalIDRepository := mock_repository.NewMockExternalIDRepository(ctrl)
+			if c.input.mockExternalIDRepository != nil {
+				c.input.mockExternalIDRepository(mockExternalIDRepository)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil, nil,
+				mockMovieTranslationRepository, mockCollectionRepository, mockReleaseDateRepository, mockExternalIDRepository)
+			res, err := u.GetMovieByID(context.Background(), c.input.args)
 			assert.Equal(t, c.expected.err, err)
 			assert.Equal(t, c.expected.movie, res)
 		})
@@ -125,15 +482,24 @@ func (s *testMovieUsecase) TestGetMovieByID() {
 
 func (s *testMovieUsecase) TestSearchByKeyword() {
 	type testInput struct {
-		keyword             string
-		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		args                           usecase.SearchByKeywordParams
+		mockMovieSearcher              func(*mock_repository.MockMovieSearcher)
+		mockMovieTranslationRepository func(*mock_repository.MockMovieTranslationRepository)
 	}
 
 	type testOutput struct {
-		err    error
-		movies []*entity.Movie
+		err  error
+		page *entity.MoviePage
 	}
 
+	testQuery := searchquery.Query{Terms: []searchquery.Term{{Words: []string{"test"}}}}
+
+	testPage := repository.PageParams{Limit: 20}
+
+	popularitySort := repository.MovieSort{Field: entity.MovieSortPopularity, Desc: true}
+
+	relevanceSort := repository.MovieSort{Field: entity.MovieSortRelevance, Desc: true}
+
 	cases := []struct {
 		name     string
 		input    testInput
@@ -142,17 +508,23 @@ func (s *testMovieUsecase) TestSearchByKeyword() {
 		{
 			name: "returns_popular_movies_when_keyword_is_empty",
 			input: testInput{
-				keyword: "",
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindPopularMovies(gomock.Any(), uint(100)).Return(
-						[]*entity.Movie{
+				args: usecase.SearchByKeywordParams{},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:           popularitySort,
+						Page:           testPage,
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+					}).Return(
+						&repository.MoviePage{Movies: []*entity.Movie{
 							{
 								ID:               1,
 								OriginalTitle:    "accumsan sed, facilisis vitae,",
 								OriginalLanguage: "Nigeria",
 								Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 								Adult:            false,
-								ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+								ReleaseDate:      datePtr("2022-08-20"),
 								Revenue:          utils.Int64Ptr(1000000),
 								Budget:           utils.Uint64Ptr(100000),
 								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -164,25 +536,25 @@ func (s *testMovieUsecase) TestSearchByKeyword() {
 								OriginalLanguage: "Nigeria",
 								Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 								Adult:            false,
-								ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+								ReleaseDate:      datePtr("2022-08-20"),
 								Revenue:          utils.Int64Ptr(1000000),
 								Budget:           utils.Uint64Ptr(100000),
 								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 								UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 							},
-						}, nil,
+						}}, nil,
 					)
 				},
 			},
 			expected: testOutput{
-				movies: []*entity.Movie{
+				page: &entity.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "accumsan sed, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -194,42 +566,49 @@ func (s *testMovieUsecase) TestSearchByKeyword() {
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 					},
-				},
+				}},
 			},
 		},
 		{
-			name: "returns_error_of_FindPopularMovies_when_error_happended",
+			name: "returns_error_of_FindMovies_when_error_happended_without_keyword",
 			input: testInput{
-				keyword: "",
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindPopularMovies(gomock.Any(), uint(100)).Return(nil, fmt.Errorf("dummy error"))
+				args: usecase.SearchByKeywordParams{},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:           popularitySort,
+						Page:           testPage,
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+					}).Return(nil, fmt.Errorf("dummy error"))
 				},
 			},
 			expected: testOutput{
-				movies: nil,
-				err:    httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindPopularMovies: %w", fmt.Errorf("dummy error"))),
+				page: nil,
+				err:  httperrors.NewInternalServerError(fmt.Errorf("movieSearcher.SearchMovies: %w", fmt.Errorf("dummy error"))),
 			},
 		},
 		{
 			name: "returns_movies_when_keyword_is_not_empty",
 			input: testInput{
-				keyword: "test",
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindByKeyword(gomock.Any(), "test").Return(
-						[]*entity.Movie{
+				args: usecase.SearchByKeywordParams{Keyword: "test"},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{Query: &testQuery, Sort: relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5, Page: testPage}).Return(
+						&repository.MoviePage{Movies: []*entity.Movie{
 							{
 								ID:               1,
 								OriginalTitle:    "accumsan tested, facilisis vitae,",
 								OriginalLanguage: "Nigeria",
 								Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 								Adult:            false,
-								ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+								ReleaseDate:      datePtr("2022-08-20"),
 								Revenue:          utils.Int64Ptr(1000000),
 								Budget:           utils.Uint64Ptr(100000),
 								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -241,29 +620,30 @@ func (s *testMovieUsecase) TestSearchByKeyword() {
 								OriginalLanguage: "Nigeria",
 								Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 								Adult:            false,
-								ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+								ReleaseDate:      datePtr("2022-08-20"),
 								Revenue:          utils.Int64Ptr(1000000),
 								Budget:           utils.Uint64Ptr(100000),
 								CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 								UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 							},
-						}, nil,
+						}}, nil,
 					)
 				},
 			},
 			expected: testOutput{
-				movies: []*entity.Movie{
+				page: &entity.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "accumsan tested, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Highlights:       &entity.MovieHighlights{},
 					},
 					{
 						ID:               2,
@@ -271,140 +651,519 @@ func (s *testMovieUsecase) TestSearchByKeyword() {
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						Highlights: &entity.MovieHighlights{
+							OriginalTitle: []string{"accumsan sed, <em>test</em> facilisis vitae,2"},
+						},
 					},
-				},
+				}},
 			},
 		},
 		{
-			name: "returns_error_of_FindByKeyword_when_error_happended",
+			name: "passes_genres_to_FindMovies_when_keyword_is_empty",
 			input: testInput{
-				keyword: "test",
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindByKeyword(gomock.Any(), "test").Return(nil, fmt.Errorf("dummy error"))
+				args: usecase.SearchByKeywordParams{Genres: []string{"horror", "thriller"}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:           popularitySort,
+						Page:           testPage,
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+						Genres:         []string{"horror", "thriller"},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
 				},
 			},
 			expected: testOutput{
-				movies: nil,
-				err:    httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByKeyword: %w", fmt.Errorf("dummy error"))),
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1}}},
 			},
 		},
-	}
-
-	for _, c := range cases {
-		s.T().Run(c.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
-			c.input.mockMovieRepository(mockMovieRepository)
-
-			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil)
-			res, err := u.SearchByKeyword(context.Background(), c.input.keyword)
-			assert.Equal(t, c.expected.err, err)
-			assert.Equal(t, c.expected.movies, res)
-		})
-	}
-}
-
-func (s *testMovieUsecase) TestAddFavoriteMovie() {
-	type testInput struct {
-		args                   repository.AddFavoriteMovieParams
-		mockMovieRepository    func(*mock_repository.MockMovieRepository)
-		mockFavoriteRepository func(*mock_repository.MockFavoriteRepository)
-	}
-
-	type testOutput struct {
-		err error
-	}
-
-	cases := []struct {
-		name     string
-		input    testInput
-		expected testOutput
-	}{
 		{
-			name: "returns_nil_when_add_favorite_movie_successfully",
+			name: "passes_genres_to_FindMovies_when_keyword_is_not_empty",
 			input: testInput{
-				args: repository.AddFavoriteMovieParams{
-					UserID:  1,
-					MovieID: 10,
-				},
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(
-						&entity.Movie{
-							ID:               10,
-							OriginalTitle:    "accumsan tested, facilisis vitae,",
-							OriginalLanguage: "Nigeria",
-							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
-							Adult:            false,
-							ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
-							Revenue:          utils.Int64Ptr(1000000),
-							Budget:           utils.Uint64Ptr(100000),
-							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
-							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
-						}, nil,
-					)
+				args: usecase.SearchByKeywordParams{Keyword: "test", Genres: []string{"horror"}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:         relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5,
+						Query:  &testQuery,
+						Page:   testPage,
+						Genres: []string{"horror"},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
 				},
-				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
-					r.EXPECT().CheckIsFavoriteMovie(gomock.Any(), repository.CheckIsFavoriteMovieParams{
-						UserID:  1,
-						MovieID: 10,
-					}).Return(false, nil)
-					r.EXPECT().AddFavoriteMovie(gomock.Any(), repository.AddFavoriteMovieParams{
-						UserID:  1,
-						MovieID: 10,
-					}).Return(nil)
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1, Highlights: &entity.MovieHighlights{}}}},
+			},
+		},
+		{
+			name: "passes_release_filter_to_FindMovies",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Keyword: "test", Release: usecase.ReleaseFilter{
+					Country:       "JP",
+					ReleasedFrom:  utils.TimePtr(utils.MustRFC3339Time("2021-01-01T00:00:00+00:00")),
+					MaxMinimumAge: utils.Uint8Ptr(12),
+				}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:         relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5,
+						Query: &testQuery,
+						Page:  testPage,
+						Release: repository.ReleaseFilter{
+							Country:       "JP",
+							ReleasedFrom:  utils.TimePtr(utils.MustRFC3339Time("2021-01-01T00:00:00+00:00")),
+							MaxMinimumAge: utils.Uint8Ptr(12),
+						},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
 				},
 			},
 			expected: testOutput{
-				err: nil,
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1, Highlights: &entity.MovieHighlights{}}}},
 			},
 		},
 		{
-			name: "returns_error_of_FindByID_when_FindByID_returns_error",
+			name: "returns_movies_translated_into_best_language_of_each_movie",
 			input: testInput{
-				args: repository.AddFavoriteMovieParams{
-					UserID:  1,
-					MovieID: 10,
+				args: usecase.SearchByKeywordParams{Keyword: "test", Languages: []string{"vi", "pt"}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{Query: &testQuery, Sort: relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5, Page: testPage}).Return(
+						&repository.MoviePage{Movies: []*entity.Movie{{ID: 1, Title: "test 1"}, {ID: 2, Title: "test 2"}, {ID: 3, Title: "test 3"}}}, nil)
 				},
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, fmt.Errorf("dummy error"))
+				mockMovieTranslationRepository: func(r *mock_repository.MockMovieTranslationRepository) {
+					r.EXPECT().FindByMovieIDs(gomock.Any(), []uint64{1, 2, 3}).Return([]*entity.MovieTranslation{
+						{MovieID: 1, Language: "pt-BR", Title: "teste 1"},
+						{MovieID: 1, Language: "vi", Title: "thử 1"},
+						{MovieID: 2, Language: "pt-BR", Title: "teste 2"},
+					}, nil)
 				},
-				mockFavoriteRepository: func(mfr *mock_repository.MockFavoriteRepository) {},
 			},
 			expected: testOutput{
-				err: httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", fmt.Errorf("dummy error"))),
+				page: &entity.MoviePage{Movies: []*entity.Movie{
+					{ID: 1, Title: "thử 1", TranslationLanguage: "vi", Highlights: &entity.MovieHighlights{}},
+					{ID: 2, Title: "teste 2", TranslationLanguage: "pt-BR", Highlights: &entity.MovieHighlights{}},
+					{ID: 3, Title: "test 3", Highlights: &entity.MovieHighlights{}},
+				}},
 			},
 		},
 		{
-			name: "returns_error_when_not_found_movie",
+			name: "passes_parsed_query_to_FindMovies",
 			input: testInput{
-				args: repository.AddFavoriteMovieParams{
-					UserID:  1,
-					MovieID: 10,
+				args: usecase.SearchByKeywordParams{Keyword: `title:"the thing" -remake carp*`},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:         relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5,
+						Query: &searchquery.Query{Terms: []searchquery.Term{
+							{Words: []string{"the", "thing"}, Field: searchquery.FieldTitle, Phrase: true},
+							{Words: []string{"remake"}, Exclude: true},
+							{Words: []string{"carp"}, Prefix: true},
+						}},
+						Page: testPage,
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
 				},
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
-					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, nil)
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1, Highlights: &entity.MovieHighlights{}}}},
+			},
+		},
+		{
+			name: "returns_score_and_highlighted_fragments_of_title_and_overview",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Keyword: "ripley alien*"},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Query: &searchquery.Query{Terms: []searchquery.Term{
+							{Words: []string{"ripley"}},
+							{Words: []string{"alien"}, Prefix: true},
+						}},
+						Sort:           relevanceSort,
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+						Page:           testPage,
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{
+						ID:            1,
+						OriginalTitle: "Aliens",
+						Overview: utils.StringPtr("Warrant officer Ellen Ripley wakes up after 57 years in stasis. " +
+							"The crew of the Nostromo was killed by an alien creature and nobody believes Ripley."),
+						Score: utils.Float64Ptr(2.5),
+					}}}, nil)
 				},
-				mockFavoriteRepository: func(mfr *mock_repository.MockFavoriteRepository) {},
 			},
 			expected: testOutput{
-				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+				page: &entity.MoviePage{Movies: []*entity.Movie{{
+					ID:            1,
+					OriginalTitle: "Aliens",
+					Overview: utils.StringPtr("Warrant officer Ellen Ripley wakes up after 57 years in stasis. " +
+						"The crew of the Nostromo was killed by an alien creature and nobody believes Ripley."),
+					Score: utils.Float64Ptr(2.5),
+					Highlights: &entity.MovieHighlights{
+						OriginalTitle: []string{"<em>Aliens</em>"},
+						Overview: []string{
+							"officer Ellen <em>Ripley</em> wakes up after",
+							"was killed by an <em>alien</em> creature and",
+							"nobody believes <em>Ripley</em>.",
+						},
+					},
+				}}},
 			},
 		},
 		{
-			name: "returns_error_of_CheckIsFavoriteMovie_when_CheckIsFavoriteMovie_returns_error",
+			name: "returns_badrequest_error_when_keyword_is_malformed",
 			input: testInput{
-				args: repository.AddFavoriteMovieParams{
-					UserID:  1,
-					MovieID: 10,
+				args:              usecase.SearchByKeywordParams{Keyword: `alien "the thing`},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				page: nil,
+				err: httperrors.NewRestError(http.StatusBadRequest, "invalid search: unclosed quote at position 7",
+					nil),
+			},
+		},
+		{
+			name: "returns_next_cursor_of_popular_movies_with_limit_at_most_max_page_size",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Page: usecase.PageParams{Limit: 500}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:           popularitySort,
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+						Page:           repository.PageParams{Limit: 100},
+					}).Return(&repository.MoviePage{
+						Movies: []*entity.Movie{{ID: 1}},
+						Next: &repository.MovieCursor{ID: 1, Sort: entity.MovieSortPopularity, Desc: true,
+							Score: utils.Float64Ptr(2.5)},
+					}, nil)
 				},
-				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{
+					Movies:     []*entity.Movie{{ID: 1}},
+					NextCursor: utils.StringPtr("eyJpZCI6MSwic29ydCI6InBvcHVsYXJpdHkiLCJkZXNjIjp0cnVlLCJzY29yZSI6Mi41fQ"),
+				},
+			},
+		},
+		{
+			name: "passes_cursor_to_FindMovies",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Keyword: "test", Page: usecase.PageParams{
+					Cursor: "eyJpZCI6Nywic29ydCI6InJlbGV2YW5jZSIsImRlc2MiOnRydWUsInNjb3JlIjoxLjV9",
+					Limit:  5,
+				}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:         relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5,
+						Query: &testQuery,
+						Page: repository.PageParams{Limit: 5, After: &repository.MovieCursor{ID: 7, Sort: entity.MovieSortRelevance,
+							Desc: true, Score: utils.Float64Ptr(1.5)}},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 8}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 8, Highlights: &entity.MovieHighlights{}}}},
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_cursor_is_invalid",
+			input: testInput{
+				args:              usecase.SearchByKeywordParams{Keyword: "test", Page: usecase.PageParams{Cursor: "!!"}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "invalid cursor", nil),
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_cursor_of_popular_movies_has_no_score",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Page: usecase.PageParams{
+					Cursor: "eyJpZCI6Nywic29ydCI6InBvcHVsYXJpdHkiLCJkZXNjIjp0cnVlfQ"}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "invalid cursor", nil),
+			},
+		},
+		{
+			name: "passes_filter_and_sort_to_FindMovies",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{
+					Keyword: "test",
+					Filter: usecase.MovieFilter{
+						OriginalLanguage: "en",
+						ReleaseYearFrom:  utils.IntPtr(1990),
+						Currency:         "USD",
+						MinBudget:        utils.Uint64Ptr(100),
+						MinRating:        utils.Float64Ptr(7),
+					},
+					Sort: usecase.MovieSort{Field: entity.MovieSortReleaseDate, Order: entity.SortOrderAsc},
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Query: &testQuery,
+						Filter: repository.MovieFilter{
+							OriginalLanguage: "en",
+							ReleaseYearFrom:  utils.IntPtr(1990),
+							Currency:         "USD",
+							MinBudget:        utils.Uint64Ptr(100),
+							MinRating:        utils.Float64Ptr(7),
+						},
+						Sort:           repository.MovieSort{Field: entity.MovieSortReleaseDate},
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+						Page:           testPage,
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1, Highlights: &entity.MovieHighlights{}}}},
+			},
+		},
+		{
+			name: "orders_by_title_ascending_when_order_is_not_given",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Sort: usecase.MovieSort{Field: entity.MovieSortTitle}, Page: usecase.PageParams{
+					Cursor: "eyJpZCI6Nywic29ydCI6InRpdGxlIiwidGl0bGUiOiJhbGllbiJ9",
+				}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{
+						Sort:           repository.MovieSort{Field: entity.MovieSortTitle},
+						MinimumVotes:   10,
+						RatingWeight:   1,
+						FavoriteWeight: 0.5,
+						Page: repository.PageParams{Limit: 20, After: &repository.MovieCursor{ID: 7,
+							Sort: entity.MovieSortTitle, Title: utils.StringPtr("alien")}},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 8}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 8}}},
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_sorted_by_relevance_without_keyword",
+			input: testInput{
+				args:              usecase.SearchByKeywordParams{Sort: usecase.MovieSort{Field: entity.MovieSortRelevance}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "sort by relevance requires a keyword", nil),
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_budget_range_has_no_currency",
+			input: testInput{
+				args:              usecase.SearchByKeywordParams{Filter: usecase.MovieFilter{MaxBudget: utils.Uint64Ptr(100)}},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "currency is required with a budget or revenue range", nil),
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_cursor_is_of_another_order",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{
+					Sort: usecase.MovieSort{Order: entity.SortOrderAsc},
+					Page: usecase.PageParams{Cursor: "eyJpZCI6MSwic29ydCI6InBvcHVsYXJpdHkiLCJkZXNjIjp0cnVlLCJzY29yZSI6Mi41fQ"},
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "invalid cursor", nil),
+			},
+		},
+		{
+			name: "returns_error_of_FindMovies_when_error_happended",
+			input: testInput{
+				args: usecase.SearchByKeywordParams{Keyword: "test"},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().SearchMovies(gomock.Any(), repository.FindMoviesParams{Query: &testQuery, Sort: relevanceSort,
+						MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5, Page: testPage}).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				page: nil,
+				err:  httperrors.NewInternalServerError(fmt.Errorf("movieSearcher.SearchMovies: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			c.input.mockMovieSearcher(mockMovieSearcher)
+			mockMovieTranslationRepository := mock_repository.NewMockMovieTranslationRepository(ctrl)
+			if c.input.mockMovieTranslationRepository != nil {
+				c.input.mockMovieTranslationRepository(mockMovieTranslationRepository)
+			}
+
+			cfg := config.Config{
+				Ranking:   config.RankingConfig{MinimumVotes: 10, RatingWeight: 1, FavoriteWeight: 0.5},
+				MovieList: config.MovieListConfig{DefaultPageSize: 20, MaxPageSize: 100},
+				Search:    config.SearchConfig{HighlightPreTag: "<em>", HighlightPostTag: "</em>", FragmentSize: 40},
+			}
+			u := usecase.NewMovieUsecase(cfg, logger.NewApiLogger(&cfg), nil, mockMovieSearcher, nil, mockMovieTranslationRepository,
+				nil, nil, nil)
+			res, err := u.SearchByKeyword(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.page, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestSearchByKeywordWithDefaultRanking() {
+	cases := []struct {
+		name     string
+		ranking  config.RankingConfig
+		expected repository.FindMoviesParams
+	}{
+		{
+			name:    "defaults_ranking_which_is_not_configured",
+			ranking: config.RankingConfig{},
+			expected: repository.FindMoviesParams{
+				Sort:           repository.MovieSort{Field: entity.MovieSortPopularity, Desc: true},
+				MinimumVotes:   10,
+				RatingWeight:   1,
+				FavoriteWeight: 0.5,
+				Page:           repository.PageParams{Limit: 20},
+			},
+		},
+		{
+			name:    "defaults_minimum_votes_which_is_not_configured",
+			ranking: config.RankingConfig{RatingWeight: 2},
+			expected: repository.FindMoviesParams{
+				Sort:         repository.MovieSort{Field: entity.MovieSortPopularity, Desc: true},
+				MinimumVotes: 10,
+				RatingWeight: 2,
+				Page:         repository.PageParams{Limit: 20},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			mockMovieSearcher.EXPECT().SearchMovies(gomock.Any(), c.expected).Return(&repository.MoviePage{}, nil)
+
+			cfg := config.Config{Ranking: c.ranking}
+			u := usecase.NewMovieUsecase(cfg, logger.NewApiLogger(&cfg), nil, mockMovieSearcher, nil, nil, nil, nil, nil)
+			_, err := u.SearchByKeyword(context.Background(), usecase.SearchByKeywordParams{})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestAddFavoriteMovie() {
+	type testInput struct {
+		args                   repository.AddFavoriteMovieParams
+		mockMovieRepository    func(*mock_repository.MockMovieRepository)
+		mockFavoriteRepository func(*mock_repository.MockFavoriteRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_add_favorite_movie_successfully",
+			input: testInput{
+				args: repository.AddFavoriteMovieParams{
+					UserID:  1,
+					MovieID: 10,
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(
+						&entity.Movie{
+							ID:               10,
+							OriginalTitle:    "accumsan tested, facilisis vitae,",
+							OriginalLanguage: "Nigeria",
+							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
+							Adult:            false,
+							ReleaseDate:      datePtr("2022-08-20"),
+							Revenue:          utils.Int64Ptr(1000000),
+							Budget:           utils.Uint64Ptr(100000),
+							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+							UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						}, nil,
+					)
+				},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().CheckIsFavoriteMovie(gomock.Any(), repository.CheckIsFavoriteMovieParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(false, nil)
+					r.EXPECT().AddFavoriteMovie(gomock.Any(), repository.AddFavoriteMovieParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(nil)
+				},
+			},
+			expected: testOutput{
+				err: nil,
+			},
+		},
+		{
+			name: "returns_error_of_FindByID_when_FindByID_returns_error",
+			input: testInput{
+				args: repository.AddFavoriteMovieParams{
+					UserID:  1,
+					MovieID: 10,
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, fmt.Errorf("dummy error"))
+				},
+				mockFavoriteRepository: func(mfr *mock_repository.MockFavoriteRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_movie",
+			input: testInput{
+				args: repository.AddFavoriteMovieParams{
+					UserID:  1,
+					MovieID: 10,
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, nil)
+				},
+				mockFavoriteRepository: func(mfr *mock_repository.MockFavoriteRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_CheckIsFavoriteMovie_when_CheckIsFavoriteMovie_returns_error",
+			input: testInput{
+				args: repository.AddFavoriteMovieParams{
+					UserID:  1,
+					MovieID: 10,
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
 					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(
 						&entity.Movie{
 							ID:               10,
@@ -412,7 +1171,7 @@ func (s *testMovieUsecase) TestAddFavoriteMovie() {
 							OriginalLanguage: "Nigeria",
 							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 							Adult:            false,
-							ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+							ReleaseDate:      datePtr("2022-08-20"),
 							Revenue:          utils.Int64Ptr(1000000),
 							Budget:           utils.Uint64Ptr(100000),
 							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -446,7 +1205,7 @@ func (s *testMovieUsecase) TestAddFavoriteMovie() {
 							OriginalLanguage: "Nigeria",
 							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 							Adult:            false,
-							ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+							ReleaseDate:      datePtr("2022-08-20"),
 							Revenue:          utils.Int64Ptr(1000000),
 							Budget:           utils.Uint64Ptr(100000),
 							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -480,7 +1239,7 @@ func (s *testMovieUsecase) TestAddFavoriteMovie() {
 							OriginalLanguage: "Nigeria",
 							Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 							Adult:            false,
-							ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+							ReleaseDate:      datePtr("2022-08-20"),
 							Revenue:          utils.Int64Ptr(1000000),
 							Budget:           utils.Uint64Ptr(100000),
 							CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -516,7 +1275,8 @@ func (s *testMovieUsecase) TestAddFavoriteMovie() {
 			c.input.mockMovieRepository(mockMovieRepository)
 			c.input.mockFavoriteRepository(mockFavoriteRepository)
 
-			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockFavoriteRepository)
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil, mockFavoriteRepository, nil, nil,
+				nil, nil)
 			err := u.AddFavoriteMovie(context.Background(), usecase.AddFavoriteMovieParams(c.input.args))
 			assert.Equal(t, c.expected.err, err)
 		})
@@ -525,15 +1285,18 @@ func (s *testMovieUsecase) TestAddFavoriteMovie() {
 
 func (s *testMovieUsecase) TestListFavoriteMoviesByUserID() {
 	type testInput struct {
-		userID                 uint64
+		args                   usecase.ListFavoriteMoviesByUserIDParams
+		movieList              *config.MovieListConfig
 		mockFavoriteRepository func(*mock_repository.MockFavoriteRepository)
 	}
 
 	type testOutput struct {
-		err    error
-		movies []*entity.Movie
+		err  error
+		page *entity.MoviePage
 	}
 
+	testPage := repository.PageParams{Limit: 20}
+
 	cases := []struct {
 		name     string
 		input    testInput
@@ -542,18 +1305,18 @@ func (s *testMovieUsecase) TestListFavoriteMoviesByUserID() {
 		{
 			name: "returns_favorite_movies_of_user",
 			input: testInput{
-				userID: 1,
+				args: usecase.ListFavoriteMoviesByUserIDParams{UserID: 1},
 				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
-					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), uint64(1)).
+					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), repository.FindFavoriteMoviesByUserIDParams{UserID: 1, Page: testPage}).
 						Return(
-							[]*entity.Movie{
+							&repository.MoviePage{Movies: []*entity.Movie{
 								{
 									ID:               1,
 									OriginalTitle:    "accumsan sed, facilisis vitae,",
 									OriginalLanguage: "Nigeria",
 									Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 									Adult:            false,
-									ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+									ReleaseDate:      datePtr("2022-08-20"),
 									Revenue:          utils.Int64Ptr(1000000),
 									Budget:           utils.Uint64Ptr(100000),
 									CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -565,25 +1328,25 @@ func (s *testMovieUsecase) TestListFavoriteMoviesByUserID() {
 									OriginalLanguage: "Nigeria",
 									Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 									Adult:            false,
-									ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+									ReleaseDate:      datePtr("2022-08-20"),
 									Revenue:          utils.Int64Ptr(1000000),
 									Budget:           utils.Uint64Ptr(100000),
 									CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 									UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 								},
-							}, nil,
+							}}, nil,
 						)
 				},
 			},
 			expected: testOutput{
-				movies: []*entity.Movie{
+				page: &entity.MoviePage{Movies: []*entity.Movie{
 					{
 						ID:               1,
 						OriginalTitle:    "accumsan sed, facilisis vitae,",
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
@@ -595,27 +1358,75 @@ func (s *testMovieUsecase) TestListFavoriteMoviesByUserID() {
 						OriginalLanguage: "Nigeria",
 						Overview:         utils.StringPtr("risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras22"),
 						Adult:            false,
-						ReleaseDate:      utils.TimePtr(utils.MustRFC3339Time("2022-08-20T22:00:00+00:00")),
+						ReleaseDate:      datePtr("2022-08-20"),
 						Revenue:          utils.Int64Ptr(1000000),
 						Budget:           utils.Uint64Ptr(100000),
 						CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 						UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
 					},
-				},
+				}},
 				err: nil,
 			},
 		},
+		{
+			name: "passes_genres_to_FindFavoriteMoviesByUserID",
+			input: testInput{
+				args: usecase.ListFavoriteMoviesByUserIDParams{UserID: 1, Genres: []string{"horror"}},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), repository.FindFavoriteMoviesByUserIDParams{
+						UserID: 1,
+						Genres: []string{"horror"},
+						Page:   testPage,
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1}}},
+			},
+		},
+		{
+			name: "uses_default_page_sizes_when_they_are_not_configured",
+			input: testInput{
+				args:      usecase.ListFavoriteMoviesByUserIDParams{UserID: 1, Page: usecase.PageParams{Limit: 1000}},
+				movieList: &config.MovieListConfig{},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), repository.FindFavoriteMoviesByUserIDParams{
+						UserID: 1,
+						Page:   repository.PageParams{Limit: 100},
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1}}},
+			},
+		},
+		{
+			name: "uses_default_page_size_when_limit_and_page_sizes_are_not_configured",
+			input: testInput{
+				args:      usecase.ListFavoriteMoviesByUserIDParams{UserID: 1},
+				movieList: &config.MovieListConfig{},
+				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
+					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), repository.FindFavoriteMoviesByUserIDParams{
+						UserID: 1,
+						Page:   testPage,
+					}).Return(&repository.MoviePage{Movies: []*entity.Movie{{ID: 1}}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.MoviePage{Movies: []*entity.Movie{{ID: 1}}},
+			},
+		},
 		{
 			name: "returns_error_of_FindFavoriteMoviesByUserID_when_it_happended",
 			input: testInput{
-				userID: 1,
+				args: usecase.ListFavoriteMoviesByUserIDParams{UserID: 1},
 				mockFavoriteRepository: func(r *mock_repository.MockFavoriteRepository) {
-					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+					r.EXPECT().FindFavoriteMoviesByUserID(gomock.Any(), repository.FindFavoriteMoviesByUserIDParams{UserID: 1, Page: testPage}).Return(nil, fmt.Errorf("dummy error"))
 				},
 			},
 			expected: testOutput{
-				movies: nil,
-				err:    httperrors.NewInternalServerError(fmt.Errorf("favoriteRepository.FindFavoriteMoviesByUserID: %w", fmt.Errorf("dummy error"))),
+				page: nil,
+				err:  httperrors.NewInternalServerError(fmt.Errorf("favoriteRepository.FindFavoriteMoviesByUserID: %w", fmt.Errorf("dummy error"))),
 			},
 		},
 	}
@@ -628,10 +1439,830 @@ func (s *testMovieUsecase) TestListFavoriteMoviesByUserID() {
 			mockFavoriteRepository := mock_repository.NewMockFavoriteRepository(ctrl)
 			c.input.mockFavoriteRepository(mockFavoriteRepository)
 
-			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockFavoriteRepository)
-			res, err := u.ListFavoriteMoviesByUserID(context.Background(), c.input.userID)
+			cfg := config.Config{MovieList: config.MovieListConfig{DefaultPageSize: 20, MaxPageSize: 100}}
+			if c.input.movieList != nil {
+				cfg.MovieList = *c.input.movieList
+			}
+
+			u := usecase.NewMovieUsecase(cfg, logger.NewApiLogger(&cfg), nil, nil, mockFavoriteRepository, nil, nil, nil, nil)
+			res, err := u.ListFavoriteMoviesByUserID(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.page, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestCreateMovie() {
+	type testInput struct {
+		args                usecase.MovieParams
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	args := usecase.MovieParams{OriginalTitle: "accumsan sed, facilisis vitae,", OriginalLanguage: "Nigeria"}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_movie",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().CreateMovie(gomock.Any(), repository.MovieParams{
+						OriginalTitle:    "accumsan sed, facilisis vitae,",
+						OriginalLanguage: "Nigeria",
+					}).Return(dummyMovie(1), nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{
+				movie: dummyMovie(1),
+			},
+		},
+		{
+			name: "returns_created_movie_when_index_is_not_updated",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().CreateMovie(gomock.Any(), gomock.Any()).Return(dummyMovie(1), nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				movie: dummyMovie(1),
+			},
+		},
+		{
+			name: "truncates_release_date_to_its_precision",
+			input: testInput{
+				args: usecase.MovieParams{
+					OriginalTitle:        "accumsan sed, facilisis vitae,",
+					OriginalLanguage:     "Nigeria",
+					ReleaseDate:          datePtr("1927-05-20"),
+					ReleaseDatePrecision: utils.StringPtr("month"),
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().CreateMovie(gomock.Any(), repository.MovieParams{
+						OriginalTitle:        "accumsan sed, facilisis vitae,",
+						OriginalLanguage:     "Nigeria",
+						ReleaseDate:          datePtr("1927-05-01"),
+						ReleaseDatePrecision: utils.StringPtr("month"),
+					}).Return(dummyMovie(1), nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{
+				movie: dummyMovie(1),
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_release_date_has_no_precision",
+			input: testInput{
+				args: usecase.MovieParams{
+					OriginalTitle:    "accumsan sed, facilisis vitae,",
+					OriginalLanguage: "Nigeria",
+					ReleaseDate:      datePtr("1927-05-20"),
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "release_date_precision is required with release_date",
+					nil),
+			},
+		},
+		{
+			name: "returns_badrequest_error_when_budget_has_no_currency",
+			input: testInput{
+				args: usecase.MovieParams{
+					OriginalTitle:    "accumsan sed, facilisis vitae,",
+					OriginalLanguage: "Nigeria",
+					Budget:           utils.Uint64Ptr(603608000),
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "currency is required with budget or revenue", nil),
+			},
+		},
+		{
+			name: "returns_error_of_CreateMovie",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().CreateMovie(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("movieRepository.CreateMovie: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+
+			cfg := config.Config{Logger: config.Logger{Level: "fatal"}}
+			appLogger := logger.NewApiLogger(&cfg)
+			appLogger.InitLogger()
+
+			u := usecase.NewMovieUsecase(cfg, appLogger, mockMovieRepository, mockMovieSearcher, nil, nil, nil, nil, nil)
+			res, err := u.CreateMovie(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.movie, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestUpdateMovie() {
+	type testInput struct {
+		args                usecase.UpdateMovieParams
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "replaces_every_field_of_movie",
+			input: testInput{
+				args: usecase.UpdateMovieParams{
+					MovieID:     1,
+					MovieParams: usecase.MovieParams{OriginalTitle: "sed", OriginalLanguage: "Vietnam"},
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					updated := dummyMovie(1)
+					updated.OriginalTitle = "sed"
+					updated.OriginalLanguage = "Vietnam"
+
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil),
+						r.EXPECT().UpdateMovie(gomock.Any(), repository.UpdateMovieParams{
+							ID:          1,
+							MovieParams: repository.MovieParams{OriginalTitle: "sed", OriginalLanguage: "Vietnam"},
+						}).Return(updated, nil),
+					)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:               1,
+					OriginalTitle:    "sed",
+					OriginalLanguage: "Vietnam",
+					CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+				},
+			},
+		},
+		{
+			name: "returns_notfound_error_when_movie_is_not_found",
+			input: testInput{
+				args: usecase.UpdateMovieParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_UpdateMovie",
+			input: testInput{
+				args: usecase.UpdateMovieParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+					r.EXPECT().UpdateMovie(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("movieRepository.UpdateMovie: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+		{
+			name: "returns_notfound_error_when_movie_is_deleted_before_update",
+			input: testInput{
+				args: usecase.UpdateMovieParams{MovieID: 1},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+					r.EXPECT().UpdateMovie(gomock.Any(), gomock.Any()).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.UpdateMovie: movie 1 not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockMovieSearcher, nil, nil, nil,
+				nil, nil)
+			res, err := u.UpdateMovie(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.movie, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestPatchMovie() {
+	type testInput struct {
+		args                usecase.PatchMovieParams
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	current := &entity.Movie{
+		ID:               1,
+		OriginalTitle:    "accumsan sed, facilisis vitae,",
+		OriginalLanguage: "Nigeria",
+		Overview:         utils.StringPtr("risus. Donec nibh enim"),
+		Adult:            true,
+		Budget:           utils.Uint64Ptr(100000),
+		Currency:         utils.StringPtr("USD"),
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "changes_only_given_fields",
+			input: testInput{
+				args: usecase.PatchMovieParams{
+					MovieID:       1,
+					OriginalTitle: utils.StringPtr("sed"),
+					Adult:         utils.BoolPtr(false),
+					Revenue:       utils.Int64Ptr(5000),
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(current, nil),
+						r.EXPECT().UpdateMovie(gomock.Any(), repository.UpdateMovieParams{
+							ID: 1,
+							MovieParams: repository.MovieParams{
+								OriginalTitle:    "sed",
+								OriginalLanguage: "Nigeria",
+								Overview:         utils.StringPtr("risus. Donec nibh enim"),
+								Adult:            false,
+								Budget:           utils.Uint64Ptr(100000),
+								Revenue:          utils.Int64Ptr(5000),
+								Currency:         utils.StringPtr("USD"),
+							},
+						}).Return(current, nil),
+					)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "changes_precision_of_current_release_date",
+			input: testInput{
+				args: usecase.PatchMovieParams{
+					MovieID:              1,
+					ReleaseDatePrecision: utils.StringPtr("year"),
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					dated := *current
+					dated.ReleaseDate = datePtr("1927-05-20")
+					dated.ReleaseDatePrecision = utils.StringPtr("day")
+
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&dated, nil),
+						r.EXPECT().UpdateMovie(gomock.Any(), repository.UpdateMovieParams{
+							ID: 1,
+							MovieParams: repository.MovieParams{
+								OriginalTitle:        "accumsan sed, facilisis vitae,",
+								OriginalLanguage:     "Nigeria",
+								Overview:             utils.StringPtr("risus. Donec nibh enim"),
+								Adult:                true,
+								ReleaseDate:          datePtr("1927-01-01"),
+								ReleaseDatePrecision: utils.StringPtr("year"),
+								Budget:               utils.Uint64Ptr(100000),
+								Currency:             utils.StringPtr("USD"),
+							},
+						}).Return(&dated, nil),
+					)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_notfound_error_when_movie_is_not_found",
+			input: testInput{
+				args: usecase.PatchMovieParams{MovieID: 1, OriginalTitle: utils.StringPtr("sed")},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockMovieSearcher, nil, nil, nil,
+				nil, nil)
+			_, err := u.PatchMovie(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestDeleteMovie() {
+	type testInput struct {
+		movieID             uint64
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "deletes_movie",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+					r.EXPECT().DeleteMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().RemoveMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_notfound_error_when_movie_is_not_found",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_DeleteMovie",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+					r.EXPECT().DeleteMovie(gomock.Any(), uint64(1)).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("movieRepository.DeleteMovie: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockMovieSearcher, nil, nil, nil,
+				nil, nil)
+			err := u.DeleteMovie(context.Background(), c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestRestoreMovie() {
+	type testInput struct {
+		movieID             uint64
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_restored_movie",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().RestoreMovie(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(1)).Return(nil)
+				},
+			},
+			expected: testOutput{
+				movie: dummyMovie(1),
+			},
+		},
+		{
+			name: "returns_notfound_error_when_movie_is_not_deleted",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().RestoreMovie(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.RestoreMovie: deleted movie %d not found", 1)),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockMovieSearcher, nil, nil, nil,
+				nil, nil)
+			res, err := u.RestoreMovie(context.Background(), c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.movie, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestGetMovieByExternalID() {
+	type testInput struct {
+		args                      usecase.GetMovieByExternalIDParams
+		mockMovieRepository       func(*mock_repository.MockMovieRepository)
+		mockCollectionRepository  func(*mock_repository.MockCollectionRepository)
+		mockReleaseDateRepository func(*mock_repository.MockReleaseDateRepository)
+		mockExternalIDRepository  func(*mock_repository.MockExternalIDRepository)
+	}
+
+	type testOutput struct {
+		movie *entity.Movie
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_movie_of_external_id",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: entity.ExternalSourceIMDb, ExternalID: "tt0111161"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockCollectionRepository: func(r *mock_repository.MockCollectionRepository) {
+					r.EXPECT().FindSummaryByMovieID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockReleaseDateRepository: func(r *mock_repository.MockReleaseDateRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return([]*entity.ReleaseDate{}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), repository.FindMovieIDByExternalIDParams{
+						Source:     entity.ExternalSourceIMDb,
+						ExternalID: "tt0111161",
+					}).Return(uint64(1), nil)
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{
+						entity.ExternalSourceIMDb: "tt0111161",
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				movie: &entity.Movie{
+					ID:           1,
+					ReleaseDates: []*entity.ReleaseDate{},
+					ExternalIDs:  entity.ExternalIDs{entity.ExternalSourceIMDb: "tt0111161"},
+				},
+			},
+		},
+		{
+			name: "returns_bad_request_when_external_id_is_not_valid",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: entity.ExternalSourceIMDb, ExternalID: "0111161"},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("%q is not a valid %s id", "0111161", "imdb")),
+			},
+		},
+		{
+			name: "returns_bad_request_when_source_is_unknown",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: "letterboxd", ExternalID: "tt0111161"},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("unknown external id source %q", "letterboxd")),
+			},
+		},
+		{
+			name: "returns_not_found_when_no_movie_has_external_id",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: entity.ExternalSourceWikidata, ExternalID: "Q172241"},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(0), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("externalIDRepository.FindMovieIDByExternalID: not found")),
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_of_external_id_is_deleted",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindMovieIDByExternalID_when_error_happended",
+			input: testInput{
+				args: usecase.GetMovieByExternalIDParams{Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(0), fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.FindMovieIDByExternalID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			if c.input.mockMovieRepository != nil {
+				c.input.mockMovieRepository(mockMovieRepository)
+			}
+
+			mockCollectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			if c.input.mockCollectionRepository != nil {
+				c.input.mockCollectionRepository(mockCollectionRepository)
+			}
+
+			mockReleaseDateRepository := mock_repository.NewMockReleaseDateRepository(ctrl)
+			if c.input.mockReleaseDateRepository != nil {
+				c.input.mockReleaseDateRepository(mockReleaseDateRepository)
+			}
+
+			mockExternalIDRepository := mock_repository.NewMockExternalIDRepository(ctrl)
+			if c.input.mockExternalIDRepository != nil {
+				c.input.mockExternalIDRepository(mockExternalIDRepository)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil, nil,
+				nil, mockCollectionRepository, mockReleaseDateRepository, mockExternalIDRepository)
+			res, err := u.GetMovieByExternalID(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.movie, res)
+		})
+	}
+}
+
+func (s *testMovieUsecase) TestSetMovieExternalID() {
+	type testInput struct {
+		args                     usecase.SetMovieExternalIDParams
+		mockMovieRepository      func(*mock_repository.MockMovieRepository)
+		mockExternalIDRepository func(*mock_repository.MockExternalIDRepository)
+	}
+
+	type testOutput struct {
+		externalIDs entity.ExternalIDs
+		err         error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "sets_external_id_and_returns_external_ids_of_movie",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceIMDb, ExternalID: "tt0111161"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), repository.FindMovieIDByExternalIDParams{
+						Source:     entity.ExternalSourceIMDb,
+						ExternalID: "tt0111161",
+					}).Return(uint64(0), nil)
+					r.EXPECT().SetExternalID(gomock.Any(), repository.SetExternalIDParams{
+						MovieID:    1,
+						Source:     entity.ExternalSourceIMDb,
+						ExternalID: "tt0111161",
+					}).Return(nil)
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{
+						entity.ExternalSourceIMDb: "tt0111161",
+						entity.ExternalSourceTMDB: "278",
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				externalIDs: entity.ExternalIDs{
+					entity.ExternalSourceIMDb: "tt0111161",
+					entity.ExternalSourceTMDB: "278",
+				},
+			},
+		},
+		{
+			name: "does_not_set_external_id_which_movie_already_has",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+					r.EXPECT().FindByMovieID(gomock.Any(), uint64(1)).Return(entity.ExternalIDs{
+						entity.ExternalSourceTMDB: "278",
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				externalIDs: entity.ExternalIDs{entity.ExternalSourceTMDB: "278"},
+			},
+		},
+		{
+			name: "returns_conflict_when_external_id_identifies_another_movie",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(2), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusConflict, "tmdb id 278 already identifies movie 2", nil),
+			},
+		},
+		{
+			name: "returns_bad_request_when_external_id_is_not_valid",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceWikidata, ExternalID: "172241"},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("%q is not a valid %s id", "172241", "wikidata")),
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_does_not_exist",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_SetExternalID_when_error_happended",
+			input: testInput{
+				args: usecase.SetMovieExternalIDParams{MovieID: 1, Source: entity.ExternalSourceTMDB, ExternalID: "278"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(&entity.Movie{ID: 1}, nil)
+				},
+				mockExternalIDRepository: func(r *mock_repository.MockExternalIDRepository) {
+					r.EXPECT().FindMovieIDByExternalID(gomock.Any(), gomock.Any()).Return(uint64(0), nil)
+					r.EXPECT().SetExternalID(gomock.Any(), gomock.Any()).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("externalIDRepository.SetExternalID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			if c.input.mockMovieRepository != nil {
+				c.input.mockMovieRepository(mockMovieRepository)
+			}
+
+			mockExternalIDRepository := mock_repository.NewMockExternalIDRepository(ctrl)
+			if c.input.mockExternalIDRepository != nil {
+				c.input.mockExternalIDRepository(mockExternalIDRepository)
+			}
+
+			u := usecase.NewMovieUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil, nil,
+				nil, nil, nil, mockExternalIDRepository)
+			res, err := u.SetMovieExternalID(context.Background(), c.input.args)
 			assert.Equal(t, c.expected.err, err)
-			assert.Equal(t, c.expected.movies, res)
+			assert.Equal(t, c.expected.externalIDs, res)
 		})
 	}
 }
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type ratingUsecase struct {
+	cfg              config.Config
+	movieRepository  repository.MovieRepository
+	ratingRepository repository.RatingRepository
+	logger           logger.Logger
+}
+
+func NewRatingUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	ratingRepository repository.RatingRepository) *ratingUsecase {
+	return &ratingUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, ratingRepository: ratingRepository}
+}
+
+type RateMovieParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+	Score   uint8  `json:"score"`
+}
+
+func (u *ratingUsecase) RateMovie(ctx context.Context, args RateMovieParams) (*entity.Rating, error) {
+	if args.Score < entity.MinRatingScore || args.Score > entity.MaxRatingScore {
+		return nil, httperrors.NewBadRequestError(fmt.Errorf("score must be between %d and %d",
+			entity.MinRatingScore, entity.MaxRatingScore))
+	}
+
+	movie, err := u.movieRepository.FindByID(ctx, args.MovieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	if err := u.ratingRepository.UpsertRating(ctx, repository.UpsertRatingParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+		Score:   args.Score,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("ratingRepository.UpsertRating: %w", err))
+	}
+
+	return u.GetRating(ctx, GetRatingParams{UserID: args.UserID, MovieID: args.MovieID})
+}
+
+type GetRatingParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+}
+
+func (u *ratingUsecase) GetRating(ctx context.Context, args GetRatingParams) (*entity.Rating, error) {
+	rating, err := u.ratingRepository.FindByUserIDAndMovieID(ctx, repository.FindRatingByUserIDAndMovieIDParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("ratingRepository.FindByUserIDAndMovieID: %w", err))
+	}
+
+	if rating == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("ratingRepository.FindByUserIDAndMovieID: not found"))
+	}
+
+	return rating, nil
+}
+
+type DeleteRatingParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+}
+
+func (u *ratingUsecase) DeleteRating(ctx context.Context, args DeleteRatingParams) error {
+	if _, err := u.GetRating(ctx, GetRatingParams(args)); err != nil {
+		return err
+	}
+
+	if err := u.ratingRepository.DeleteRating(ctx, repository.DeleteRatingParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+	}); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("ratingRepository.DeleteRating: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRatingUsecase struct {
+	suite.Suite
+}
+
+func TestRatingUsecasesuite(t *testing.T) {
+	suite.Run(t, &testRatingUsecase{})
+}
+
+func dummyRating(score uint8) *entity.Rating {
+	return &entity.Rating{
+		UserID:    1,
+		MovieID:   10,
+		Score:     score,
+		CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testRatingUsecase) TestRateMovie() {
+	type testInput struct {
+		args                 usecase.RateMovieParams
+		mockMovieRepository  func(*mock_repository.MockMovieRepository)
+		mockRatingRepository func(*mock_repository.MockRatingRepository)
+	}
+
+	type testOutput struct {
+		rating *entity.Rating
+		err    error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_rating_when_rate_movie_successfully",
+			input: testInput{
+				args: usecase.RateMovieParams{UserID: 1, MovieID: 10, Score: 8},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {
+					r.EXPECT().UpsertRating(gomock.Any(), repository.UpsertRatingParams{
+						UserID:  1,
+						MovieID: 10,
+						Score:   8,
+					}).Return(nil)
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), repository.FindRatingByUserIDAndMovieIDParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(dummyRating(8), nil)
+				},
+			},
+			expected: testOutput{
+				rating: dummyRating(8),
+			},
+		},
+		{
+			name: "returns_error_when_score_is_out_of_range",
+			input: testInput{
+				args:                 usecase.RateMovieParams{UserID: 1, MovieID: 10, Score: 11},
+				mockMovieRepository:  func(r *mock_repository.MockMovieRepository) {},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("score must be between %d and %d", 1, 10)),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_movie",
+			input: testInput{
+				args: usecase.RateMovieParams{UserID: 1, MovieID: 10, Score: 8},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, nil)
+				},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_UpsertRating",
+			input: testInput{
+				args: usecase.RateMovieParams{UserID: 1, MovieID: 10, Score: 8},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {
+					r.EXPECT().UpsertRating(gomock.Any(), gomock.Any()).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("ratingRepository.UpsertRating: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			mockRatingRepository := mock_repository.NewMockRatingRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			c.input.mockRatingRepository(mockRatingRepository)
+
+			u := usecase.NewRatingUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, mockRatingRepository)
+			res, err := u.RateMovie(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.rating, res)
+		})
+	}
+}
+
+func (s *testRatingUsecase) TestDeleteRating() {
+	type testInput struct {
+		args                 usecase.DeleteRatingParams
+		mockRatingRepository func(*mock_repository.MockRatingRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_delete_rating_successfully",
+			input: testInput{
+				args: usecase.DeleteRatingParams{UserID: 1, MovieID: 10},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), repository.FindRatingByUserIDAndMovieIDParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(dummyRating(8), nil)
+					r.EXPECT().DeleteRating(gomock.Any(), repository.DeleteRatingParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_movie_is_not_rated",
+			input: testInput{
+				args: usecase.DeleteRatingParams{UserID: 1, MovieID: 10},
+				mockRatingRepository: func(r *mock_repository.MockRatingRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("ratingRepository.FindByUserIDAndMovieID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRatingRepository := mock_repository.NewMockRatingRepository(ctrl)
+			c.input.mockRatingRepository(mockRatingRepository)
+
+			u := usecase.NewRatingUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockRatingRepository)
+			err := u.DeleteRating(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source box_office.go -destination ../testdata/mock_repository/box_office_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type BoxOfficeRepository interface {
+	// FindGrossesByMovieID returns the weekly grosses of the movie ordered by territory and week
+	FindGrossesByMovieID(ctx context.Context, movieID uint64) ([]*entity.BoxOfficeGross, error)
+}
@@ -0,0 +1,38 @@
+//go:generate mockgen -source collection.go -destination ../testdata/mock_repository/collection_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// FindMoviesByCollectionIDParams finds at most Limit movies of the collection after After, the first page has no After
+type FindMoviesByCollectionIDParams struct {
+	CollectionID uint64                 `json:"collection_id"`
+	Limit        uint                   `json:"limit"`
+	After        *CollectionMovieCursor `json:"after"`
+}
+
+// CollectionMovieCursor is the position of the last movie of a page of a collection, the positions of the movies
+// of a collection are unique
+type CollectionMovieCursor struct {
+	Position uint `json:"position"`
+}
+
+// CollectionMoviePage is a page of the movies of a collection, Next is the cursor of the next page and is nil on
+// the last page
+type CollectionMoviePage struct {
+	Movies []*entity.CollectionMovie `json:"movies"`
+	Next   *CollectionMovieCursor    `json:"next"`
+}
+
+type CollectionRepository interface {
+	// FindByID returns the collection without its movies
+	FindByID(ctx context.Context, collectionID uint64) (*entity.Collection, error)
+	// FindMoviesByCollectionID returns the movies of the collection which are not deleted ordered by position
+	FindMoviesByCollectionID(ctx context.Context, args FindMoviesByCollectionIDParams) (*CollectionMoviePage, error)
+	// FindSummaryByMovieID returns the collection which the movie belongs to, it returns nil when the movie
+	// belongs to none
+	FindSummaryByMovieID(ctx context.Context, movieID uint64) (*entity.CollectionSummary, error)
+}
@@ -0,0 +1,53 @@
+//go:generate mockgen -source comment.go -destination ../testdata/mock_repository/comment_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type CreateCommentParams struct {
+	ReviewID uint64  `json:"review_id"`
+	UserID   uint64  `json:"user_id"`
+	ParentID *uint64 `json:"parent_id"`
+	Depth    uint8   `json:"depth"`
+	Content  string  `json:"content"`
+	// ModerationStatus is pending when the content filter sends the comment to moderation
+	ModerationStatus entity.ModerationStatus `json:"moderation_status"`
+}
+
+// FindCommentsParams finds at most Limit comments on the review when ParentID is nil and the replies
+// to the comment of ParentID otherwise, after After. The first page has no After
+type FindCommentsParams struct {
+	ReviewID uint64         `json:"review_id"`
+	ParentID *uint64        `json:"parent_id"`
+	Limit    uint           `json:"limit"`
+	After    *CommentCursor `json:"after"`
+}
+
+// CommentCursor is the position of the last comment of a page in a list ordered by id
+type CommentCursor struct {
+	ID uint64 `json:"id"`
+}
+
+// CommentPage is a page of a comment list, Next is the cursor of the next page and is nil on the last page
+type CommentPage struct {
+	Comments []*entity.Comment `json:"comments"`
+	Next     *CommentCursor    `json:"next"`
+}
+
+type UpdateCommentParams struct {
+	ID               uint64                  `json:"id"`
+	Content          string                  `json:"content"`
+	ModerationStatus entity.ModerationStatus `json:"moderation_status"`
+}
+
+type CommentRepository interface {
+	CreateComment(ctx context.Context, args CreateCommentParams) (*entity.Comment, error)
+	FindByID(ctx context.Context, commentID uint64) (*entity.Comment, error)
+	FindComments(ctx context.Context, args FindCommentsParams) (*CommentPage, error)
+	UpdateComment(ctx context.Context, args UpdateCommentParams) error
+	// DeleteComment clears the content of the comment and marks it deleted, its replies are kept
+	DeleteComment(ctx context.Context, commentID uint64) error
+}
@@ -0,0 +1,40 @@
+//go:generate mockgen -source credit.go -destination ../testdata/mock_repository/credit_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// FindFilmographyParams finds at most Limit credits of the person after After, the first page has no After
+type FindFilmographyParams struct {
+	PersonID uint64             `json:"person_id"`
+	Limit    uint               `json:"limit"`
+	After    *FilmographyCursor `json:"after"`
+}
+
+// FilmographyCursor is the position of the last credit of a page of a filmography, the credits are ordered by the
+// release date of their movie and its precision, then by MovieID, BillingOrder and ID. A known ReleaseDate has its
+// ReleaseDatePrecision
+type FilmographyCursor struct {
+	ID                   uint64       `json:"id"`
+	MovieID              uint64       `json:"movie_id"`
+	ReleaseDate          *entity.Date `json:"release_date,omitempty"`
+	ReleaseDatePrecision *string      `json:"release_date_precision,omitempty"`
+	BillingOrder         uint         `json:"billing_order"`
+}
+
+// FilmographyPage is a page of a filmography, Next is the cursor of the next page and is nil on the last page
+type FilmographyPage struct {
+	Credits []*entity.FilmographyCredit `json:"credits"`
+	Next    *FilmographyCursor          `json:"next"`
+}
+
+type CreditRepository interface {
+	// FindByMovieID returns the credits of the movie ordered by billing order
+	FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.Credit, error)
+	// FindFilmographyByPersonID returns the credits of the person in the movies which are not deleted, the
+	// latest released movies come first
+	FindFilmographyByPersonID(ctx context.Context, args FindFilmographyParams) (*FilmographyPage, error)
+}
@@ -0,0 +1,28 @@
+//go:generate mockgen -source external_id.go -destination ../testdata/mock_repository/external_id_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type FindMovieIDByExternalIDParams struct {
+	Source     string `json:"source"`
+	ExternalID string `json:"external_id"`
+}
+
+type SetExternalIDParams struct {
+	MovieID    uint64 `json:"movie_id"`
+	Source     string `json:"source"`
+	ExternalID string `json:"external_id"`
+}
+
+type ExternalIDRepository interface {
+	FindByMovieID(ctx context.Context, movieID uint64) (entity.ExternalIDs, error)
+	// FindMovieIDByExternalID returns the id of the movie which the external id identifies even when the movie is
+	// deleted, it returns 0 when no movie has the external id
+	FindMovieIDByExternalID(ctx context.Context, args FindMovieIDByExternalIDParams) (uint64, error)
+	// SetExternalID replaces the id of the movie for the source
+	SetExternalID(ctx context.Context, args SetExternalIDParams) error
+}
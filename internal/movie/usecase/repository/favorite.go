@@ -3,8 +3,6 @@ package repository
 
 import (
 	"context"
-
-	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 )
 
 type AddFavoriteMovieParams struct {
@@ -12,13 +10,30 @@ type AddFavoriteMovieParams struct {
 	MovieID uint64 `json:"email"`
 }
 
+// AddFavoriteCollectionParams adds every movie of the collection which is not deleted and is not favorite yet
+type AddFavoriteCollectionParams struct {
+	UserID       uint64 `json:"user_id"`
+	CollectionID uint64 `json:"collection_id"`
+}
+
 type CheckIsFavoriteMovieParams struct {
 	UserID  uint64 `json:"user_id"`
 	MovieID uint64 `json:"email"`
 }
 
+// FindFavoriteMoviesByUserIDParams lists the favorite movies of the user, only the movies of any of Genres
+// are listed when it is not empty. Only the movies matching Release are listed
+type FindFavoriteMoviesByUserIDParams struct {
+	UserID  uint64        `json:"user_id"`
+	Genres  []string      `json:"genres"`
+	Release ReleaseFilter `json:"release"`
+	Page    PageParams    `json:"page"`
+}
+
 type FavoriteRepository interface {
 	AddFavoriteMovie(ctx context.Context, args AddFavoriteMovieParams) error
+	// AddFavoriteCollection returns the number of the movies which are added
+	AddFavoriteCollection(ctx context.Context, args AddFavoriteCollectionParams) (int64, error)
 	CheckIsFavoriteMovie(ctx context.Context, args CheckIsFavoriteMovieParams) (bool, error)
-	FindFavoriteMoviesByUserID(ctx context.Context, userID uint64) ([]*entity.Movie, error)
+	FindFavoriteMoviesByUserID(ctx context.Context, args FindFavoriteMoviesByUserIDParams) (*MoviePage, error)
 }
@@ -0,0 +1,12 @@
+//go:generate mockgen -source genre.go -destination ../testdata/mock_repository/genre_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type GenreRepository interface {
+	FindAll(ctx context.Context) ([]*entity.Genre, error)
+}
@@ -0,0 +1,23 @@
+//go:generate mockgen -source moderation.go -destination ../testdata/mock_repository/moderation_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type ModerateParams struct {
+	ModeratorID uint64                      `json:"moderator_id"`
+	TargetType  entity.ReportTargetType     `json:"target_type"`
+	TargetID    uint64                      `json:"target_id"`
+	Action      entity.ModerationActionType `json:"action"`
+	Note        string                      `json:"note"`
+}
+
+type ModerationRepository interface {
+	// Moderate applies the action to the review or comment, resolves its open reports and records the
+	// action in one transaction. A deleted review is removed while a deleted comment keeps its place in
+	// the thread like the comments deleted by their authors
+	Moderate(ctx context.Context, args ModerateParams) (*entity.ModerationAction, error)
+}
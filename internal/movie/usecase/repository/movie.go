@@ -5,10 +5,116 @@ import (
 	"context"
 
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
 )
 
+// MovieCursor is the position of the last movie of a page in a list ordered by Sort and by id, Desc is the direction
+// of Sort and the value of Sort of the movie is in the field named after it, Score being the popularity or the
+// relevance score, and the release date is followed by its precision. A movie list is ordered by a unique key so that the next page starts right after the cursor even
+// when movies are inserted in between
+type MovieCursor struct {
+	ID                   uint64       `json:"id"`
+	Sort                 string       `json:"sort,omitempty"`
+	Desc                 bool         `json:"desc,omitempty"`
+	Score                *float64     `json:"score,omitempty"`
+	ReleaseDate          *entity.Date `json:"release_date,omitempty"`
+	ReleaseDatePrecision *string      `json:"release_date_precision,omitempty"`
+	Revenue              *int64       `json:"revenue,omitempty"`
+	Title                *string      `json:"title,omitempty"`
+}
+
+// PageParams lists at most Limit movies after After, the first page has no After
+type PageParams struct {
+	Limit uint         `json:"limit"`
+	After *MovieCursor `json:"after"`
+}
+
+// MoviePage is a page of a movie list, Next is the cursor of the next page and is nil on the last page
+type MoviePage struct {
+	Movies []*entity.Movie `json:"movies"`
+	Next   *MovieCursor    `json:"next"`
+}
+
+// MovieFilter matches the movies satisfying every given condition, the zero fields are not conditions.
+// ReleaseYearFrom and ReleaseYearTo are the first and the last year of the release date, the budget and the
+// revenue ranges are inclusive amounts in the minor unit of Currency and MinRating is the minimum average rating
+type MovieFilter struct {
+	OriginalLanguage string   `json:"original_language"`
+	Adult            *bool    `json:"adult"`
+	ReleaseYearFrom  *int     `json:"release_year_from"`
+	ReleaseYearTo    *int     `json:"release_year_to"`
+	Currency         string   `json:"currency"`
+	MinBudget        *uint64  `json:"min_budget"`
+	MaxBudget        *uint64  `json:"max_budget"`
+	MinRevenue       *int64   `json:"min_revenue"`
+	MaxRevenue       *int64   `json:"max_revenue"`
+	MinRating        *float64 `json:"min_rating"`
+}
+
+// MovieSort orders a movie list by Field, which is one of the entity.MovieSort constants, from the greatest value
+// when Desc is true. The movies of the same value are ordered by id and the movies whose value is not known are
+// put last. The zero MovieSort orders the movies by id only
+type MovieSort struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc"`
+}
+
+// MovieHit is a movie found by a search index with the relevance score which the index gave it
+type MovieHit struct {
+	MovieID uint64  `json:"movie_id"`
+	Score   float64 `json:"score"`
+}
+
+// FindMoviesParams lists the movies satisfying every term of Query, every movie is listed when it is nil. Hits
+// replace Query when they are not nil, only the movies of the hits are listed with the score of their hit as the
+// relevance score. Only the movies of any of Genres are returned when it is not empty and only the ones matching
+// Filter and Release are returned. MinimumVotes, RatingWeight and FavoriteWeight compute the popularity score when
+// the movies are ordered by it, the relevance requires Query or Hits and the cursor of the page must have the value
+// of Sort
+type FindMoviesParams struct {
+	Query          *searchquery.Query `json:"query"`
+	Hits           []MovieHit         `json:"hits"`
+	Filter         MovieFilter        `json:"filter"`
+	Genres         []string           `json:"genres"`
+	Release        ReleaseFilter      `json:"release"`
+	Sort           MovieSort          `json:"sort"`
+	MinimumVotes   uint64             `json:"minimum_votes"`
+	RatingWeight   float64            `json:"rating_weight"`
+	FavoriteWeight float64            `json:"favorite_weight"`
+	Page           PageParams         `json:"page"`
+}
+
+// MovieParams are the fields of a movie which are written by the admins
+type MovieParams struct {
+	OriginalTitle        string       `json:"original_title"`
+	OriginalLanguage     string       `json:"original_language"`
+	Overview             *string      `json:"overview"`
+	PosterPath           *string      `json:"poster_path"`
+	BackdropPath         *string      `json:"backdrop_path"`
+	Adult                bool         `json:"adult"`
+	ReleaseDate          *entity.Date `json:"release_date"`
+	ReleaseDatePrecision *string      `json:"release_date_precision"`
+	Budget               *uint64      `json:"budget"`
+	Revenue              *int64       `json:"revenue"`
+	Currency             *string      `json:"currency"`
+}
+
+type UpdateMovieParams struct {
+	ID uint64 `json:"id"`
+	MovieParams
+}
+
+// MovieRepository never returns the movies which are deleted except RestoreMovie
 type MovieRepository interface {
 	FindByID(ctx context.Context, movieID uint64) (*entity.Movie, error)
-	FindByKeyword(ctx context.Context, keyword string) ([]*entity.Movie, error)
-	FindPopularMovies(ctx context.Context, limit uint) ([]*entity.Movie, error)
+	FindMovies(ctx context.Context, args FindMoviesParams) (*MoviePage, error)
+	CreateMovie(ctx context.Context, args MovieParams) (*entity.Movie, error)
+	// UpdateMovie replaces the fields of the movie and returns it as written, it returns nil when there is no movie
+	// of the id
+	UpdateMovie(ctx context.Context, args UpdateMovieParams) (*entity.Movie, error)
+	// DeleteMovie soft deletes the movie by setting its deleted_at
+	DeleteMovie(ctx context.Context, movieID uint64) error
+	// RestoreMovie clears deleted_at of the deleted movie and returns it, it returns nil when there is no deleted
+	// movie of the id
+	RestoreMovie(ctx context.Context, movieID uint64) (*entity.Movie, error)
 }
@@ -0,0 +1,14 @@
+//go:generate mockgen -source movie_searcher.go -destination ../testdata/mock_repository/movie_searcher_gen.go
+package repository
+
+import "context"
+
+// MovieSearcher lists the movies like MovieRepository.FindMovies, a search may be run against an index of its own
+// which IndexMovie and RemoveMovie keep in sync with the catalog after a movie or what is searched with it is written
+type MovieSearcher interface {
+	SearchMovies(ctx context.Context, args FindMoviesParams) (*MoviePage, error)
+	// IndexMovie indexes the movie again with its translations, the people credited in it and its tags, a movie
+	// which is deleted is removed from the index
+	IndexMovie(ctx context.Context, movieID uint64) error
+	RemoveMovie(ctx context.Context, movieID uint64) error
+}
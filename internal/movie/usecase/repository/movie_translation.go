@@ -0,0 +1,13 @@
+//go:generate mockgen -source movie_translation.go -destination ../testdata/mock_repository/movie_translation_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type MovieTranslationRepository interface {
+	// FindByMovieIDs returns every translation of the movies ordered by movie and language
+	FindByMovieIDs(ctx context.Context, movieIDs []uint64) ([]*entity.MovieTranslation, error)
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source person.go -destination ../testdata/mock_repository/person_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type PersonRepository interface {
+	FindByID(ctx context.Context, personID uint64) (*entity.Person, error)
+}
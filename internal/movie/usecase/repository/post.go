@@ -0,0 +1,21 @@
+//go:generate mockgen -source post.go -destination ../testdata/mock_repository/post_gen.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type FindRecentPostsParams struct {
+	UserID uint64    `json:"user_id"`
+	Since  time.Time `json:"since"`
+	Limit  uint      `json:"limit"`
+}
+
+type PostRepository interface {
+	// FindRecentPosts lists the reviews and comments written by the user since the given time, the newest first.
+	// Deleted comments are left out
+	FindRecentPosts(ctx context.Context, args FindRecentPostsParams) ([]*entity.Post, error)
+}
@@ -0,0 +1,32 @@
+//go:generate mockgen -source rating.go -destination ../testdata/mock_repository/rating_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type FindRatingByUserIDAndMovieIDParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+}
+
+type UpsertRatingParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+	Score   uint8  `json:"score"`
+}
+
+type DeleteRatingParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+}
+
+type RatingRepository interface {
+	FindByUserIDAndMovieID(ctx context.Context, args FindRatingByUserIDAndMovieIDParams) (*entity.Rating, error)
+	// UpsertRating adds or changes the rating of user and keeps the rating aggregates of the movie up to date
+	UpsertRating(ctx context.Context, args UpsertRatingParams) error
+	// DeleteRating removes the rating of user and keeps the rating aggregates of the movie up to date
+	DeleteRating(ctx context.Context, args DeleteRatingParams) error
+}
@@ -0,0 +1,26 @@
+//go:generate mockgen -source release_date.go -destination ../testdata/mock_repository/release_date_gen.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// ReleaseFilter matches the movies which have a release satisfying every given condition, the zero fields are not
+// conditions. Country only narrows the other conditions to the releases in it, it does not filter the movies by
+// itself. ReleasedFrom is inclusive, ReleasedBefore is exclusive and MaxMinimumAge never matches the releases
+// which are not certified
+type ReleaseFilter struct {
+	Country        string     `json:"country"`
+	ReleaseType    string     `json:"release_type"`
+	ReleasedFrom   *time.Time `json:"released_from"`
+	ReleasedBefore *time.Time `json:"released_before"`
+	MaxMinimumAge  *uint8     `json:"max_minimum_age"`
+}
+
+type ReleaseDateRepository interface {
+	// FindByMovieID returns the releases of the movie ordered by country and release date
+	FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.ReleaseDate, error)
+}
@@ -0,0 +1,59 @@
+//go:generate mockgen -source report.go -destination ../testdata/mock_repository/report_gen.go
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type CreateReportParams struct {
+	UserID     uint64                  `json:"user_id"`
+	TargetType entity.ReportTargetType `json:"target_type"`
+	TargetID   uint64                  `json:"target_id"`
+	Reason     string                  `json:"reason"`
+}
+
+// CreateFilterReportParams reports the content which the content filters sent to moderation
+type CreateFilterReportParams struct {
+	TargetType entity.ReportTargetType `json:"target_type"`
+	TargetID   uint64                  `json:"target_id"`
+	Reason     string                  `json:"reason"`
+}
+
+type FindReportByUserIDAndTargetParams struct {
+	UserID     uint64                  `json:"user_id"`
+	TargetType entity.ReportTargetType `json:"target_type"`
+	TargetID   uint64                  `json:"target_id"`
+}
+
+// FindReportsParams finds at most Limit reports of Status after After, the first page has no After
+type FindReportsParams struct {
+	Status entity.ReportStatus `json:"status"`
+	Limit  uint                `json:"limit"`
+	After  *ReportCursor       `json:"after"`
+}
+
+// ReportCursor is the position of the last report of a page in the moderation queue which is ordered by id
+type ReportCursor struct {
+	ID uint64 `json:"id"`
+}
+
+// ReportPage is a page of the moderation queue, Next is the cursor of the next page and is nil on the last page
+type ReportPage struct {
+	Reports []*entity.Report `json:"reports"`
+	Next    *ReportCursor    `json:"next"`
+}
+
+// ErrReportExists is returned by CreateReport when the user has already reported the target
+var ErrReportExists = errors.New("user has already reported the target")
+
+type ReportRepository interface {
+	// CreateReport returns ErrReportExists when the user has already reported the target
+	CreateReport(ctx context.Context, args CreateReportParams) (*entity.Report, error)
+	CreateFilterReport(ctx context.Context, args CreateFilterReportParams) (*entity.Report, error)
+	FindByUserIDAndTarget(ctx context.Context, args FindReportByUserIDAndTargetParams) (*entity.Report, error)
+	// FindReports lists the reports of given status, the oldest first
+	FindReports(ctx context.Context, args FindReportsParams) (*ReportPage, error)
+}
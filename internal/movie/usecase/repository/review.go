@@ -0,0 +1,99 @@
+//go:generate mockgen -source review.go -destination ../testdata/mock_repository/review_gen.go
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type CreateReviewParams struct {
+	UserID    uint64 `json:"user_id"`
+	MovieID   uint64 `json:"movie_id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	IsSpoiler bool   `json:"is_spoiler"`
+	// ModerationStatus is pending when the content filter sends the review to moderation
+	ModerationStatus entity.ModerationStatus `json:"moderation_status"`
+}
+
+type FindReviewByUserIDAndMovieIDParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+}
+
+// ReviewSort is the order of the reviews returned by FindByMovieID
+type ReviewSort string
+
+const (
+	// ReviewSortHelpful orders the most helpful reviews first by the lower bound of the wilson score
+	// interval of their helpful votes
+	ReviewSortHelpful ReviewSort = "helpful"
+	// ReviewSortNewest orders the latest written reviews first
+	ReviewSortNewest ReviewSort = "newest"
+	// ReviewSortRating orders the reviews whose author rated the movie highest first
+	ReviewSortRating ReviewSort = "rating"
+)
+
+// ReviewAuthorType filters the reviews returned by FindByMovieID by their authors
+type ReviewAuthorType string
+
+const (
+	// ReviewAuthorAll returns the reviews of every author
+	ReviewAuthorAll ReviewAuthorType = ""
+	// ReviewAuthorCritic returns only the reviews written by the critics
+	ReviewAuthorCritic ReviewAuthorType = "critic"
+	// ReviewAuthorAudience returns only the reviews written by the users who are not critics
+	ReviewAuthorAudience ReviewAuthorType = "audience"
+)
+
+// FindReviewsByMovieIDParams lists at most Limit reviews of the movie after After, the first page has no After
+type FindReviewsByMovieIDParams struct {
+	MovieID    uint64           `json:"movie_id"`
+	Sort       ReviewSort       `json:"sort"`
+	AuthorType ReviewAuthorType `json:"author_type"`
+	Limit      uint             `json:"limit"`
+	After      *ReviewCursor    `json:"after"`
+}
+
+// ReviewCursor is the position of the last review of a page in a list ordered by Sort and by id, the value of Sort
+// of the review is Helpfulness, CreatedAt or AuthorRating which may be unknown
+type ReviewCursor struct {
+	ID           uint64     `json:"id"`
+	Sort         ReviewSort `json:"sort"`
+	Helpfulness  *float64   `json:"helpfulness,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	AuthorRating *uint8     `json:"author_rating,omitempty"`
+}
+
+// ReviewPage is a page of a review list, Next is the cursor of the next page and is nil on the last page
+type ReviewPage struct {
+	Reviews []*entity.Review `json:"reviews"`
+	Next    *ReviewCursor    `json:"next"`
+}
+
+// UpdateReviewParams edits the review, the current version of the review is kept as a revision
+type UpdateReviewParams struct {
+	ID               uint64                  `json:"id"`
+	Title            string                  `json:"title"`
+	Content          string                  `json:"content"`
+	IsSpoiler        bool                    `json:"is_spoiler"`
+	ModerationStatus entity.ModerationStatus `json:"moderation_status"`
+}
+
+// ErrReviewExists is returned by CreateReview when the user has already reviewed the movie
+var ErrReviewExists = errors.New("user has already reviewed the movie")
+
+type ReviewRepository interface {
+	// CreateReview returns ErrReviewExists when the user has already reviewed the movie
+	CreateReview(ctx context.Context, args CreateReviewParams) (*entity.Review, error)
+	FindByID(ctx context.Context, reviewID uint64) (*entity.Review, error)
+	FindByUserIDAndMovieID(ctx context.Context, args FindReviewByUserIDAndMovieIDParams) (*entity.Review, error)
+	FindByMovieID(ctx context.Context, args FindReviewsByMovieIDParams) (*ReviewPage, error)
+	UpdateReview(ctx context.Context, args UpdateReviewParams) error
+	// FindRevisions returns the previous versions of the review, the oldest first
+	FindRevisions(ctx context.Context, reviewID uint64) ([]*entity.ReviewRevision, error)
+	DeleteReview(ctx context.Context, reviewID uint64) error
+}
@@ -0,0 +1,33 @@
+//go:generate mockgen -source review_vote.go -destination ../testdata/mock_repository/review_vote_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type FindReviewVoteByUserIDAndReviewIDParams struct {
+	UserID   uint64 `json:"user_id"`
+	ReviewID uint64 `json:"review_id"`
+}
+
+type UpsertReviewVoteParams struct {
+	UserID   uint64 `json:"user_id"`
+	ReviewID uint64 `json:"review_id"`
+	Helpful  bool   `json:"helpful"`
+}
+
+type DeleteReviewVoteParams struct {
+	UserID   uint64 `json:"user_id"`
+	ReviewID uint64 `json:"review_id"`
+}
+
+type ReviewVoteRepository interface {
+	FindByUserIDAndReviewID(ctx context.Context, args FindReviewVoteByUserIDAndReviewIDParams) (*entity.ReviewVote, error)
+	// UpsertReviewVote votes the review or changes the previous vote of user, the helpful counts of the
+	// review are kept in sync
+	UpsertReviewVote(ctx context.Context, args UpsertReviewVoteParams) error
+	// DeleteReviewVote removes the vote of user and keeps the helpful counts of the review up to date
+	DeleteReviewVote(ctx context.Context, args DeleteReviewVoteParams) error
+}
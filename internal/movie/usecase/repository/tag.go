@@ -0,0 +1,55 @@
+//go:generate mockgen -source tag.go -destination ../testdata/mock_repository/tag_gen.go
+package repository
+
+import (
+	"context"
+
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+type AddMovieTagsParams struct {
+	UserID  uint64   `json:"user_id"`
+	MovieID uint64   `json:"movie_id"`
+	Slugs   []string `json:"slugs"`
+}
+
+type DeleteMovieTagParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+	Slug    string `json:"slug"`
+}
+
+// FindMoviesByTagIDParams finds at most Limit movies of the tag after After, the first page has no After
+type FindMoviesByTagIDParams struct {
+	TagID uint64             `json:"tag_id"`
+	Limit uint               `json:"limit"`
+	After *TaggedMovieCursor `json:"after"`
+}
+
+// TaggedMovieCursor is the position of the last movie of a page of a tag, the movies are ordered by UserCount
+// and then by ID
+type TaggedMovieCursor struct {
+	ID        uint64 `json:"id"`
+	UserCount uint   `json:"user_count"`
+}
+
+// TaggedMoviePage is a page of the movies of a tag, Next is the cursor of the next page and is nil on the last page
+type TaggedMoviePage struct {
+	Movies []*entity.TaggedMovie `json:"movies"`
+	Next   *TaggedMovieCursor    `json:"next"`
+}
+
+type TagRepository interface {
+	// FindBySlug returns the tag without its movies
+	FindBySlug(ctx context.Context, slug string) (*entity.Tag, error)
+	// FindMoviesByTagID returns the movies of the tag which are not deleted, the movies which most users
+	// applied the tag to come first
+	FindMoviesByTagID(ctx context.Context, args FindMoviesByTagIDParams) (*TaggedMoviePage, error)
+	// FindUsagesByMovieID returns the tags of the movie without weight, the most applied tags come first
+	FindUsagesByMovieID(ctx context.Context, movieID uint64) ([]*entity.TagUsage, error)
+	// AddMovieTags creates the tags which do not exist and applies them to the movie for the user, the tags
+	// which the user already applied are skipped
+	AddMovieTags(ctx context.Context, args AddMovieTagsParams) error
+	// DeleteMovieTag removes the tag which the user applied to the movie, it returns the number of the removed tags
+	DeleteMovieTag(ctx context.Context, args DeleteMovieTagParams) (int64, error)
+}
@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/spoiler"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/textdiff"
+)
+
+type reviewUsecase struct {
+	cfg              config.Config
+	movieRepository  repository.MovieRepository
+	reviewRepository repository.ReviewRepository
+	reportRepository repository.ReportRepository
+	contentFilter    contentfilter.ContentFilter
+	logger           logger.Logger
+}
+
+func NewReviewUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	reviewRepository repository.ReviewRepository, reportRepository repository.ReportRepository,
+	contentFilter contentfilter.ContentFilter) *reviewUsecase {
+	return &reviewUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, reviewRepository: reviewRepository,
+		reportRepository: reportRepository, contentFilter: contentFilter}
+}
+
+type CreateReviewParams struct {
+	UserID    uint64 `json:"user_id"`
+	MovieID   uint64 `json:"movie_id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	IsSpoiler bool   `json:"is_spoiler"`
+}
+
+func (u *reviewUsecase) CreateReview(ctx context.Context, args CreateReviewParams) (*entity.Review, error) {
+	movie, err := u.movieRepository.FindByID(ctx, args.MovieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	existsReview, err := u.reviewRepository.FindByUserIDAndMovieID(ctx, repository.FindReviewByUserIDAndMovieIDParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+	})
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByUserIDAndMovieID: %w", err))
+	}
+
+	if existsReview != nil {
+		return nil, httperrors.NewRestError(http.StatusBadRequest, "already reviewed", nil)
+	}
+
+	status, verdict, err := filterContent(ctx, u.contentFilter, contentfilter.Content{
+		TargetType: entity.ReportTargetReview,
+		UserID:     args.UserID,
+		Title:      args.Title,
+		Text:       args.Content,
+	}, entity.ModerationStatusVisible)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := u.reviewRepository.CreateReview(ctx, repository.CreateReviewParams{
+		UserID:           args.UserID,
+		MovieID:          args.MovieID,
+		Title:            args.Title,
+		Content:          args.Content,
+		IsSpoiler:        args.IsSpoiler,
+		ModerationStatus: status,
+	})
+	if err != nil {
+		// a concurrent request may create the review after it is checked above
+		if errors.Is(err, repository.ErrReviewExists) {
+			return nil, httperrors.NewRestError(http.StatusBadRequest, "already reviewed", nil)
+		}
+
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.CreateReview: %w", err))
+	}
+
+	if err := reportFilteredContent(ctx, u.reportRepository, entity.ReportTargetReview, review.ID, verdict); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+type GetReviewByIDParams struct {
+	ReviewID       uint64 `json:"review_id"`
+	RevealSpoilers bool   `json:"reveal_spoilers"`
+	Viewer         Viewer `json:"viewer"`
+}
+
+func (u *reviewUsecase) GetReviewByID(ctx context.Context, args GetReviewByIDParams) (*entity.Review, error) {
+	review, err := u.findReview(ctx, args.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.Viewer.canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	return maskSpoilers(review, args.RevealSpoilers), nil
+}
+
+func (u *reviewUsecase) findReview(ctx context.Context, reviewID uint64) (*entity.Review, error) {
+	review, err := u.reviewRepository.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByID: %w", err))
+	}
+
+	if review == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found"))
+	}
+
+	return review, nil
+}
+
+type ListReviewsByMovieIDParams struct {
+	MovieID uint64 `json:"movie_id"`
+	// Sort is one of helpful, newest and rating, the most helpful reviews are listed first when it is empty
+	Sort string `json:"sort"`
+	// AuthorType is critic or audience to list only the reviews of the critics or of the other users,
+	// every review is listed when it is empty
+	AuthorType     string `json:"author_type"`
+	RevealSpoilers bool   `json:"reveal_spoilers"`
+	// Page pages the reviews, its cursor must be the one of a list ordered by the same Sort
+	Page PageParams `json:"page"`
+}
+
+func (u *reviewUsecase) ListReviewsByMovieID(ctx context.Context, args ListReviewsByMovieIDParams) (*entity.ReviewPage, error) {
+	sort := repository.ReviewSort(args.Sort)
+	switch sort {
+	case "":
+		sort = repository.ReviewSortHelpful
+	case repository.ReviewSortHelpful, repository.ReviewSortNewest, repository.ReviewSortRating:
+	default:
+		return nil, httperrors.NewBadRequestError(fmt.Errorf("invalid sort: %s", args.Sort))
+	}
+
+	authorType := repository.ReviewAuthorType(args.AuthorType)
+	switch authorType {
+	case repository.ReviewAuthorAll, repository.ReviewAuthorCritic, repository.ReviewAuthorAudience:
+	default:
+		return nil, httperrors.NewBadRequestError(fmt.Errorf("invalid author type: %s", args.AuthorType))
+	}
+
+	params := repository.FindReviewsByMovieIDParams{
+		MovieID:    args.MovieID,
+		Sort:       sort,
+		AuthorType: authorType,
+		Limit:      pageSize(args.Page.Limit, u.cfg.Review.DefaultPageSize, u.cfg.Review.MaxPageSize),
+	}
+	if args.Page.Cursor != "" {
+		params.After = &repository.ReviewCursor{}
+		if err := decodeCursor(args.Page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+
+		if params.After.Sort != sort {
+			return nil, httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil)
+		}
+	}
+
+	movie, err := u.movieRepository.FindByID(ctx, args.MovieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	page, err := u.reviewRepository.FindByMovieID(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByMovieID: %w", err))
+	}
+
+	reviewPage := &entity.ReviewPage{Reviews: page.Reviews}
+	for i, review := range page.Reviews {
+		reviewPage.Reviews[i] = maskSpoilers(review, args.RevealSpoilers)
+	}
+
+	if page.Next != nil {
+		if reviewPage.NextCursor, err = encodeCursor(page.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return reviewPage, nil
+}
+
+type UpdateReviewParams struct {
+	ReviewID  uint64 `json:"review_id"`
+	UserID    uint64 `json:"user_id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	IsSpoiler bool   `json:"is_spoiler"`
+}
+
+func (u *reviewUsecase) UpdateReview(ctx context.Context, args UpdateReviewParams) (*entity.Review, error) {
+	review, err := u.findOwnReview(ctx, args.ReviewID, args.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing is changed, so no revision is kept
+	if review.Title == args.Title && review.Content == args.Content && review.IsSpoiler == args.IsSpoiler {
+		return review, nil
+	}
+
+	status, verdict, err := filterContent(ctx, u.contentFilter, contentfilter.Content{
+		TargetType: entity.ReportTargetReview,
+		ID:         review.ID,
+		UserID:     args.UserID,
+		Title:      args.Title,
+		Text:       args.Content,
+	}, review.ModerationStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.reviewRepository.UpdateReview(ctx, repository.UpdateReviewParams{
+		ID:               review.ID,
+		Title:            args.Title,
+		Content:          args.Content,
+		IsSpoiler:        args.IsSpoiler,
+		ModerationStatus: status,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.UpdateReview: %w", err))
+	}
+
+	if err := reportFilteredContent(ctx, u.reportRepository, entity.ReportTargetReview, review.ID, verdict); err != nil {
+		return nil, err
+	}
+
+	return u.findReview(ctx, review.ID)
+}
+
+type ListReviewRevisionsParams struct {
+	ReviewID       uint64 `json:"review_id"`
+	RevealSpoilers bool   `json:"reveal_spoilers"`
+	Viewer         Viewer `json:"viewer"`
+}
+
+// ListReviewRevisions returns every version of the review with the changes from the version before it, the
+// current review is the last revision
+func (u *reviewUsecase) ListReviewRevisions(ctx context.Context, args ListReviewRevisionsParams) (*entity.ReviewRevisionHistory, error) {
+	review, err := u.findReview(ctx, args.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.Viewer.canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	revisions, err := u.reviewRepository.FindRevisions(ctx, review.ID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindRevisions: %w", err))
+	}
+
+	current := &entity.ReviewRevision{
+		Title:     review.Title,
+		Content:   review.Content,
+		IsSpoiler: review.IsSpoiler,
+		CreatedAt: review.CreatedAt,
+	}
+	if review.EditedAt != nil {
+		current.CreatedAt = *review.EditedAt
+	}
+	revisions = append(revisions, current)
+
+	for i, revision := range revisions {
+		revision.Number = uint(i + 1)
+		if !args.RevealSpoilers {
+			revision.Title, revision.Content, _ = maskSpoilerText(revision.Title, revision.Content, revision.IsSpoiler)
+		}
+
+		if i == 0 {
+			revision.TitleDiff, revision.ContentDiff = []entity.DiffSegment{}, []entity.DiffSegment{}
+			continue
+		}
+
+		revision.TitleDiff = diff(revisions[i-1].Title, revision.Title)
+		revision.ContentDiff = diff(revisions[i-1].Content, revision.Content)
+	}
+
+	return &entity.ReviewRevisionHistory{ReviewID: review.ID, Revisions: revisions}, nil
+}
+
+// diff returns the word by word changes which turn from into to
+func diff(from string, to string) []entity.DiffSegment {
+	segments := textdiff.Diff(from, to)
+	diffSegments := make([]entity.DiffSegment, 0, len(segments))
+	for _, segment := range segments {
+		diffSegments = append(diffSegments, entity.DiffSegment{Op: entity.DiffOp(segment.Op), Text: segment.Text})
+	}
+
+	return diffSegments
+}
+
+type DeleteReviewParams struct {
+	ReviewID uint64 `json:"review_id"`
+	UserID   uint64 `json:"user_id"`
+}
+
+func (u *reviewUsecase) DeleteReview(ctx context.Context, args DeleteReviewParams) error {
+	review, err := u.findOwnReview(ctx, args.ReviewID, args.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.reviewRepository.DeleteReview(ctx, review.ID); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.DeleteReview: %w", err))
+	}
+
+	return nil
+}
+
+// findOwnReview returns the review only when it was written by the given user
+func (u *reviewUsecase) findOwnReview(ctx context.Context, reviewID uint64, userID uint64) (*entity.Review, error) {
+	review, err := u.findReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.UserID != userID {
+		return nil, httperrors.NewForbiddenError(fmt.Errorf("review %d is not written by user %d", reviewID, userID))
+	}
+
+	return review, nil
+}
+
+// maskSpoilers returns a copy of the review whose spoilers are masked for the readers who do not reveal
+// spoilers
+func maskSpoilers(review *entity.Review, revealSpoilers bool) *entity.Review {
+	if revealSpoilers {
+		return review
+	}
+
+	masked := *review
+	masked.Title, masked.Content, masked.SpoilersMasked = maskSpoilerText(review.Title, review.Content, review.IsSpoiler)
+
+	return &masked
+}
+
+// maskSpoilerText masks the spoilers of the title and the content of a review and reports whether anything
+// was masked, the whole content of a spoiler review is masked and only the inline ranges of the others
+func maskSpoilerText(title string, content string, isSpoiler bool) (string, string, bool) {
+	contentMasked := true
+	if isSpoiler {
+		content = spoiler.Placeholder
+	} else {
+		content, contentMasked = spoiler.Mask(content)
+	}
+
+	title, titleMasked := spoiler.Mask(title)
+
+	return title, content, contentMasked || titleMasked
+}
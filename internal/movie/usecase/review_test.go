@@ -0,0 +1,970 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/contentfilter"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReviewUsecase struct {
+	suite.Suite
+}
+
+func TestReviewUsecasesuite(t *testing.T) {
+	suite.Run(t, &testReviewUsecase{})
+}
+
+func dummyMovie(movieID uint64) *entity.Movie {
+	return &entity.Movie{
+		ID:               movieID,
+		OriginalTitle:    "accumsan sed, facilisis vitae,",
+		OriginalLanguage: "Nigeria",
+		CreatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt:        utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func dummyReview(reviewID uint64, userID uint64) *entity.Review {
+	return &entity.Review{
+		ID:        reviewID,
+		MovieID:   10,
+		UserID:    userID,
+		Username:  "testuser",
+		Title:     "great movie",
+		Content:   "really enjoyed it",
+		CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+		UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+	}
+}
+
+func (s *testReviewUsecase) TestCreateReview() {
+	type testInput struct {
+		args                 usecase.CreateReviewParams
+		contentFilter        contentfilter.ContentFilter
+		mockMovieRepository  func(*mock_repository.MockMovieRepository)
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+		mockReportRepository func(*mock_repository.MockReportRepository)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	args := usecase.CreateReviewParams{UserID: 1, MovieID: 10, Title: "great movie", Content: "really enjoyed it"}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_created_review",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), repository.FindReviewByUserIDAndMovieIDParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(nil, nil)
+					r.EXPECT().CreateReview(gomock.Any(), repository.CreateReviewParams{
+						UserID:           1,
+						MovieID:          10,
+						Title:            "great movie",
+						Content:          "really enjoyed it",
+						ModerationStatus: entity.ModerationStatusVisible,
+					}).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				review: dummyReview(5, 1),
+			},
+		},
+		{
+			name: "creates_pending_review_and_reports_it_when_content_filter_sends_it_to_moderation",
+			input: testInput{
+				args: usecase.CreateReviewParams{UserID: 1, MovieID: 10, Title: "great movie",
+					Content: "see https://example.com https://example.org"},
+				contentFilter: contentfilter.NewLinkFilter(1, nil),
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReview(gomock.Any(), repository.CreateReviewParams{
+						UserID:           1,
+						MovieID:          10,
+						Title:            "great movie",
+						Content:          "see https://example.com https://example.org",
+						ModerationStatus: entity.ModerationStatusPending,
+					}).Return(dummyReview(5, 1), nil)
+				},
+				mockReportRepository: func(r *mock_repository.MockReportRepository) {
+					r.EXPECT().CreateFilterReport(gomock.Any(), repository.CreateFilterReportParams{
+						TargetType: entity.ReportTargetReview,
+						TargetID:   5,
+						Reason:     "content has 2 links",
+					}).Return(&entity.Report{ID: 3}, nil)
+				},
+			},
+			expected: testOutput{
+				review: dummyReview(5, 1),
+			},
+		},
+		{
+			name: "returns_error_when_content_filter_rejects_review",
+			input: testInput{
+				args:          args,
+				contentFilter: contentfilter.NewProfanityFilter([]string{"Enjoyed"}),
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "content contains prohibited words", nil),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_movie",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_when_movie_already_reviewed_by_user",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), repository.FindReviewByUserIDAndMovieIDParams{
+						UserID:  1,
+						MovieID: 10,
+					}).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "already reviewed", nil),
+			},
+		},
+		{
+			name: "returns_error_when_movie_is_reviewed_by_user_concurrently",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(nil, repository.ErrReviewExists)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "already reviewed", nil),
+			},
+		},
+		{
+			name: "returns_error_of_CreateReview",
+			input: testInput{
+				args: args,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByUserIDAndMovieID(gomock.Any(), gomock.Any()).Return(nil, nil)
+					r.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.CreateReview: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			c.input.mockReviewRepository(mockReviewRepository)
+			if c.input.mockReportRepository != nil {
+				c.input.mockReportRepository(mockReportRepository)
+			}
+
+			contentFilter := c.input.contentFilter
+			if contentFilter == nil {
+				contentFilter = contentfilter.NewPipeline()
+			}
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockReviewRepository, mockReportRepository, contentFilter)
+			res, err := u.CreateReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.review, res)
+		})
+	}
+}
+
+func dummySpoilerReview(reviewID uint64, userID uint64) *entity.Review {
+	review := dummyReview(reviewID, userID)
+	review.Title = "the ending ||twist||"
+	review.Content = "the hero ||dies|| at the end"
+
+	return review
+}
+
+func (s *testReviewUsecase) TestGetReviewByID() {
+	type testInput struct {
+		args                 usecase.GetReviewByIDParams
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	maskedReview := dummySpoilerReview(5, 1)
+	maskedReview.Title = "the ending [spoiler]"
+	maskedReview.Content = "the hero [spoiler] at the end"
+	maskedReview.SpoilersMasked = true
+
+	spoilerReview := dummyReview(5, 1)
+	spoilerReview.IsSpoiler = true
+	maskedSpoilerReview := dummyReview(5, 1)
+	maskedSpoilerReview.IsSpoiler = true
+	maskedSpoilerReview.Content = "[spoiler]"
+	maskedSpoilerReview.SpoilersMasked = true
+
+	hiddenReview := dummyReview(5, 1)
+	hiddenReview.ModerationStatus = entity.ModerationStatusHidden
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_review_with_inline_spoilers_masked",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummySpoilerReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				review: maskedReview,
+			},
+		},
+		{
+			name: "returns_review_with_content_masked_when_review_is_spoiler",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(spoilerReview, nil)
+				},
+			},
+			expected: testOutput{
+				review: maskedSpoilerReview,
+			},
+		},
+		{
+			name: "returns_review_as_it_is_when_reveal_spoilers",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5, RevealSpoilers: true},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummySpoilerReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				review: dummySpoilerReview(5, 1),
+			},
+		},
+		{
+			name: "returns_hidden_review_to_its_author",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5, Viewer: usecase.Viewer{UserID: 1}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+			},
+			expected: testOutput{
+				review: hiddenReview,
+			},
+		},
+		{
+			name: "returns_hidden_review_to_moderators",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5, Viewer: usecase.Viewer{UserID: 9, IsModerator: true}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+			},
+			expected: testOutput{
+				review: hiddenReview,
+			},
+		},
+		{
+			name: "returns_error_when_review_is_hidden_from_user",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5, Viewer: usecase.Viewer{UserID: 2}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", 5)),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.GetReviewByIDParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockReviewRepository, nil, nil)
+			res, err := u.GetReviewByID(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.review, res)
+		})
+	}
+}
+
+func (s *testReviewUsecase) TestListReviewsByMovieID() {
+	type testInput struct {
+		args                 usecase.ListReviewsByMovieIDParams
+		mockMovieRepository  func(*mock_repository.MockMovieRepository)
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+	}
+
+	type testOutput struct {
+		page *entity.ReviewPage
+		err  error
+	}
+
+	createdAt := time.Date(2022, 8, 20, 22, 0, 0, 0, time.UTC)
+	newestCursor, err := cursor.Encode(repository.ReviewCursor{ID: 5, Sort: repository.ReviewSortNewest,
+		CreatedAt: &createdAt})
+	s.Require().NoError(err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_reviews_of_movie_sorted_by_helpfulness_when_sort_is_empty",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), repository.FindReviewsByMovieIDParams{
+						MovieID: 10,
+						Sort:    repository.ReviewSortHelpful,
+						Limit:   20,
+					}).Return(&repository.ReviewPage{Reviews: []*entity.Review{dummyReview(5, 1), dummyReview(6, 2)}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReviewPage{Reviews: []*entity.Review{dummyReview(5, 1), dummyReview(6, 2)}},
+			},
+		},
+		{
+			name: "returns_reviews_of_movie_sorted_by_given_sort",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10, Sort: "newest"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), repository.FindReviewsByMovieIDParams{
+						MovieID: 10,
+						Sort:    repository.ReviewSortNewest,
+						Limit:   20,
+					}).Return(&repository.ReviewPage{Reviews: []*entity.Review{dummyReview(6, 2), dummyReview(5, 1)}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReviewPage{Reviews: []*entity.Review{dummyReview(6, 2), dummyReview(5, 1)}},
+			},
+		},
+		{
+			name: "returns_page_of_reviews_with_next_cursor_after_cursor",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10, Sort: "newest",
+					Page: usecase.PageParams{Cursor: newestCursor, Limit: 1}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), repository.FindReviewsByMovieIDParams{
+						MovieID: 10,
+						Sort:    repository.ReviewSortNewest,
+						Limit:   1,
+						After: &repository.ReviewCursor{ID: 5, Sort: repository.ReviewSortNewest,
+							CreatedAt: &createdAt},
+					}).Return(&repository.ReviewPage{
+						Reviews: []*entity.Review{dummyReview(5, 1)},
+						Next: &repository.ReviewCursor{ID: 5, Sort: repository.ReviewSortNewest,
+							CreatedAt: &createdAt},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReviewPage{Reviews: []*entity.Review{dummyReview(5, 1)}, NextCursor: &newestCursor},
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_of_other_sort",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10, Sort: "rating",
+					Page: usecase.PageParams{Cursor: newestCursor}},
+				mockMovieRepository:  func(r *mock_repository.MockMovieRepository) {},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_invalid",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10,
+					Page: usecase.PageParams{Cursor: "not a cursor"}},
+				mockMovieRepository:  func(r *mock_repository.MockMovieRepository) {},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_reviews_of_given_author_type",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10, AuthorType: "critic"},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), repository.FindReviewsByMovieIDParams{
+						MovieID:    10,
+						Sort:       repository.ReviewSortHelpful,
+						AuthorType: repository.ReviewAuthorCritic,
+						Limit:      20,
+					}).Return(&repository.ReviewPage{Reviews: []*entity.Review{dummyReview(5, 1)}}, nil)
+				},
+			},
+			expected: testOutput{
+				page: &entity.ReviewPage{Reviews: []*entity.Review{dummyReview(5, 1)}},
+			},
+		},
+		{
+			name: "returns_error_when_author_type_is_invalid",
+			input: testInput{
+				args:                 usecase.ListReviewsByMovieIDParams{MovieID: 10, AuthorType: "director"},
+				mockMovieRepository:  func(r *mock_repository.MockMovieRepository) {},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("invalid author type: %s", "director")),
+			},
+		},
+		{
+			name: "returns_error_when_sort_is_invalid",
+			input: testInput{
+				args:                 usecase.ListReviewsByMovieIDParams{MovieID: 10, Sort: "oldest"},
+				mockMovieRepository:  func(r *mock_repository.MockMovieRepository) {},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("invalid sort: %s", "oldest")),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_movie",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(nil, nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindByMovieID",
+			input: testInput{
+				args: usecase.ListReviewsByMovieIDParams{MovieID: 10},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(10)).Return(dummyMovie(10), nil)
+				},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByMovieID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByMovieID: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			c.input.mockReviewRepository(mockReviewRepository)
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockReviewRepository, nil, nil)
+			res, err := u.ListReviewsByMovieID(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.page, res)
+		})
+	}
+}
+
+func (s *testReviewUsecase) TestUpdateReview() {
+	type testInput struct {
+		args                 usecase.UpdateReviewParams
+		contentFilter        contentfilter.ContentFilter
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	updatedReview := dummyReview(5, 1)
+	updatedReview.Title = "updated"
+	updatedReview.Content = "changed my mind"
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_updated_review_when_user_is_author",
+			input: testInput{
+				args: usecase.UpdateReviewParams{ReviewID: 5, UserID: 1, Title: "updated", Content: "changed my mind"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil),
+						r.EXPECT().UpdateReview(gomock.Any(), repository.UpdateReviewParams{
+							ID:      5,
+							Title:   "updated",
+							Content: "changed my mind",
+						}).Return(nil),
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(updatedReview, nil),
+					)
+				},
+			},
+			expected: testOutput{
+				review: updatedReview,
+			},
+		},
+		{
+			name: "keeps_moderation_status_of_review_when_content_filter_accepts_it",
+			input: testInput{
+				args: usecase.UpdateReviewParams{ReviewID: 5, UserID: 1, Title: "updated", Content: "changed my mind"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					hiddenReview := dummyReview(5, 1)
+					hiddenReview.ModerationStatus = entity.ModerationStatusHidden
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil),
+						r.EXPECT().UpdateReview(gomock.Any(), repository.UpdateReviewParams{
+							ID:               5,
+							Title:            "updated",
+							Content:          "changed my mind",
+							ModerationStatus: entity.ModerationStatusHidden,
+						}).Return(nil),
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(updatedReview, nil),
+					)
+				},
+			},
+			expected: testOutput{
+				review: updatedReview,
+			},
+		},
+		{
+			name: "returns_error_when_content_filter_rejects_review",
+			input: testInput{
+				args:          usecase.UpdateReviewParams{ReviewID: 5, UserID: 1, Title: "updated", Content: "changed my mind"},
+				contentFilter: contentfilter.NewProfanityFilter([]string{"mind"}),
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "content contains prohibited words", nil),
+			},
+		},
+		{
+			name: "returns_review_without_update_when_nothing_is_changed",
+			input: testInput{
+				args: usecase.UpdateReviewParams{ReviewID: 5, UserID: 1, Title: "great movie", Content: "really enjoyed it"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				review: dummyReview(5, 1),
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.UpdateReviewParams{ReviewID: 5, UserID: 1, Title: "updated", Content: "changed my mind"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_forbidden_when_user_is_not_author",
+			input: testInput{
+				args: usecase.UpdateReviewParams{ReviewID: 5, UserID: 2, Title: "updated", Content: "changed my mind"},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewForbiddenError(fmt.Errorf("review %d is not written by user %d", 5, 2)),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+
+			contentFilter := c.input.contentFilter
+			if contentFilter == nil {
+				contentFilter = contentfilter.NewPipeline()
+			}
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockReviewRepository,
+				nil, contentFilter)
+			res, err := u.UpdateReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.review, res)
+		})
+	}
+}
+
+func (s *testReviewUsecase) TestListReviewRevisions() {
+	type testInput struct {
+		args                 usecase.ListReviewRevisionsParams
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+	}
+
+	type testOutput struct {
+		history *entity.ReviewRevisionHistory
+		err     error
+	}
+
+	editedReview := dummyReview(5, 1)
+	editedReview.Content = "the hero ||dies|| at the very end"
+	editedReview.Edited = true
+	editedReview.EditedAt = utils.TimePtr(utils.MustRFC3339Time("2022-08-22T22:00:00+00:00"))
+
+	hiddenReview := dummyReview(5, 1)
+	hiddenReview.ModerationStatus = entity.ModerationStatusHidden
+
+	previousRevisions := func() []*entity.ReviewRevision {
+		return []*entity.ReviewRevision{
+			{
+				Title:     "great movie",
+				Content:   "the hero dies",
+				CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+			},
+			{
+				Title:     "great movie",
+				Content:   "spoiler warning",
+				IsSpoiler: true,
+				CreatedAt: utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_revisions_with_diffs_and_current_review_last",
+			input: testInput{
+				args: usecase.ListReviewRevisionsParams{ReviewID: 5, RevealSpoilers: true},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(editedReview, nil)
+					r.EXPECT().FindRevisions(gomock.Any(), uint64(5)).Return(previousRevisions(), nil)
+				},
+			},
+			expected: testOutput{
+				history: &entity.ReviewRevisionHistory{
+					ReviewID: 5,
+					Revisions: []*entity.ReviewRevision{
+						{
+							Number:      1,
+							Title:       "great movie",
+							Content:     "the hero dies",
+							TitleDiff:   []entity.DiffSegment{},
+							ContentDiff: []entity.DiffSegment{},
+							CreatedAt:   utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						},
+						{
+							Number:    2,
+							Title:     "great movie",
+							Content:   "spoiler warning",
+							IsSpoiler: true,
+							TitleDiff: []entity.DiffSegment{{Op: entity.DiffOpEqual, Text: "great movie"}},
+							ContentDiff: []entity.DiffSegment{
+								{Op: entity.DiffOpDelete, Text: "the hero dies"},
+								{Op: entity.DiffOpInsert, Text: "spoiler warning"},
+							},
+							CreatedAt: utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						},
+						{
+							Number:    3,
+							Title:     "great movie",
+							Content:   "the hero ||dies|| at the very end",
+							TitleDiff: []entity.DiffSegment{{Op: entity.DiffOpEqual, Text: "great movie"}},
+							ContentDiff: []entity.DiffSegment{
+								{Op: entity.DiffOpDelete, Text: "spoiler warning"},
+								{Op: entity.DiffOpInsert, Text: "the hero ||dies|| at the very end"},
+							},
+							CreatedAt: utils.MustRFC3339Time("2022-08-22T22:00:00+00:00"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_revisions_with_masked_spoilers",
+			input: testInput{
+				args: usecase.ListReviewRevisionsParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(editedReview, nil)
+					r.EXPECT().FindRevisions(gomock.Any(), uint64(5)).Return(previousRevisions(), nil)
+				},
+			},
+			expected: testOutput{
+				history: &entity.ReviewRevisionHistory{
+					ReviewID: 5,
+					Revisions: []*entity.ReviewRevision{
+						{
+							Number:      1,
+							Title:       "great movie",
+							Content:     "the hero dies",
+							TitleDiff:   []entity.DiffSegment{},
+							ContentDiff: []entity.DiffSegment{},
+							CreatedAt:   utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						},
+						{
+							Number:    2,
+							Title:     "great movie",
+							Content:   "[spoiler]",
+							IsSpoiler: true,
+							TitleDiff: []entity.DiffSegment{{Op: entity.DiffOpEqual, Text: "great movie"}},
+							ContentDiff: []entity.DiffSegment{
+								{Op: entity.DiffOpDelete, Text: "the hero dies"},
+								{Op: entity.DiffOpInsert, Text: "[spoiler]"},
+							},
+							CreatedAt: utils.MustRFC3339Time("2022-08-21T22:00:00+00:00"),
+						},
+						{
+							Number:    3,
+							Title:     "great movie",
+							Content:   "the hero [spoiler] at the very end",
+							TitleDiff: []entity.DiffSegment{{Op: entity.DiffOpEqual, Text: "great movie"}},
+							ContentDiff: []entity.DiffSegment{
+								{Op: entity.DiffOpDelete, Text: "[spoiler]"},
+								{Op: entity.DiffOpInsert, Text: "the hero [spoiler] at the very end"},
+							},
+							CreatedAt: utils.MustRFC3339Time("2022-08-22T22:00:00+00:00"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_not_found_when_review_is_hidden_from_viewer",
+			input: testInput{
+				args: usecase.ListReviewRevisionsParams{ReviewID: 5, Viewer: usecase.Viewer{UserID: 2}},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(hiddenReview, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", 5)),
+			},
+		},
+		{
+			name: "returns_error_of_FindRevisions",
+			input: testInput{
+				args: usecase.ListReviewRevisionsParams{ReviewID: 5},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+					r.EXPECT().FindRevisions(gomock.Any(), uint64(5)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindRevisions: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockReviewRepository,
+				nil, nil)
+			res, err := u.ListReviewRevisions(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.history, res)
+		})
+	}
+}
+
+func (s *testReviewUsecase) TestDeleteReview() {
+	type testInput struct {
+		args                 usecase.DeleteReviewParams
+		mockReviewRepository func(*mock_repository.MockReviewRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_user_is_author",
+			input: testInput{
+				args: usecase.DeleteReviewParams{ReviewID: 5, UserID: 1},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+					r.EXPECT().DeleteReview(gomock.Any(), uint64(5)).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_forbidden_when_user_is_not_author",
+			input: testInput{
+				args: usecase.DeleteReviewParams{ReviewID: 5, UserID: 2},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewForbiddenError(fmt.Errorf("review %d is not written by user %d", 5, 2)),
+			},
+		},
+		{
+			name: "returns_error_of_DeleteReview",
+			input: testInput{
+				args: usecase.DeleteReviewParams{ReviewID: 5, UserID: 1},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+					r.EXPECT().DeleteReview(gomock.Any(), uint64(5)).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.DeleteReview: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+
+			u := usecase.NewReviewUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockReviewRepository,
+				nil, nil)
+			err := u.DeleteReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
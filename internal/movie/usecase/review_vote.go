@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type reviewVoteUsecase struct {
+	cfg                  config.Config
+	reviewRepository     repository.ReviewRepository
+	reviewVoteRepository repository.ReviewVoteRepository
+	logger               logger.Logger
+}
+
+func NewReviewVoteUsecase(cfg config.Config, log logger.Logger, reviewRepository repository.ReviewRepository,
+	reviewVoteRepository repository.ReviewVoteRepository) *reviewVoteUsecase {
+	return &reviewVoteUsecase{cfg: cfg, logger: log, reviewRepository: reviewRepository,
+		reviewVoteRepository: reviewVoteRepository}
+}
+
+type VoteReviewParams struct {
+	ReviewID       uint64 `json:"review_id"`
+	UserID         uint64 `json:"user_id"`
+	Helpful        bool   `json:"helpful"`
+	RevealSpoilers bool   `json:"reveal_spoilers"`
+}
+
+func (u *reviewVoteUsecase) VoteReview(ctx context.Context, args VoteReviewParams) (*entity.Review, error) {
+	review, err := u.findReview(ctx, args.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(Viewer{UserID: args.UserID}).canSee(review.ModerationStatus, review.UserID) {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("review %d is hidden", review.ID))
+	}
+
+	if review.UserID == args.UserID {
+		return nil, httperrors.NewRestError(http.StatusBadRequest, "can not vote own review", nil)
+	}
+
+	if err := u.reviewVoteRepository.UpsertReviewVote(ctx, repository.UpsertReviewVoteParams{
+		UserID:   args.UserID,
+		ReviewID: args.ReviewID,
+		Helpful:  args.Helpful,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewVoteRepository.UpsertReviewVote: %w", err))
+	}
+
+	review, err = u.findReview(ctx, args.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return maskSpoilers(review, args.RevealSpoilers), nil
+}
+
+type DeleteReviewVoteParams struct {
+	ReviewID uint64 `json:"review_id"`
+	UserID   uint64 `json:"user_id"`
+}
+
+func (u *reviewVoteUsecase) DeleteReviewVote(ctx context.Context, args DeleteReviewVoteParams) error {
+	vote, err := u.reviewVoteRepository.FindByUserIDAndReviewID(ctx, repository.FindReviewVoteByUserIDAndReviewIDParams{
+		UserID:   args.UserID,
+		ReviewID: args.ReviewID,
+	})
+	if err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("reviewVoteRepository.FindByUserIDAndReviewID: %w", err))
+	}
+
+	if vote == nil {
+		return httperrors.NewNotFoundError(fmt.Errorf("reviewVoteRepository.FindByUserIDAndReviewID: not found"))
+	}
+
+	if err := u.reviewVoteRepository.DeleteReviewVote(ctx, repository.DeleteReviewVoteParams{
+		UserID:   args.UserID,
+		ReviewID: args.ReviewID,
+	}); err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("reviewVoteRepository.DeleteReviewVote: %w", err))
+	}
+
+	return nil
+}
+
+func (u *reviewVoteUsecase) findReview(ctx context.Context, reviewID uint64) (*entity.Review, error) {
+	review, err := u.reviewRepository.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("reviewRepository.FindByID: %w", err))
+	}
+
+	if review == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found"))
+	}
+
+	return review, nil
+}
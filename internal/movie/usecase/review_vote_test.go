@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testReviewVoteUsecase struct {
+	suite.Suite
+}
+
+func TestReviewVoteUsecasesuite(t *testing.T) {
+	suite.Run(t, &testReviewVoteUsecase{})
+}
+
+func (s *testReviewVoteUsecase) TestVoteReview() {
+	type testInput struct {
+		args                     usecase.VoteReviewParams
+		mockReviewRepository     func(*mock_repository.MockReviewRepository)
+		mockReviewVoteRepository func(*mock_repository.MockReviewVoteRepository)
+	}
+
+	type testOutput struct {
+		review *entity.Review
+		err    error
+	}
+
+	votedReview := dummyReview(5, 1)
+	votedReview.HelpfulCount = 1
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_voted_review_when_vote_successfully",
+			input: testInput{
+				args: usecase.VoteReviewParams{ReviewID: 5, UserID: 2, Helpful: true},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					gomock.InOrder(
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil),
+						r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(votedReview, nil),
+					)
+				},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {
+					r.EXPECT().UpsertReviewVote(gomock.Any(), repository.UpsertReviewVoteParams{
+						UserID:   2,
+						ReviewID: 5,
+						Helpful:  true,
+					}).Return(nil)
+				},
+			},
+			expected: testOutput{
+				review: votedReview,
+			},
+		},
+		{
+			name: "returns_error_when_not_found_review",
+			input: testInput{
+				args: usecase.VoteReviewParams{ReviewID: 5, UserID: 2, Helpful: true},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(nil, nil)
+				},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_when_vote_own_review",
+			input: testInput{
+				args: usecase.VoteReviewParams{ReviewID: 5, UserID: 1, Helpful: true},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, "can not vote own review", nil),
+			},
+		},
+		{
+			name: "returns_error_of_UpsertReviewVote",
+			input: testInput{
+				args: usecase.VoteReviewParams{ReviewID: 5, UserID: 2, Helpful: false},
+				mockReviewRepository: func(r *mock_repository.MockReviewRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(5)).Return(dummyReview(5, 1), nil)
+				},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {
+					r.EXPECT().UpsertReviewVote(gomock.Any(), gomock.Any()).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("reviewVoteRepository.UpsertReviewVote: %w", fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewRepository := mock_repository.NewMockReviewRepository(ctrl)
+			mockReviewVoteRepository := mock_repository.NewMockReviewVoteRepository(ctrl)
+			c.input.mockReviewRepository(mockReviewRepository)
+			c.input.mockReviewVoteRepository(mockReviewVoteRepository)
+
+			u := usecase.NewReviewVoteUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockReviewRepository, mockReviewVoteRepository)
+			res, err := u.VoteReview(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.review, res)
+		})
+	}
+}
+
+func (s *testReviewVoteUsecase) TestDeleteReviewVote() {
+	type testInput struct {
+		args                     usecase.DeleteReviewVoteParams
+		mockReviewVoteRepository func(*mock_repository.MockReviewVoteRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_nil_when_delete_vote_successfully",
+			input: testInput{
+				args: usecase.DeleteReviewVoteParams{ReviewID: 5, UserID: 2},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {
+					r.EXPECT().FindByUserIDAndReviewID(gomock.Any(), repository.FindReviewVoteByUserIDAndReviewIDParams{
+						UserID:   2,
+						ReviewID: 5,
+					}).Return(&entity.ReviewVote{
+						UserID:    2,
+						ReviewID:  5,
+						Helpful:   true,
+						CreatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+						UpdatedAt: utils.MustRFC3339Time("2022-08-20T22:00:00+00:00"),
+					}, nil)
+					r.EXPECT().DeleteReviewVote(gomock.Any(), repository.DeleteReviewVoteParams{
+						UserID:   2,
+						ReviewID: 5,
+					}).Return(nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_error_when_review_is_not_voted",
+			input: testInput{
+				args: usecase.DeleteReviewVoteParams{ReviewID: 5, UserID: 2},
+				mockReviewVoteRepository: func(r *mock_repository.MockReviewVoteRepository) {
+					r.EXPECT().FindByUserIDAndReviewID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("reviewVoteRepository.FindByUserIDAndReviewID: not found")),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReviewVoteRepository := mock_repository.NewMockReviewVoteRepository(ctrl)
+			c.input.mockReviewVoteRepository(mockReviewVoteRepository)
+
+			u := usecase.NewReviewVoteUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockReviewVoteRepository)
+			err := u.DeleteReviewVote(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
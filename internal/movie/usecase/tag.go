@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+)
+
+type tagUsecase struct {
+	cfg                        config.Config
+	movieRepository            repository.MovieRepository
+	movieSearcher              repository.MovieSearcher
+	tagRepository              repository.TagRepository
+	movieTranslationRepository repository.MovieTranslationRepository
+	logger                     logger.Logger
+}
+
+func NewTagUsecase(cfg config.Config, log logger.Logger, movieRepository repository.MovieRepository,
+	movieSearcher repository.MovieSearcher, tagRepository repository.TagRepository,
+	movieTranslationRepository repository.MovieTranslationRepository) *tagUsecase {
+	return &tagUsecase{cfg: cfg, logger: log, movieRepository: movieRepository, movieSearcher: movieSearcher,
+		tagRepository: tagRepository, movieTranslationRepository: movieTranslationRepository}
+}
+
+// GetTagParams gets the tag with its movies, the movies which most users applied the tag to come first.
+// Slug is normalized like the tags which are added, the movies are translated into the best of Languages and are
+// paged with the page sizes of MovieList
+type GetTagParams struct {
+	Slug      string     `json:"slug"`
+	Languages []string   `json:"languages"`
+	Page      PageParams `json:"page"`
+}
+
+func (u *tagUsecase) GetTag(ctx context.Context, args GetTagParams) (*entity.Tag, error) {
+	params := repository.FindMoviesByTagIDParams{
+		Limit: pageSize(args.Page.Limit, u.cfg.MovieList.DefaultPageSize, u.cfg.MovieList.MaxPageSize),
+	}
+	if args.Page.Cursor != "" {
+		params.After = &repository.TaggedMovieCursor{}
+		if err := decodeCursor(args.Page.Cursor, params.After); err != nil {
+			return nil, err
+		}
+	}
+
+	tag, err := u.tagRepository.FindBySlug(ctx, normalizeTag(args.Slug))
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("tagRepository.FindBySlug: %w", err))
+	}
+
+	if tag == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("tagRepository.FindBySlug: not found"))
+	}
+
+	params.TagID = tag.ID
+	taggedMovies, err := u.tagRepository.FindMoviesByTagID(ctx, params)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("tagRepository.FindMoviesByTagID: %w", err))
+	}
+
+	movies := make([]*entity.Movie, len(taggedMovies.Movies))
+	for i, taggedMovie := range taggedMovies.Movies {
+		movies[i] = taggedMovie.Movie
+	}
+
+	if err := translateMovies(ctx, u.movieTranslationRepository, movies, args.Languages); err != nil {
+		return nil, err
+	}
+
+	tag.Movies = taggedMovies.Movies
+	if taggedMovies.Next != nil {
+		if tag.NextCursor, err = encodeCursor(taggedMovies.Next); err != nil {
+			return nil, err
+		}
+	}
+
+	return tag, nil
+}
+
+// ListMovieTags returns the tag cloud of the movie
+func (u *tagUsecase) ListMovieTags(ctx context.Context, movieID uint64) (*entity.MovieTagCloud, error) {
+	if err := u.checkMovie(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	return u.movieTagCloud(ctx, movieID)
+}
+
+// AddMovieTagsParams applies Tags to the movie for the user, the tags are normalized into lower case words
+// joined by "-" and deduplicated
+type AddMovieTagsParams struct {
+	UserID  uint64   `json:"user_id"`
+	MovieID uint64   `json:"movie_id"`
+	Tags    []string `json:"tags"`
+}
+
+// AddMovieTags applies the tags to the movie and returns the tag cloud of the movie, the tags which the user
+// already applied are skipped
+func (u *tagUsecase) AddMovieTags(ctx context.Context, args AddMovieTagsParams) (*entity.MovieTagCloud, error) {
+	slugs := make([]string, 0, len(args.Tags))
+	added := make(map[string]bool, len(args.Tags))
+	for _, tag := range args.Tags {
+		slug := normalizeTag(tag)
+		if slug == "" {
+			return nil, httperrors.NewBadRequestError(fmt.Errorf("tag %q must contain a letter or a digit", tag))
+		}
+
+		if utf8.RuneCountInString(slug) > entity.MaxTagLength {
+			return nil, httperrors.NewBadRequestError(fmt.Errorf("tag %q must be at most %d characters",
+				tag, entity.MaxTagLength))
+		}
+
+		if !added[slug] {
+			added[slug] = true
+			slugs = append(slugs, slug)
+		}
+	}
+
+	if err := u.checkMovie(ctx, args.MovieID); err != nil {
+		return nil, err
+	}
+
+	if err := u.tagRepository.AddMovieTags(ctx, repository.AddMovieTagsParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+		Slugs:   slugs,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("tagRepository.AddMovieTags: %w", err))
+	}
+
+	indexMovie(ctx, u.movieSearcher, u.logger, args.MovieID)
+
+	return u.movieTagCloud(ctx, args.MovieID)
+}
+
+type DeleteMovieTagParams struct {
+	UserID  uint64 `json:"user_id"`
+	MovieID uint64 `json:"movie_id"`
+	Slug    string `json:"slug"`
+}
+
+// DeleteMovieTag removes the tag which the user applied to the movie
+func (u *tagUsecase) DeleteMovieTag(ctx context.Context, args DeleteMovieTagParams) error {
+	deleted, err := u.tagRepository.DeleteMovieTag(ctx, repository.DeleteMovieTagParams{
+		UserID:  args.UserID,
+		MovieID: args.MovieID,
+		Slug:    normalizeTag(args.Slug),
+	})
+	if err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("tagRepository.DeleteMovieTag: %w", err))
+	}
+
+	if deleted == 0 {
+		return httperrors.NewNotFoundError(fmt.Errorf("tagRepository.DeleteMovieTag: not found"))
+	}
+
+	indexMovie(ctx, u.movieSearcher, u.logger, args.MovieID)
+
+	return nil
+}
+
+func (u *tagUsecase) checkMovie(ctx context.Context, movieID uint64) error {
+	movie, err := u.movieRepository.FindByID(ctx, movieID)
+	if err != nil {
+		return httperrors.NewInternalServerError(fmt.Errorf("movieRepository.FindByID: %w", err))
+	}
+
+	if movie == nil {
+		return httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found"))
+	}
+
+	return nil
+}
+
+// movieTagCloud weights the tags of the movie by the number of the users who applied them relative to the most
+// applied tag, the weight is rounded to 2 decimal places
+func (u *tagUsecase) movieTagCloud(ctx context.Context, movieID uint64) (*entity.MovieTagCloud, error) {
+	usages, err := u.tagRepository.FindUsagesByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("tagRepository.FindUsagesByMovieID: %w", err))
+	}
+
+	var maxUserCount uint
+	for _, usage := range usages {
+		if usage.UserCount > maxUserCount {
+			maxUserCount = usage.UserCount
+		}
+	}
+
+	for _, usage := range usages {
+		usage.Weight = math.Round(float64(usage.UserCount)/float64(maxUserCount)*100) / 100
+	}
+
+	return &entity.MovieTagCloud{MovieID: movieID, Tags: usages}, nil
+}
+
+// normalizeTag writes the tag in lower case and joins its words by "-", the characters which are neither
+// a letter nor a digit separate the words. "Time Travel" and "time_travel" become "time-travel"
+func normalizeTag(tag string) string {
+	words := strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, "-")
+}
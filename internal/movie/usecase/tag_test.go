@@ -0,0 +1,473 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/testdata/mock_repository"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTagUsecase struct {
+	suite.Suite
+}
+
+func TestTagUsecaseSuite(t *testing.T) {
+	suite.Run(t, &testTagUsecase{})
+}
+
+func (s *testTagUsecase) TestGetTag() {
+	type testInput struct {
+		args              usecase.GetTagParams
+		mockTagRepository func(*mock_repository.MockTagRepository)
+	}
+
+	type testOutput struct {
+		tag *entity.Tag
+		err error
+	}
+
+	tagCursor, err := cursor.Encode(repository.TaggedMovieCursor{ID: 1, UserCount: 3})
+	assert.NoError(s.T(), err)
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_tag_with_movies",
+			input: testInput{
+				args: usecase.GetTagParams{Slug: "Time Travel"},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindBySlug(gomock.Any(), "time-travel").Return(&entity.Tag{ID: 1, Slug: "time-travel"}, nil)
+					r.EXPECT().FindMoviesByTagID(gomock.Any(), repository.FindMoviesByTagIDParams{TagID: 1, Limit: 20}).
+						Return(&repository.TaggedMoviePage{Movies: []*entity.TaggedMovie{
+							{UserCount: 3, Movie: dummyMovie(1)},
+							{UserCount: 1, Movie: dummyMovie(3)},
+						}}, nil)
+				},
+			},
+			expected: testOutput{
+				tag: &entity.Tag{
+					ID:   1,
+					Slug: "time-travel",
+					Movies: []*entity.TaggedMovie{
+						{UserCount: 3, Movie: dummyMovie(1)},
+						{UserCount: 1, Movie: dummyMovie(3)},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_page_of_movies_with_next_cursor_after_cursor",
+			input: testInput{
+				args: usecase.GetTagParams{Slug: "time-travel", Page: usecase.PageParams{Cursor: tagCursor, Limit: 1}},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindBySlug(gomock.Any(), "time-travel").Return(&entity.Tag{ID: 1, Slug: "time-travel"}, nil)
+					r.EXPECT().FindMoviesByTagID(gomock.Any(), repository.FindMoviesByTagIDParams{
+						TagID: 1,
+						Limit: 1,
+						After: &repository.TaggedMovieCursor{ID: 1, UserCount: 3},
+					}).Return(&repository.TaggedMoviePage{
+						Movies: []*entity.TaggedMovie{{UserCount: 3, Movie: dummyMovie(1)}},
+						Next:   &repository.TaggedMovieCursor{ID: 1, UserCount: 3},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				tag: &entity.Tag{
+					ID:         1,
+					Slug:       "time-travel",
+					Movies:     []*entity.TaggedMovie{{UserCount: 3, Movie: dummyMovie(1)}},
+					NextCursor: &tagCursor,
+				},
+			},
+		},
+		{
+			name: "returns_error_when_cursor_is_invalid",
+			input: testInput{
+				args:              usecase.GetTagParams{Slug: "time-travel", Page: usecase.PageParams{Cursor: "not a cursor"}},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {},
+			},
+			expected: testOutput{
+				err: httperrors.NewRestError(http.StatusBadRequest, cursor.ErrInvalid.Error(), nil),
+			},
+		},
+		{
+			name: "returns_not_found_when_tag_does_not_exist",
+			input: testInput{
+				args: usecase.GetTagParams{Slug: "time-travel"},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindBySlug(gomock.Any(), "time-travel").Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("tagRepository.FindBySlug: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindMoviesByTagID_when_it_happened",
+			input: testInput{
+				args: usecase.GetTagParams{Slug: "time-travel"},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindBySlug(gomock.Any(), "time-travel").Return(&entity.Tag{ID: 1, Slug: "time-travel"}, nil)
+					r.EXPECT().FindMoviesByTagID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("tagRepository.FindMoviesByTagID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTagRepository := mock_repository.NewMockTagRepository(ctrl)
+			c.input.mockTagRepository(mockTagRepository)
+
+			u := usecase.NewTagUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, nil, mockTagRepository,
+				mock_repository.NewMockMovieTranslationRepository(ctrl))
+			res, err := u.GetTag(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.tag, res)
+		})
+	}
+}
+
+func (s *testTagUsecase) TestListMovieTags() {
+	type testInput struct {
+		movieID             uint64
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockTagRepository   func(*mock_repository.MockTagRepository)
+	}
+
+	type testOutput struct {
+		cloud *entity.MovieTagCloud
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "returns_tags_weighted_by_most_applied_tag",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindUsagesByMovieID(gomock.Any(), uint64(1)).Return([]*entity.TagUsage{
+						{Slug: "time-travel", UserCount: 3},
+						{Slug: "slow-burn", UserCount: 2},
+						{Slug: "twist-ending", UserCount: 1},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				cloud: &entity.MovieTagCloud{
+					MovieID: 1,
+					Tags: []*entity.TagUsage{
+						{Slug: "time-travel", UserCount: 3, Weight: 1},
+						{Slug: "slow-burn", UserCount: 2, Weight: 0.67},
+						{Slug: "twist-ending", UserCount: 1, Weight: 0.33},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_empty_cloud_when_movie_has_no_tag",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindUsagesByMovieID(gomock.Any(), uint64(1)).Return([]*entity.TagUsage{}, nil)
+				},
+			},
+			expected: testOutput{
+				cloud: &entity.MovieTagCloud{MovieID: 1, Tags: []*entity.TagUsage{}},
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_does_not_exist",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_FindUsagesByMovieID_when_it_happened",
+			input: testInput{
+				movieID: 1,
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(1)).Return(dummyMovie(1), nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().FindUsagesByMovieID(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("tagRepository.FindUsagesByMovieID: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			c.input.mockMovieRepository(mockMovieRepository)
+			mockTagRepository := mock_repository.NewMockTagRepository(ctrl)
+			if c.input.mockTagRepository != nil {
+				c.input.mockTagRepository(mockTagRepository)
+			}
+
+			u := usecase.NewTagUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository, nil,
+				mockTagRepository, nil)
+			res, err := u.ListMovieTags(context.Background(), c.input.movieID)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.cloud, res)
+		})
+	}
+}
+
+func (s *testTagUsecase) TestAddMovieTags() {
+	type testInput struct {
+		args                usecase.AddMovieTagsParams
+		mockMovieRepository func(*mock_repository.MockMovieRepository)
+		mockMovieSearcher   func(*mock_repository.MockMovieSearcher)
+		mockTagRepository   func(*mock_repository.MockTagRepository)
+	}
+
+	type testOutput struct {
+		cloud *entity.MovieTagCloud
+		err   error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "adds_normalized_and_deduplicated_tags",
+			input: testInput{
+				args: usecase.AddMovieTagsParams{
+					UserID:  1,
+					MovieID: 2,
+					Tags:    []string{"Time Travel", " time_travel ", "Slow-Burn!", "Ký ức"},
+				},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(dummyMovie(2), nil)
+				},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(2)).Return(nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().AddMovieTags(gomock.Any(), repository.AddMovieTagsParams{
+						UserID:  1,
+						MovieID: 2,
+						Slugs:   []string{"time-travel", "slow-burn", "ký-ức"},
+					}).Return(nil)
+					r.EXPECT().FindUsagesByMovieID(gomock.Any(), uint64(2)).Return([]*entity.TagUsage{
+						{Slug: "time-travel", UserCount: 2},
+						{Slug: "ký-ức", UserCount: 1},
+						{Slug: "slow-burn", UserCount: 1},
+					}, nil)
+				},
+			},
+			expected: testOutput{
+				cloud: &entity.MovieTagCloud{
+					MovieID: 2,
+					Tags: []*entity.TagUsage{
+						{Slug: "time-travel", UserCount: 2, Weight: 1},
+						{Slug: "ký-ức", UserCount: 1, Weight: 0.5},
+						{Slug: "slow-burn", UserCount: 1, Weight: 0.5},
+					},
+				},
+			},
+		},
+		{
+			name: "returns_bad_request_when_tag_has_no_letter_or_digit",
+			input: testInput{
+				args: usecase.AddMovieTagsParams{UserID: 1, MovieID: 2, Tags: []string{"time-travel", "--"}},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("tag %q must contain a letter or a digit", "--")),
+			},
+		},
+		{
+			name: "returns_bad_request_when_tag_is_too_long",
+			input: testInput{
+				args: usecase.AddMovieTagsParams{UserID: 1, MovieID: 2, Tags: []string{strings.Repeat("a", 51)}},
+			},
+			expected: testOutput{
+				err: httperrors.NewBadRequestError(fmt.Errorf("tag %q must be at most %d characters",
+					strings.Repeat("a", 51), 50)),
+			},
+		},
+		{
+			name: "returns_not_found_when_movie_does_not_exist",
+			input: testInput{
+				args: usecase.AddMovieTagsParams{UserID: 1, MovieID: 2, Tags: []string{"time-travel"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(nil, nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("movieRepository.FindByID: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_AddMovieTags_when_it_happened",
+			input: testInput{
+				args: usecase.AddMovieTagsParams{UserID: 1, MovieID: 2, Tags: []string{"time-travel"}},
+				mockMovieRepository: func(r *mock_repository.MockMovieRepository) {
+					r.EXPECT().FindByID(gomock.Any(), uint64(2)).Return(dummyMovie(2), nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().AddMovieTags(gomock.Any(), gomock.Any()).Return(fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("tagRepository.AddMovieTags: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieRepository := mock_repository.NewMockMovieRepository(ctrl)
+			if c.input.mockMovieRepository != nil {
+				c.input.mockMovieRepository(mockMovieRepository)
+			}
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+			mockTagRepository := mock_repository.NewMockTagRepository(ctrl)
+			if c.input.mockTagRepository != nil {
+				c.input.mockTagRepository(mockTagRepository)
+			}
+
+			u := usecase.NewTagUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), mockMovieRepository,
+				mockMovieSearcher, mockTagRepository, nil)
+			res, err := u.AddMovieTags(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+			assert.Equal(t, c.expected.cloud, res)
+		})
+	}
+}
+
+func (s *testTagUsecase) TestDeleteMovieTag() {
+	type testInput struct {
+		args              usecase.DeleteMovieTagParams
+		mockMovieSearcher func(*mock_repository.MockMovieSearcher)
+		mockTagRepository func(*mock_repository.MockTagRepository)
+	}
+
+	type testOutput struct {
+		err error
+	}
+
+	cases := []struct {
+		name     string
+		input    testInput
+		expected testOutput
+	}{
+		{
+			name: "deletes_tag_by_normalized_slug",
+			input: testInput{
+				args: usecase.DeleteMovieTagParams{UserID: 1, MovieID: 2, Slug: "Time Travel"},
+				mockMovieSearcher: func(r *mock_repository.MockMovieSearcher) {
+					r.EXPECT().IndexMovie(gomock.Any(), uint64(2)).Return(nil)
+				},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().DeleteMovieTag(gomock.Any(), repository.DeleteMovieTagParams{
+						UserID:  1,
+						MovieID: 2,
+						Slug:    "time-travel",
+					}).Return(int64(1), nil)
+				},
+			},
+			expected: testOutput{},
+		},
+		{
+			name: "returns_not_found_when_user_did_not_apply_tag",
+			input: testInput{
+				args: usecase.DeleteMovieTagParams{UserID: 1, MovieID: 2, Slug: "time-travel"},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().DeleteMovieTag(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewNotFoundError(fmt.Errorf("tagRepository.DeleteMovieTag: not found")),
+			},
+		},
+		{
+			name: "returns_error_of_DeleteMovieTag_when_it_happened",
+			input: testInput{
+				args: usecase.DeleteMovieTagParams{UserID: 1, MovieID: 2, Slug: "time-travel"},
+				mockTagRepository: func(r *mock_repository.MockTagRepository) {
+					r.EXPECT().DeleteMovieTag(gomock.Any(), gomock.Any()).Return(int64(0), fmt.Errorf("dummy error"))
+				},
+			},
+			expected: testOutput{
+				err: httperrors.NewInternalServerError(fmt.Errorf("tagRepository.DeleteMovieTag: %w",
+					fmt.Errorf("dummy error"))),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		s.T().Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMovieSearcher := mock_repository.NewMockMovieSearcher(ctrl)
+			if c.input.mockMovieSearcher != nil {
+				c.input.mockMovieSearcher(mockMovieSearcher)
+			}
+			mockTagRepository := mock_repository.NewMockTagRepository(ctrl)
+			c.input.mockTagRepository(mockTagRepository)
+
+			u := usecase.NewTagUsecase(config.Config{}, logger.NewApiLogger(&config.Config{}), nil, mockMovieSearcher,
+				mockTagRepository, nil)
+			err := u.DeleteMovieTag(context.Background(), c.input.args)
+			assert.Equal(t, c.expected.err, err)
+		})
+	}
+}
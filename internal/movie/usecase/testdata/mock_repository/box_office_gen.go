@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: box_office.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// MockBoxOfficeRepository is a mock of BoxOfficeRepository interface.
+type MockBoxOfficeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoxOfficeRepositoryMockRecorder
+}
+
+// MockBoxOfficeRepositoryMockRecorder is the mock recorder for MockBoxOfficeRepository.
+type MockBoxOfficeRepositoryMockRecorder struct {
+	mock *MockBoxOfficeRepository
+}
+
+// NewMockBoxOfficeRepository creates a new mock instance.
+func NewMockBoxOfficeRepository(ctrl *gomock.Controller) *MockBoxOfficeRepository {
+	mock := &MockBoxOfficeRepository{ctrl: ctrl}
+	mock.recorder = &MockBoxOfficeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoxOfficeRepository) EXPECT() *MockBoxOfficeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindGrossesByMovieID mocks base method.
+func (m *MockBoxOfficeRepository) FindGrossesByMovieID(ctx context.Context, movieID uint64) ([]*entity.BoxOfficeGross, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGrossesByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.BoxOfficeGross)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGrossesByMovieID indicates an expected call of FindGrossesByMovieID.
+func (mr *MockBoxOfficeRepositoryMockRecorder) FindGrossesByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGrossesByMovieID", reflect.TypeOf((*MockBoxOfficeRepository)(nil).FindGrossesByMovieID), ctx, movieID)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: collection.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockCollectionRepository is a mock of CollectionRepository interface.
+type MockCollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionRepositoryMockRecorder
+}
+
+// MockCollectionRepositoryMockRecorder is the mock recorder for MockCollectionRepository.
+type MockCollectionRepositoryMockRecorder struct {
+	mock *MockCollectionRepository
+}
+
+// NewMockCollectionRepository creates a new mock instance.
+func NewMockCollectionRepository(ctrl *gomock.Controller) *MockCollectionRepository {
+	mock := &MockCollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockCollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionRepository) EXPECT() *MockCollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockCollectionRepository) FindByID(ctx context.Context, collectionID uint64) (*entity.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, collectionID)
+	ret0, _ := ret[0].(*entity.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCollectionRepositoryMockRecorder) FindByID(ctx, collectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCollectionRepository)(nil).FindByID), ctx, collectionID)
+}
+
+// FindMoviesByCollectionID mocks base method.
+func (m *MockCollectionRepository) FindMoviesByCollectionID(ctx context.Context, args repository.FindMoviesByCollectionIDParams) (*repository.CollectionMoviePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoviesByCollectionID", ctx, args)
+	ret0, _ := ret[0].(*repository.CollectionMoviePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMoviesByCollectionID indicates an expected call of FindMoviesByCollectionID.
+func (mr *MockCollectionRepositoryMockRecorder) FindMoviesByCollectionID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoviesByCollectionID", reflect.TypeOf((*MockCollectionRepository)(nil).FindMoviesByCollectionID), ctx, args)
+}
+
+// FindSummaryByMovieID mocks base method.
+func (m *MockCollectionRepository) FindSummaryByMovieID(ctx context.Context, movieID uint64) (*entity.CollectionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSummaryByMovieID", ctx, movieID)
+	ret0, _ := ret[0].(*entity.CollectionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSummaryByMovieID indicates an expected call of FindSummaryByMovieID.
+func (mr *MockCollectionRepositoryMockRecorder) FindSummaryByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummaryByMovieID", reflect.TypeOf((*MockCollectionRepository)(nil).FindSummaryByMovieID), ctx, movieID)
+}
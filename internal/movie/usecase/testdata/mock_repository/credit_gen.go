@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: credit.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockCreditRepository is a mock of CreditRepository interface.
+type MockCreditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreditRepositoryMockRecorder
+}
+
+// MockCreditRepositoryMockRecorder is the mock recorder for MockCreditRepository.
+type MockCreditRepositoryMockRecorder struct {
+	mock *MockCreditRepository
+}
+
+// NewMockCreditRepository creates a new mock instance.
+func NewMockCreditRepository(ctrl *gomock.Controller) *MockCreditRepository {
+	mock := &MockCreditRepository{ctrl: ctrl}
+	mock.recorder = &MockCreditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreditRepository) EXPECT() *MockCreditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByMovieID mocks base method.
+func (m *MockCreditRepository) FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.Credit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.Credit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockCreditRepositoryMockRecorder) FindByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockCreditRepository)(nil).FindByMovieID), ctx, movieID)
+}
+
+// FindFilmographyByPersonID mocks base method.
+func (m *MockCreditRepository) FindFilmographyByPersonID(ctx context.Context, args repository.FindFilmographyParams) (*repository.FilmographyPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFilmographyByPersonID", ctx, args)
+	ret0, _ := ret[0].(*repository.FilmographyPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFilmographyByPersonID indicates an expected call of FindFilmographyByPersonID.
+func (mr *MockCreditRepositoryMockRecorder) FindFilmographyByPersonID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilmographyByPersonID", reflect.TypeOf((*MockCreditRepository)(nil).FindFilmographyByPersonID), ctx, args)
+}
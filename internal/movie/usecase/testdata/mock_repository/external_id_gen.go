@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: external_id.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockExternalIDRepository is a mock of ExternalIDRepository interface.
+type MockExternalIDRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalIDRepositoryMockRecorder
+}
+
+// MockExternalIDRepositoryMockRecorder is the mock recorder for MockExternalIDRepository.
+type MockExternalIDRepositoryMockRecorder struct {
+	mock *MockExternalIDRepository
+}
+
+// NewMockExternalIDRepository creates a new mock instance.
+func NewMockExternalIDRepository(ctrl *gomock.Controller) *MockExternalIDRepository {
+	mock := &MockExternalIDRepository{ctrl: ctrl}
+	mock.recorder = &MockExternalIDRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalIDRepository) EXPECT() *MockExternalIDRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByMovieID mocks base method.
+func (m *MockExternalIDRepository) FindByMovieID(ctx context.Context, movieID uint64) (entity.ExternalIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID)
+	ret0, _ := ret[0].(entity.ExternalIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockExternalIDRepositoryMockRecorder) FindByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockExternalIDRepository)(nil).FindByMovieID), ctx, movieID)
+}
+
+// FindMovieIDByExternalID mocks base method.
+func (m *MockExternalIDRepository) FindMovieIDByExternalID(ctx context.Context, args repository.FindMovieIDByExternalIDParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovieIDByExternalID", ctx, args)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMovieIDByExternalID indicates an expected call of FindMovieIDByExternalID.
+func (mr *MockExternalIDRepositoryMockRecorder) FindMovieIDByExternalID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovieIDByExternalID", reflect.TypeOf((*MockExternalIDRepository)(nil).FindMovieIDByExternalID), ctx, args)
+}
+
+// SetExternalID mocks base method.
+func (m *MockExternalIDRepository) SetExternalID(ctx context.Context, args repository.SetExternalIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExternalID", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExternalID indicates an expected call of SetExternalID.
+func (mr *MockExternalIDRepositoryMockRecorder) SetExternalID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalID", reflect.TypeOf((*MockExternalIDRepository)(nil).SetExternalID), ctx, args)
+}
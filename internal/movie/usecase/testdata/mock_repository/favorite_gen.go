@@ -9,7 +9,6 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
 )
 
@@ -36,6 +35,21 @@ func (m *MockFavoriteRepository) EXPECT() *MockFavoriteRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddFavoriteCollection mocks base method.
+func (m *MockFavoriteRepository) AddFavoriteCollection(ctx context.Context, args repository.AddFavoriteCollectionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavoriteCollection", ctx, args)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavoriteCollection indicates an expected call of AddFavoriteCollection.
+func (mr *MockFavoriteRepositoryMockRecorder) AddFavoriteCollection(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteCollection", reflect.TypeOf((*MockFavoriteRepository)(nil).AddFavoriteCollection), ctx, args)
+}
+
 // AddFavoriteMovie mocks base method.
 func (m *MockFavoriteRepository) AddFavoriteMovie(ctx context.Context, args repository.AddFavoriteMovieParams) error {
 	m.ctrl.T.Helper()
@@ -66,16 +80,16 @@ func (mr *MockFavoriteRepositoryMockRecorder) CheckIsFavoriteMovie(ctx, args int
 }
 
 // FindFavoriteMoviesByUserID mocks base method.
-func (m *MockFavoriteRepository) FindFavoriteMoviesByUserID(ctx context.Context, userID uint64) ([]*entity.Movie, error) {
+func (m *MockFavoriteRepository) FindFavoriteMoviesByUserID(ctx context.Context, args repository.FindFavoriteMoviesByUserIDParams) (*repository.MoviePage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFavoriteMoviesByUserID", ctx, userID)
-	ret0, _ := ret[0].([]*entity.Movie)
+	ret := m.ctrl.Call(m, "FindFavoriteMoviesByUserID", ctx, args)
+	ret0, _ := ret[0].(*repository.MoviePage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFavoriteMoviesByUserID indicates an expected call of FindFavoriteMoviesByUserID.
-func (mr *MockFavoriteRepositoryMockRecorder) FindFavoriteMoviesByUserID(ctx, userID interface{}) *gomock.Call {
+func (mr *MockFavoriteRepositoryMockRecorder) FindFavoriteMoviesByUserID(ctx, args interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFavoriteMoviesByUserID", reflect.TypeOf((*MockFavoriteRepository)(nil).FindFavoriteMoviesByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFavoriteMoviesByUserID", reflect.TypeOf((*MockFavoriteRepository)(nil).FindFavoriteMoviesByUserID), ctx, args)
 }
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
 )
 
 // MockMovieRepository is a mock of MovieRepository interface.
@@ -35,6 +36,35 @@ func (m *MockMovieRepository) EXPECT() *MockMovieRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateMovie mocks base method.
+func (m *MockMovieRepository) CreateMovie(ctx context.Context, args repository.MovieParams) (*entity.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMovie", ctx, args)
+	ret0, _ := ret[0].(*entity.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMovie indicates an expected call of CreateMovie.
+func (mr *MockMovieRepositoryMockRecorder) CreateMovie(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMovie", reflect.TypeOf((*MockMovieRepository)(nil).CreateMovie), ctx, args)
+}
+
+// DeleteMovie mocks base method.
+func (m *MockMovieRepository) DeleteMovie(ctx context.Context, movieID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMovie", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMovie indicates an expected call of DeleteMovie.
+func (mr *MockMovieRepositoryMockRecorder) DeleteMovie(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMovie", reflect.TypeOf((*MockMovieRepository)(nil).DeleteMovie), ctx, movieID)
+}
+
 // FindByID mocks base method.
 func (m *MockMovieRepository) FindByID(ctx context.Context, movieID uint64) (*entity.Movie, error) {
 	m.ctrl.T.Helper()
@@ -50,32 +80,47 @@ func (mr *MockMovieRepositoryMockRecorder) FindByID(ctx, movieID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMovieRepository)(nil).FindByID), ctx, movieID)
 }
 
-// FindByKeyword mocks base method.
-func (m *MockMovieRepository) FindByKeyword(ctx context.Context, keyword string) ([]*entity.Movie, error) {
+// FindMovies mocks base method.
+func (m *MockMovieRepository) FindMovies(ctx context.Context, args repository.FindMoviesParams) (*repository.MoviePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovies", ctx, args)
+	ret0, _ := ret[0].(*repository.MoviePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMovies indicates an expected call of FindMovies.
+func (mr *MockMovieRepositoryMockRecorder) FindMovies(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovies", reflect.TypeOf((*MockMovieRepository)(nil).FindMovies), ctx, args)
+}
+
+// RestoreMovie mocks base method.
+func (m *MockMovieRepository) RestoreMovie(ctx context.Context, movieID uint64) (*entity.Movie, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByKeyword", ctx, keyword)
-	ret0, _ := ret[0].([]*entity.Movie)
+	ret := m.ctrl.Call(m, "RestoreMovie", ctx, movieID)
+	ret0, _ := ret[0].(*entity.Movie)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByKeyword indicates an expected call of FindByKeyword.
-func (mr *MockMovieRepositoryMockRecorder) FindByKeyword(ctx, keyword interface{}) *gomock.Call {
+// RestoreMovie indicates an expected call of RestoreMovie.
+func (mr *MockMovieRepositoryMockRecorder) RestoreMovie(ctx, movieID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKeyword", reflect.TypeOf((*MockMovieRepository)(nil).FindByKeyword), ctx, keyword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMovie", reflect.TypeOf((*MockMovieRepository)(nil).RestoreMovie), ctx, movieID)
 }
 
-// FindPopularMovies mocks base method.
-func (m *MockMovieRepository) FindPopularMovies(ctx context.Context, limit uint) ([]*entity.Movie, error) {
+// UpdateMovie mocks base method.
+func (m *MockMovieRepository) UpdateMovie(ctx context.Context, args repository.UpdateMovieParams) (*entity.Movie, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPopularMovies", ctx, limit)
-	ret0, _ := ret[0].([]*entity.Movie)
+	ret := m.ctrl.Call(m, "UpdateMovie", ctx, args)
+	ret0, _ := ret[0].(*entity.Movie)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindPopularMovies indicates an expected call of FindPopularMovies.
-func (mr *MockMovieRepositoryMockRecorder) FindPopularMovies(ctx, limit interface{}) *gomock.Call {
+// UpdateMovie indicates an expected call of UpdateMovie.
+func (mr *MockMovieRepositoryMockRecorder) UpdateMovie(ctx, args interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPopularMovies", reflect.TypeOf((*MockMovieRepository)(nil).FindPopularMovies), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMovie", reflect.TypeOf((*MockMovieRepository)(nil).UpdateMovie), ctx, args)
 }
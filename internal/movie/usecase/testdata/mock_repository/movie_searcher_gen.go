@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie_searcher.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockMovieSearcher is a mock of MovieSearcher interface.
+type MockMovieSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieSearcherMockRecorder
+}
+
+// MockMovieSearcherMockRecorder is the mock recorder for MockMovieSearcher.
+type MockMovieSearcherMockRecorder struct {
+	mock *MockMovieSearcher
+}
+
+// NewMockMovieSearcher creates a new mock instance.
+func NewMockMovieSearcher(ctrl *gomock.Controller) *MockMovieSearcher {
+	mock := &MockMovieSearcher{ctrl: ctrl}
+	mock.recorder = &MockMovieSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieSearcher) EXPECT() *MockMovieSearcherMockRecorder {
+	return m.recorder
+}
+
+// IndexMovie mocks base method.
+func (m *MockMovieSearcher) IndexMovie(ctx context.Context, movieID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexMovie", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexMovie indicates an expected call of IndexMovie.
+func (mr *MockMovieSearcherMockRecorder) IndexMovie(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexMovie", reflect.TypeOf((*MockMovieSearcher)(nil).IndexMovie), ctx, movieID)
+}
+
+// RemoveMovie mocks base method.
+func (m *MockMovieSearcher) RemoveMovie(ctx context.Context, movieID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMovie", ctx, movieID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMovie indicates an expected call of RemoveMovie.
+func (mr *MockMovieSearcherMockRecorder) RemoveMovie(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMovie", reflect.TypeOf((*MockMovieSearcher)(nil).RemoveMovie), ctx, movieID)
+}
+
+// SearchMovies mocks base method.
+func (m *MockMovieSearcher) SearchMovies(ctx context.Context, args repository.FindMoviesParams) (*repository.MoviePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMovies", ctx, args)
+	ret0, _ := ret[0].(*repository.MoviePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMovies indicates an expected call of SearchMovies.
+func (mr *MockMovieSearcherMockRecorder) SearchMovies(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMovies", reflect.TypeOf((*MockMovieSearcher)(nil).SearchMovies), ctx, args)
+}
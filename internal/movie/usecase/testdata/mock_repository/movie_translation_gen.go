@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie_translation.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// MockMovieTranslationRepository is a mock of MovieTranslationRepository interface.
+type MockMovieTranslationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieTranslationRepositoryMockRecorder
+}
+
+// MockMovieTranslationRepositoryMockRecorder is the mock recorder for MockMovieTranslationRepository.
+type MockMovieTranslationRepositoryMockRecorder struct {
+	mock *MockMovieTranslationRepository
+}
+
+// NewMockMovieTranslationRepository creates a new mock instance.
+func NewMockMovieTranslationRepository(ctrl *gomock.Controller) *MockMovieTranslationRepository {
+	mock := &MockMovieTranslationRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieTranslationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieTranslationRepository) EXPECT() *MockMovieTranslationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByMovieIDs mocks base method.
+func (m *MockMovieTranslationRepository) FindByMovieIDs(ctx context.Context, movieIDs []uint64) ([]*entity.MovieTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieIDs", ctx, movieIDs)
+	ret0, _ := ret[0].([]*entity.MovieTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieIDs indicates an expected call of FindByMovieIDs.
+func (mr *MockMovieTranslationRepositoryMockRecorder) FindByMovieIDs(ctx, movieIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieIDs", reflect.TypeOf((*MockMovieTranslationRepository)(nil).FindByMovieIDs), ctx, movieIDs)
+}
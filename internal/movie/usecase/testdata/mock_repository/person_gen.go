@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: person.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// MockPersonRepository is a mock of PersonRepository interface.
+type MockPersonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonRepositoryMockRecorder
+}
+
+// MockPersonRepositoryMockRecorder is the mock recorder for MockPersonRepository.
+type MockPersonRepositoryMockRecorder struct {
+	mock *MockPersonRepository
+}
+
+// NewMockPersonRepository creates a new mock instance.
+func NewMockPersonRepository(ctrl *gomock.Controller) *MockPersonRepository {
+	mock := &MockPersonRepository{ctrl: ctrl}
+	mock.recorder = &MockPersonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonRepository) EXPECT() *MockPersonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockPersonRepository) FindByID(ctx context.Context, personID uint64) (*entity.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, personID)
+	ret0, _ := ret[0].(*entity.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPersonRepositoryMockRecorder) FindByID(ctx, personID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPersonRepository)(nil).FindByID), ctx, personID)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: post.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockPostRepository is a mock of PostRepository interface.
+type MockPostRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostRepositoryMockRecorder
+}
+
+// MockPostRepositoryMockRecorder is the mock recorder for MockPostRepository.
+type MockPostRepositoryMockRecorder struct {
+	mock *MockPostRepository
+}
+
+// NewMockPostRepository creates a new mock instance.
+func NewMockPostRepository(ctrl *gomock.Controller) *MockPostRepository {
+	mock := &MockPostRepository{ctrl: ctrl}
+	mock.recorder = &MockPostRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostRepository) EXPECT() *MockPostRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindRecentPosts mocks base method.
+func (m *MockPostRepository) FindRecentPosts(ctx context.Context, args repository.FindRecentPostsParams) ([]*entity.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentPosts", ctx, args)
+	ret0, _ := ret[0].([]*entity.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentPosts indicates an expected call of FindRecentPosts.
+func (mr *MockPostRepositoryMockRecorder) FindRecentPosts(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentPosts", reflect.TypeOf((*MockPostRepository)(nil).FindRecentPosts), ctx, args)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rating.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockRatingRepository is a mock of RatingRepository interface.
+type MockRatingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingRepositoryMockRecorder
+}
+
+// MockRatingRepositoryMockRecorder is the mock recorder for MockRatingRepository.
+type MockRatingRepositoryMockRecorder struct {
+	mock *MockRatingRepository
+}
+
+// NewMockRatingRepository creates a new mock instance.
+func NewMockRatingRepository(ctrl *gomock.Controller) *MockRatingRepository {
+	mock := &MockRatingRepository{ctrl: ctrl}
+	mock.recorder = &MockRatingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatingRepository) EXPECT() *MockRatingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRating mocks base method.
+func (m *MockRatingRepository) DeleteRating(ctx context.Context, args repository.DeleteRatingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRating", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRating indicates an expected call of DeleteRating.
+func (mr *MockRatingRepositoryMockRecorder) DeleteRating(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockRatingRepository)(nil).DeleteRating), ctx, args)
+}
+
+// FindByUserIDAndMovieID mocks base method.
+func (m *MockRatingRepository) FindByUserIDAndMovieID(ctx context.Context, args repository.FindRatingByUserIDAndMovieIDParams) (*entity.Rating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAndMovieID", ctx, args)
+	ret0, _ := ret[0].(*entity.Rating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAndMovieID indicates an expected call of FindByUserIDAndMovieID.
+func (mr *MockRatingRepositoryMockRecorder) FindByUserIDAndMovieID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndMovieID", reflect.TypeOf((*MockRatingRepository)(nil).FindByUserIDAndMovieID), ctx, args)
+}
+
+// UpsertRating mocks base method.
+func (m *MockRatingRepository) UpsertRating(ctx context.Context, args repository.UpsertRatingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRating", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRating indicates an expected call of UpsertRating.
+func (mr *MockRatingRepositoryMockRecorder) UpsertRating(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRating", reflect.TypeOf((*MockRatingRepository)(nil).UpsertRating), ctx, args)
+}
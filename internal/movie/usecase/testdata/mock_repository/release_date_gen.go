@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: release_date.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+)
+
+// MockReleaseDateRepository is a mock of ReleaseDateRepository interface.
+type MockReleaseDateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReleaseDateRepositoryMockRecorder
+}
+
+// MockReleaseDateRepositoryMockRecorder is the mock recorder for MockReleaseDateRepository.
+type MockReleaseDateRepositoryMockRecorder struct {
+	mock *MockReleaseDateRepository
+}
+
+// NewMockReleaseDateRepository creates a new mock instance.
+func NewMockReleaseDateRepository(ctrl *gomock.Controller) *MockReleaseDateRepository {
+	mock := &MockReleaseDateRepository{ctrl: ctrl}
+	mock.recorder = &MockReleaseDateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReleaseDateRepository) EXPECT() *MockReleaseDateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByMovieID mocks base method.
+func (m *MockReleaseDateRepository) FindByMovieID(ctx context.Context, movieID uint64) ([]*entity.ReleaseDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.ReleaseDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockReleaseDateRepositoryMockRecorder) FindByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockReleaseDateRepository)(nil).FindByMovieID), ctx, movieID)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFilterReport mocks base method.
+func (m *MockReportRepository) CreateFilterReport(ctx context.Context, args repository.CreateFilterReportParams) (*entity.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFilterReport", ctx, args)
+	ret0, _ := ret[0].(*entity.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFilterReport indicates an expected call of CreateFilterReport.
+func (mr *MockReportRepositoryMockRecorder) CreateFilterReport(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilterReport", reflect.TypeOf((*MockReportRepository)(nil).CreateFilterReport), ctx, args)
+}
+
+// CreateReport mocks base method.
+func (m *MockReportRepository) CreateReport(ctx context.Context, args repository.CreateReportParams) (*entity.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, args)
+	ret0, _ := ret[0].(*entity.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockReportRepositoryMockRecorder) CreateReport(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReportRepository)(nil).CreateReport), ctx, args)
+}
+
+// FindByUserIDAndTarget mocks base method.
+func (m *MockReportRepository) FindByUserIDAndTarget(ctx context.Context, args repository.FindReportByUserIDAndTargetParams) (*entity.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAndTarget", ctx, args)
+	ret0, _ := ret[0].(*entity.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAndTarget indicates an expected call of FindByUserIDAndTarget.
+func (mr *MockReportRepositoryMockRecorder) FindByUserIDAndTarget(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndTarget", reflect.TypeOf((*MockReportRepository)(nil).FindByUserIDAndTarget), ctx, args)
+}
+
+// FindReports mocks base method.
+func (m *MockReportRepository) FindReports(ctx context.Context, args repository.FindReportsParams) (*repository.ReportPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReports", ctx, args)
+	ret0, _ := ret[0].(*repository.ReportPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReports indicates an expected call of FindReports.
+func (mr *MockReportRepositoryMockRecorder) FindReports(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReports", reflect.TypeOf((*MockReportRepository)(nil).FindReports), ctx, args)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewRepository) CreateReview(ctx context.Context, args repository.CreateReviewParams) (*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, args)
+	ret0, _ := ret[0].(*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewRepositoryMockRecorder) CreateReview(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewRepository)(nil).CreateReview), ctx, args)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewRepository) DeleteReview(ctx context.Context, reviewID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewRepositoryMockRecorder) DeleteReview(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewRepository)(nil).DeleteReview), ctx, reviewID)
+}
+
+// FindByID mocks base method.
+func (m *MockReviewRepository) FindByID(ctx context.Context, reviewID uint64) (*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, reviewID)
+	ret0, _ := ret[0].(*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReviewRepositoryMockRecorder) FindByID(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReviewRepository)(nil).FindByID), ctx, reviewID)
+}
+
+// FindByMovieID mocks base method.
+func (m *MockReviewRepository) FindByMovieID(ctx context.Context, args repository.FindReviewsByMovieIDParams) (*repository.ReviewPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMovieID", ctx, args)
+	ret0, _ := ret[0].(*repository.ReviewPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMovieID indicates an expected call of FindByMovieID.
+func (mr *MockReviewRepositoryMockRecorder) FindByMovieID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMovieID", reflect.TypeOf((*MockReviewRepository)(nil).FindByMovieID), ctx, args)
+}
+
+// FindByUserIDAndMovieID mocks base method.
+func (m *MockReviewRepository) FindByUserIDAndMovieID(ctx context.Context, args repository.FindReviewByUserIDAndMovieIDParams) (*entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAndMovieID", ctx, args)
+	ret0, _ := ret[0].(*entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAndMovieID indicates an expected call of FindByUserIDAndMovieID.
+func (mr *MockReviewRepositoryMockRecorder) FindByUserIDAndMovieID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndMovieID", reflect.TypeOf((*MockReviewRepository)(nil).FindByUserIDAndMovieID), ctx, args)
+}
+
+// FindRevisions mocks base method.
+func (m *MockReviewRepository) FindRevisions(ctx context.Context, reviewID uint64) ([]*entity.ReviewRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRevisions", ctx, reviewID)
+	ret0, _ := ret[0].([]*entity.ReviewRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRevisions indicates an expected call of FindRevisions.
+func (mr *MockReviewRepositoryMockRecorder) FindRevisions(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRevisions", reflect.TypeOf((*MockReviewRepository)(nil).FindRevisions), ctx, reviewID)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewRepository) UpdateReview(ctx context.Context, args repository.UpdateReviewParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewRepositoryMockRecorder) UpdateReview(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewRepository)(nil).UpdateReview), ctx, args)
+}
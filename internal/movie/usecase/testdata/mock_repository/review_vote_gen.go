@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_vote.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockReviewVoteRepository is a mock of ReviewVoteRepository interface.
+type MockReviewVoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewVoteRepositoryMockRecorder
+}
+
+// MockReviewVoteRepositoryMockRecorder is the mock recorder for MockReviewVoteRepository.
+type MockReviewVoteRepositoryMockRecorder struct {
+	mock *MockReviewVoteRepository
+}
+
+// NewMockReviewVoteRepository creates a new mock instance.
+func NewMockReviewVoteRepository(ctrl *gomock.Controller) *MockReviewVoteRepository {
+	mock := &MockReviewVoteRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewVoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewVoteRepository) EXPECT() *MockReviewVoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteReviewVote mocks base method.
+func (m *MockReviewVoteRepository) DeleteReviewVote(ctx context.Context, args repository.DeleteReviewVoteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewVote", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewVote indicates an expected call of DeleteReviewVote.
+func (mr *MockReviewVoteRepositoryMockRecorder) DeleteReviewVote(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewVote", reflect.TypeOf((*MockReviewVoteRepository)(nil).DeleteReviewVote), ctx, args)
+}
+
+// FindByUserIDAndReviewID mocks base method.
+func (m *MockReviewVoteRepository) FindByUserIDAndReviewID(ctx context.Context, args repository.FindReviewVoteByUserIDAndReviewIDParams) (*entity.ReviewVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAndReviewID", ctx, args)
+	ret0, _ := ret[0].(*entity.ReviewVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAndReviewID indicates an expected call of FindByUserIDAndReviewID.
+func (mr *MockReviewVoteRepositoryMockRecorder) FindByUserIDAndReviewID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndReviewID", reflect.TypeOf((*MockReviewVoteRepository)(nil).FindByUserIDAndReviewID), ctx, args)
+}
+
+// UpsertReviewVote mocks base method.
+func (m *MockReviewVoteRepository) UpsertReviewVote(ctx context.Context, args repository.UpsertReviewVoteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReviewVote", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertReviewVote indicates an expected call of UpsertReviewVote.
+func (mr *MockReviewVoteRepositoryMockRecorder) UpsertReviewVote(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReviewVote", reflect.TypeOf((*MockReviewVoteRepository)(nil).UpsertReviewVote), ctx, args)
+}
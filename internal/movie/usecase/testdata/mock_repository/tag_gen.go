@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/samthehai/ml-backend-test-samthehai/internal/entity"
+	repository "github.com/samthehai/ml-backend-test-samthehai/internal/movie/usecase/repository"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMovieTags mocks base method.
+func (m *MockTagRepository) AddMovieTags(ctx context.Context, args repository.AddMovieTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMovieTags", ctx, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMovieTags indicates an expected call of AddMovieTags.
+func (mr *MockTagRepositoryMockRecorder) AddMovieTags(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMovieTags", reflect.TypeOf((*MockTagRepository)(nil).AddMovieTags), ctx, args)
+}
+
+// DeleteMovieTag mocks base method.
+func (m *MockTagRepository) DeleteMovieTag(ctx context.Context, args repository.DeleteMovieTagParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMovieTag", ctx, args)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMovieTag indicates an expected call of DeleteMovieTag.
+func (mr *MockTagRepositoryMockRecorder) DeleteMovieTag(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMovieTag", reflect.TypeOf((*MockTagRepository)(nil).DeleteMovieTag), ctx, args)
+}
+
+// FindBySlug mocks base method.
+func (m *MockTagRepository) FindBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockTagRepositoryMockRecorder) FindBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockTagRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// FindMoviesByTagID mocks base method.
+func (m *MockTagRepository) FindMoviesByTagID(ctx context.Context, args repository.FindMoviesByTagIDParams) (*repository.TaggedMoviePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoviesByTagID", ctx, args)
+	ret0, _ := ret[0].(*repository.TaggedMoviePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMoviesByTagID indicates an expected call of FindMoviesByTagID.
+func (mr *MockTagRepositoryMockRecorder) FindMoviesByTagID(ctx, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoviesByTagID", reflect.TypeOf((*MockTagRepository)(nil).FindMoviesByTagID), ctx, args)
+}
+
+// FindUsagesByMovieID mocks base method.
+func (m *MockTagRepository) FindUsagesByMovieID(ctx context.Context, movieID uint64) ([]*entity.TagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsagesByMovieID", ctx, movieID)
+	ret0, _ := ret[0].([]*entity.TagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsagesByMovieID indicates an expected call of FindUsagesByMovieID.
+func (mr *MockTagRepositoryMockRecorder) FindUsagesByMovieID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsagesByMovieID", reflect.TypeOf((*MockTagRepository)(nil).FindUsagesByMovieID), ctx, movieID)
+}
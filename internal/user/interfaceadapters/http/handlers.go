@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/samthehai/ml-backend-test-samthehai/config"
+	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	handlersusecase "github.com/samthehai/ml-backend-test-samthehai/internal/user/interfaceadapters/http/usecase"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/user/usecase"
 	"github.com/samthehai/ml-backend-test-samthehai/pkg/httperrors"
@@ -14,13 +15,15 @@ import (
 )
 
 type userHandlers struct {
-	cfg         *config.Config
-	userUsecase handlersusecase.UserUsecase
-	logger      logger.Logger
+	cfg              *config.Config
+	userUsecase      handlersusecase.UserUsecase
+	logger           logger.Logger
+	getCurrentUserFn func(c echo.Context) (*entity.User, error)
 }
 
-func NewUserHandlers(cfg *config.Config, userUsecase handlersusecase.UserUsecase, log logger.Logger) *userHandlers {
-	return &userHandlers{cfg: cfg, userUsecase: userUsecase, logger: log}
+func NewUserHandlers(cfg *config.Config, userUsecase handlersusecase.UserUsecase, log logger.Logger,
+	getCurrentUserFn func(c echo.Context) (*entity.User, error)) *userHandlers {
+	return &userHandlers{cfg: cfg, userUsecase: userUsecase, logger: log, getCurrentUserFn: getCurrentUserFn}
 }
 
 type registerRequest struct {
@@ -120,3 +123,137 @@ func (h *userHandlers) Login() echo.HandlerFunc {
 		})
 	}
 }
+
+// GetPreferences godoc
+// @Summary Get preferences of current login user
+// @Description Get preferences of current login user.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Users
+// @Accept json
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.UserPreferences
+// @Failure 401 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /users/me/preferences [get]
+func (h *userHandlers) GetPreferences() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, entity.UserPreferences{
+			RevealSpoilers: currentUser.RevealSpoilers,
+			Country:        currentUser.Country,
+		})
+	}
+}
+
+type updatePreferencesRequest struct {
+	RevealSpoilers *bool   `json:"reveal_spoilers" validate:"required"`
+	Country        *string `json:"country" validate:"omitempty,len=2,alpha"`
+}
+
+// UpdatePreferences godoc
+// @Summary Update preferences of current login user
+// @Description Update preferences of current login user, reveal_spoilers is the default of reveal_spoilers query parameter of reviews api.
+// 							country is the default of country query parameter of movies api, it is cleared when it is not given.
+// 							If user is not login returns http.StatusUnauthorized.
+// @Tags Users
+// @Accept json
+// @Param updatePreferencesRequest body updatePreferencesRequest true "updatePreferencesRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entity.UserPreferences
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /users/me/preferences [put]
+func (h *userHandlers) UpdatePreferences() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &updatePreferencesRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		currentUser, err := h.getCurrentUserFn(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		preferences, err := h.userUsecase.UpdatePreferences(ctx, usecase.UpdatePreferencesParams{
+			UserID:         currentUser.ID,
+			RevealSpoilers: *req.RevealSpoilers,
+			Country:        req.Country,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, preferences)
+	}
+}
+
+type setCriticRequest struct {
+	UserID   uint64 `param:"id"`
+	IsCritic *bool  `json:"is_critic" validate:"required"`
+}
+
+type setCriticResponse struct {
+	ID       uint64 `json:"id"`
+	Username string `json:"username"`
+	IsCritic bool   `json:"is_critic"`
+}
+
+// SetCritic godoc
+// @Summary Set whether a user is a verified critic
+// @Description Set whether a user is a verified critic, the ratings of critics make the critic score of the movies
+// 							and the ratings of the other users make the audience score. Only the admins can call it.
+// 							If the user is not exist returns http.StatusNotFound.
+// @Tags Admin
+// @Accept json
+// @Param id path uint64 true "user id"
+// @Param setCriticRequest body setCriticRequest true "setCriticRequest body"
+// @Param Authorization header string true "Format: Bearer accesstoken - which can be get when call /api/v1/login api"
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} setCriticResponse
+// @Failure 400 {object} httperrors.RestError
+// @Failure 401 {object} httperrors.RestError
+// @Failure 403 {object} httperrors.RestError
+// @Failure 404 {object} httperrors.RestError
+// @Failure 500 {object} httperrors.RestError
+// @Router /admin/users/{id}/critic [put]
+func (h *userHandlers) SetCritic() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := &setCriticRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		ctx := utils.GetRequestCtx(c)
+		user, err := h.userUsecase.SetCritic(ctx, usecase.SetCriticParams{
+			UserID:   req.UserID,
+			IsCritic: *req.IsCritic,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httperrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, setCriticResponse{
+			ID:       user.ID,
+			Username: user.Username,
+			IsCritic: user.IsCritic,
+		})
+	}
+}
@@ -10,4 +10,6 @@ import (
 type UserUsecase interface {
 	Register(ctx context.Context, args usecase.RegisterParams) (*entity.User, error)
 	Login(ctx context.Context, args usecase.LoginParams) (*entity.UserWithAccessToken, error)
+	UpdatePreferences(ctx context.Context, args usecase.UpdatePreferencesParams) (*entity.UserPreferences, error)
+	SetCritic(ctx context.Context, args usecase.SetCriticParams) (*entity.User, error)
 }
@@ -7,6 +7,10 @@ type User struct {
 	Username       string    `json:"username" db:"username"`
 	Email          string    `json:"email" db:"email"`
 	HashedPassword string    `json:"hashed_password" db:"hashed_password"`
+	Role           string    `json:"role" db:"role"`
+	RevealSpoilers bool      `json:"reveal_spoilers" db:"reveal_spoilers"`
+	Country        *string   `json:"country" db:"country"`
+	IsCritic       bool      `json:"is_critic" db:"is_critic"`
 	CreatedAt      time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/user/usecase/repository"
 )
@@ -19,7 +20,7 @@ func NewUserRepository(connManager ConnManager) *userRepository {
 }
 
 const registerQuery = `INSERT INTO users(username, email, hashed_password) VALUES (?,?,?)`
-const findByID = `SELECT id, username, email, hashed_password FROM users WHERE id = ?`
+const findByID = `SELECT id, username, email, hashed_password, role, reveal_spoilers, country, is_critic FROM users WHERE id = ?`
 
 func (r *userRepository) Register(ctx context.Context, args repository.RegisterParams) (*entity.User, error) {
 	res, err := r.connManager.GetWriter().ExecContext(ctx, registerQuery, args.Username, args.Email, args.HashedPassword)
@@ -37,15 +38,21 @@ func (r *userRepository) Register(ctx context.Context, args repository.RegisterP
 		return nil, fmt.Errorf("userRepository.Register.QueryRowxContext: %w", err)
 	}
 
-	return &entity.User{
-		ID:             u.ID,
-		Username:       u.Username,
-		Email:          u.Email,
-		HashedPassword: u.HashedPassword,
-	}, nil
+	return u.toEntity(), nil
+}
+
+func (r *userRepository) FindByID(ctx context.Context, userID uint64) (*entity.User, error) {
+	foundUser := &User{}
+	if err := r.connManager.GetReader().QueryRowxContext(ctx, findByID, userID).StructScan(foundUser); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("userRepository.FindByID.QueryRowxContext: %w", err)
+	}
+	return foundUser.toEntity(), nil
 }
 
-const findByEmail = `SELECT id, username, email, hashed_password FROM users WHERE email = ?`
+const findByEmail = `SELECT id, username, email, hashed_password, role, reveal_spoilers, country, is_critic FROM users WHERE email = ?`
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	foundUser := &User{}
@@ -55,10 +62,77 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity
 		}
 		return nil, fmt.Errorf("userRepository.FindByEmail.QueryRowxContext: %w", err)
 	}
+	return foundUser.toEntity(), nil
+}
+
+const updatePreferencesQuery = `UPDATE users SET reveal_spoilers = ?, country = ? WHERE id = ?`
+
+func (r *userRepository) UpdatePreferences(ctx context.Context, args repository.UpdatePreferencesParams) error {
+	if _, err := r.connManager.GetWriter().ExecContext(ctx, updatePreferencesQuery, args.RevealSpoilers,
+		args.Country, args.UserID); err != nil {
+		return fmt.Errorf("userRepository.UpdatePreferences.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+const lockCriticQuery = `SELECT is_critic FROM users WHERE id = ? FOR UPDATE`
+
+const updateCriticQuery = `UPDATE users SET is_critic = ? WHERE id = ?`
+
+// addCriticRatingStatsQuery counts every rating of the user in movie_critic_rating_stats
+const addCriticRatingStatsQuery = `INSERT INTO movie_critic_rating_stats(movie_id, rating_count, rating_sum)
+SELECT movie_id, 1, score FROM ratings WHERE user_id = ?
+ON DUPLICATE KEY UPDATE rating_count = movie_critic_rating_stats.rating_count + 1,
+rating_sum = movie_critic_rating_stats.rating_sum + ratings.score`
+
+// removeCriticRatingStatsQuery takes every rating of the user out of movie_critic_rating_stats
+const removeCriticRatingStatsQuery = `UPDATE movie_critic_rating_stats
+INNER JOIN ratings ON movie_critic_rating_stats.movie_id = ratings.movie_id
+SET movie_critic_rating_stats.rating_count = movie_critic_rating_stats.rating_count - 1,
+movie_critic_rating_stats.rating_sum = movie_critic_rating_stats.rating_sum - ratings.score
+WHERE ratings.user_id = ?`
+
+// SetCritic changes whether the user is a critic and moves the ratings of the user into or out of the critic
+// aggregates of the movies in the same transaction, the user row is locked so no rating of the user is counted
+// with the old flag meanwhile
+func (r *userRepository) SetCritic(ctx context.Context, args repository.SetCriticParams) error {
+	return withTransaction(ctx, r.connManager.GetWriter(), func(tx *sqlx.Tx) error {
+		var isCritic bool
+		if err := tx.QueryRowxContext(ctx, lockCriticQuery, args.UserID).Scan(&isCritic); err != nil {
+			return fmt.Errorf("userRepository.SetCritic.QueryRowxContext: %w", err)
+		}
+
+		if isCritic == args.IsCritic {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, updateCriticQuery, args.IsCritic, args.UserID); err != nil {
+			return fmt.Errorf("userRepository.SetCritic.ExecContext: %w", err)
+		}
+
+		statsQuery := removeCriticRatingStatsQuery
+		if args.IsCritic {
+			statsQuery = addCriticRatingStatsQuery
+		}
+
+		if _, err := tx.ExecContext(ctx, statsQuery, args.UserID); err != nil {
+			return fmt.Errorf("userRepository.SetCritic.ExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (u *User) toEntity() *entity.User {
 	return &entity.User{
-		ID:             foundUser.ID,
-		Username:       foundUser.Username,
-		Email:          foundUser.Email,
-		HashedPassword: foundUser.HashedPassword,
-	}, nil
+		ID:             u.ID,
+		Username:       u.Username,
+		Email:          u.Email,
+		HashedPassword: u.HashedPassword,
+		Role:           entity.UserRole(u.Role),
+		RevealSpoilers: u.RevealSpoilers,
+		Country:        u.Country,
+		IsCritic:       u.IsCritic,
+	}
 }
@@ -12,7 +12,23 @@ type RegisterParams struct {
 	HashedPassword string `json:"hashed_password"`
 }
 
+type UpdatePreferencesParams struct {
+	UserID         uint64  `json:"user_id"`
+	RevealSpoilers bool    `json:"reveal_spoilers"`
+	Country        *string `json:"country"`
+}
+
+// SetCriticParams makes the user a critic or an audience member, the ratings of the user are moved between
+// the critic and the audience aggregates of the movies
+type SetCriticParams struct {
+	UserID   uint64 `json:"user_id"`
+	IsCritic bool   `json:"is_critic"`
+}
+
 type UserRepository interface {
 	Register(ctx context.Context, args RegisterParams) (*entity.User, error)
+	FindByID(ctx context.Context, userID uint64) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
+	UpdatePreferences(ctx context.Context, args UpdatePreferencesParams) error
+	SetCritic(ctx context.Context, args SetCriticParams) error
 }
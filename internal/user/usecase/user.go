@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/samthehai/ml-backend-test-samthehai/config"
 	"github.com/samthehai/ml-backend-test-samthehai/internal/entity"
@@ -99,3 +100,55 @@ func (u *userUsecase) GetUserByEmail(ctx context.Context, email string) (*entity
 
 	return user, nil
 }
+
+// UpdatePreferencesParams replaces every preference of the user, Country is cleared when it is nil
+type UpdatePreferencesParams struct {
+	UserID         uint64  `json:"user_id"`
+	RevealSpoilers bool    `json:"reveal_spoilers"`
+	Country        *string `json:"country"`
+}
+
+func (u *userUsecase) UpdatePreferences(ctx context.Context, args UpdatePreferencesParams) (*entity.UserPreferences, error) {
+	if args.Country != nil {
+		country := strings.ToUpper(*args.Country)
+		args.Country = &country
+	}
+
+	if err := u.userRepository.UpdatePreferences(ctx, repository.UpdatePreferencesParams{
+		UserID:         args.UserID,
+		RevealSpoilers: args.RevealSpoilers,
+		Country:        args.Country,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("userUsecase.UpdatePreferences.userRepository.UpdatePreferences: %w", err))
+	}
+
+	return &entity.UserPreferences{RevealSpoilers: args.RevealSpoilers, Country: args.Country}, nil
+}
+
+type SetCriticParams struct {
+	UserID   uint64 `json:"user_id"`
+	IsCritic bool   `json:"is_critic"`
+}
+
+// SetCritic makes the user a verified critic or takes the critic status back, it is used by the admins
+func (u *userUsecase) SetCritic(ctx context.Context, args SetCriticParams) (*entity.User, error) {
+	user, err := u.userRepository.FindByID(ctx, args.UserID)
+	if err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("userUsecase.SetCritic.FindByID: %w", err))
+	}
+
+	if user == nil {
+		return nil, httperrors.NewNotFoundError(fmt.Errorf("userUsecase.SetCritic.FindByID: not found"))
+	}
+
+	if err := u.userRepository.SetCritic(ctx, repository.SetCriticParams{
+		UserID:   args.UserID,
+		IsCritic: args.IsCritic,
+	}); err != nil {
+		return nil, httperrors.NewInternalServerError(fmt.Errorf("userUsecase.SetCritic.userRepository.SetCritic: %w", err))
+	}
+
+	user.IsCritic = args.IsCritic
+
+	return user, nil
+}
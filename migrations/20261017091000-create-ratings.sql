@@ -0,0 +1,40 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `ratings` (
+  `user_id` BIGINT UNSIGNED NOT NULL,
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `score` TINYINT UNSIGNED NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`user_id`, `movie_id`),
+  CONSTRAINT `fk_ratings_user_id_to_users_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `fk_ratings_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`),
+  CONSTRAINT `check_ratings_score` CHECK (`score` BETWEEN 1 AND 10)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- movie_rating_stats keeps the rating aggregates of every movie, it is updated in the same
+-- transaction as the ratings table so reading a movie never needs to aggregate ratings
+CREATE TABLE IF NOT EXISTS `movie_rating_stats` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `rating_count` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_sum` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_1` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_2` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_3` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_4` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_5` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_6` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_7` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_8` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_9` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_10` INT UNSIGNED NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (`movie_id`),
+  CONSTRAINT `fk_movie_rating_stats_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_rating_stats`;
+DROP TABLE IF EXISTS `ratings`;
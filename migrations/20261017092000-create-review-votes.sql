@@ -0,0 +1,24 @@
+
+-- +migrate Up
+-- helpful_count and not_helpful_count are updated in the same transaction as the review_votes table
+-- so reviews can be sorted by helpfulness without aggregating the votes
+ALTER TABLE `reviews`
+  ADD COLUMN `helpful_count` INT UNSIGNED NOT NULL DEFAULT 0 AFTER `content`,
+  ADD COLUMN `not_helpful_count` INT UNSIGNED NOT NULL DEFAULT 0 AFTER `helpful_count`;
+
+CREATE TABLE IF NOT EXISTS `review_votes` (
+  `user_id` BIGINT UNSIGNED NOT NULL,
+  `review_id` BIGINT UNSIGNED NOT NULL,
+  `helpful` BOOLEAN NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`user_id`, `review_id`),
+  CONSTRAINT `fk_review_votes_user_id_to_users_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `fk_review_votes_review_id_to_reviews_id` FOREIGN KEY (`review_id`) REFERENCES `reviews` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `review_votes`;
+ALTER TABLE `reviews` DROP COLUMN `not_helpful_count`, DROP COLUMN `helpful_count`;
@@ -0,0 +1,25 @@
+
+-- +migrate Up
+-- depth is 1 for the comments on a review and the depth of the parent + 1 for the replies,
+-- deleted comments are kept (with empty content) so the replies under them are not lost
+CREATE TABLE IF NOT EXISTS `review_comments` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `review_id` BIGINT UNSIGNED NOT NULL,
+  `user_id` BIGINT UNSIGNED NOT NULL,
+  `parent_id` BIGINT UNSIGNED,
+  `depth` TINYINT UNSIGNED NOT NULL,
+  `content` TEXT NOT NULL,
+  `deleted_at` TIMESTAMP NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  INDEX `index_review_comments_review_id_parent_id` (`review_id`, `parent_id`),
+  CONSTRAINT `fk_review_comments_user_id_to_users_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `fk_review_comments_review_id_to_reviews_id` FOREIGN KEY (`review_id`) REFERENCES `reviews` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_review_comments_parent_id_to_review_comments_id` FOREIGN KEY (`parent_id`) REFERENCES `review_comments` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `review_comments`;
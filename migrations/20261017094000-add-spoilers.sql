@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE `reviews` ADD COLUMN `is_spoiler` BOOLEAN NOT NULL DEFAULT FALSE AFTER `content`;
+
+-- reveal_spoilers is the default of the reveal_spoilers query parameter for the user
+ALTER TABLE `users` ADD COLUMN `reveal_spoilers` BOOLEAN NOT NULL DEFAULT FALSE AFTER `hashed_password`;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `reveal_spoilers`;
+ALTER TABLE `reviews` DROP COLUMN `is_spoiler`;
@@ -0,0 +1,47 @@
+-- +migrate Up
+ALTER TABLE `users` ADD COLUMN `role` VARCHAR(20) NOT NULL DEFAULT 'user' AFTER `hashed_password`;
+
+-- hidden reviews and comments are left out of every public listing until a moderator restores them
+ALTER TABLE `reviews` ADD COLUMN `moderation_status` VARCHAR(20) NOT NULL DEFAULT 'visible' AFTER `is_spoiler`;
+ALTER TABLE `review_comments` ADD COLUMN `moderation_status` VARCHAR(20) NOT NULL DEFAULT 'visible' AFTER `content`;
+
+-- target_id is the id of a review or a comment depending on target_type, there is no foreign key
+-- so the reports are kept when the reported content is deleted
+CREATE TABLE IF NOT EXISTS `content_reports` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT UNSIGNED NOT NULL,
+  `target_type` VARCHAR(20) NOT NULL,
+  `target_id` BIGINT UNSIGNED NOT NULL,
+  `reason` VARCHAR(1000) NOT NULL,
+  `status` VARCHAR(20) NOT NULL DEFAULT 'open',
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_content_reports_user_id_target` (`user_id`, `target_type`, `target_id`),
+  INDEX `index_content_reports_status_target` (`status`, `target_type`, `target_id`),
+  CONSTRAINT `fk_content_reports_user_id_to_users_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `moderation_actions` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `moderator_id` BIGINT UNSIGNED NOT NULL,
+  `target_type` VARCHAR(20) NOT NULL,
+  `target_id` BIGINT UNSIGNED NOT NULL,
+  `action` VARCHAR(20) NOT NULL,
+  `note` VARCHAR(1000) NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  INDEX `index_moderation_actions_target` (`target_type`, `target_id`),
+  CONSTRAINT `fk_moderation_actions_moderator_id_to_users_id` FOREIGN KEY (`moderator_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `moderation_actions`;
+DROP TABLE IF EXISTS `content_reports`;
+ALTER TABLE `review_comments` DROP COLUMN `moderation_status`;
+ALTER TABLE `reviews` DROP COLUMN `moderation_status`;
+ALTER TABLE `users` DROP COLUMN `role`;
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- the reports made by the content filters have no reporter
+ALTER TABLE `content_reports` MODIFY COLUMN `user_id` BIGINT UNSIGNED NULL;
+
+-- the content filters look up the recent posts of a user
+ALTER TABLE `reviews` ADD INDEX `index_reviews_user_id_created_at` (`user_id`, `created_at`);
+ALTER TABLE `review_comments` ADD INDEX `index_review_comments_user_id_created_at` (`user_id`, `created_at`);
+
+-- +migrate Down
+ALTER TABLE `review_comments` DROP INDEX `index_review_comments_user_id_created_at`;
+ALTER TABLE `reviews` DROP INDEX `index_reviews_user_id_created_at`;
+DELETE FROM `content_reports` WHERE `user_id` IS NULL;
+ALTER TABLE `content_reports` MODIFY COLUMN `user_id` BIGINT UNSIGNED NOT NULL;
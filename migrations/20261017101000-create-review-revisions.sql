@@ -0,0 +1,23 @@
+-- +migrate Up
+-- edited_at is set when the author edits the title, content or spoiler flag of the review, updated_at can not
+-- tell it since it also changes with the votes
+ALTER TABLE `reviews` ADD COLUMN `edited_at` TIMESTAMP NULL AFTER `not_helpful_count`;
+
+-- review_revisions keeps the previous versions of the edited reviews, created_at is when the version was written
+CREATE TABLE IF NOT EXISTS `review_revisions` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `review_id` BIGINT UNSIGNED NOT NULL,
+  `title` VARCHAR(255) NOT NULL,
+  `content` TEXT NOT NULL,
+  `is_spoiler` BOOLEAN NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (`id`),
+  INDEX `index_review_revisions_review_id` (`review_id`),
+  CONSTRAINT `fk_review_revisions_review_id_to_reviews_id` FOREIGN KEY (`review_id`) REFERENCES `reviews` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `review_revisions`;
+ALTER TABLE `reviews` DROP COLUMN `edited_at`;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- is_critic is set by the admins for the verified critics, their ratings are also counted in movie_critic_rating_stats
+ALTER TABLE `users` ADD COLUMN `is_critic` BOOLEAN NOT NULL DEFAULT FALSE AFTER `role`;
+
+-- movie_critic_rating_stats keeps the rating aggregates of the critics for every movie, the audience aggregates
+-- are the differences from movie_rating_stats. It is updated in the same transaction as the ratings table and when
+-- a user becomes or stops being a critic
+CREATE TABLE IF NOT EXISTS `movie_critic_rating_stats` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `rating_count` INT UNSIGNED NOT NULL DEFAULT 0,
+  `rating_sum` INT UNSIGNED NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (`movie_id`),
+  CONSTRAINT `fk_movie_critic_rating_stats_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_critic_rating_stats`;
+ALTER TABLE `users` DROP COLUMN `is_critic`;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- deleted_at is set when an admin deletes the movie, the deleted movies are left out of every read query
+-- and can be restored by clearing it
+ALTER TABLE `movies` ADD COLUMN `deleted_at` TIMESTAMP NULL AFTER `updated_at`;
+CREATE INDEX `index_movies_deleted_at` ON `movies` (`deleted_at`);
+
+-- +migrate Down
+ALTER TABLE `movies` DROP INDEX `index_movies_deleted_at`;
+ALTER TABLE `movies` DROP COLUMN `deleted_at`;
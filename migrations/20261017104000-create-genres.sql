@@ -0,0 +1,51 @@
+-- +migrate Up
+-- slug is the identifier of the genre which is used by the genre filter of the apis
+CREATE TABLE IF NOT EXISTS `genres` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(100) NOT NULL,
+  `slug` VARCHAR(100) NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_genres_slug` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `movie_genres` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `genre_id` BIGINT UNSIGNED NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`movie_id`, `genre_id`),
+  INDEX `index_movie_genres_genre_id` (`genre_id`),
+  CONSTRAINT `fk_movie_genres_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_movie_genres_genre_id_to_genres_id` FOREIGN KEY (`genre_id`) REFERENCES `genres` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+INSERT INTO `genres` (`name`, `slug`)
+VALUES
+  ('Action', 'action'),
+  ('Adventure', 'adventure'),
+  ('Animation', 'animation'),
+  ('Comedy', 'comedy'),
+  ('Crime', 'crime'),
+  ('Documentary', 'documentary'),
+  ('Drama', 'drama'),
+  ('Family', 'family'),
+  ('Fantasy', 'fantasy'),
+  ('History', 'history'),
+  ('Horror', 'horror'),
+  ('Music', 'music'),
+  ('Mystery', 'mystery'),
+  ('Romance', 'romance'),
+  ('Science Fiction', 'science-fiction'),
+  ('Thriller', 'thriller'),
+  ('TV Movie', 'tv-movie'),
+  ('War', 'war'),
+  ('Western', 'western');
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_genres`;
+DROP TABLE IF EXISTS `genres`;
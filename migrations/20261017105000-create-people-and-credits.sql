@@ -0,0 +1,39 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `people` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `biography` VARCHAR(5000) DEFAULT NULL,
+  `profile_path` VARCHAR(2048) DEFAULT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  FULLTEXT INDEX `fulltext_people` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- role is the job of the person in the movie such as Actor or Director and department groups the roles,
+-- the credits of the Acting department are the cast and the others are the crew. character_name is only set
+-- for the cast and billing_order is the order in which the credits are listed
+CREATE TABLE IF NOT EXISTS `credits` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `person_id` BIGINT UNSIGNED NOT NULL,
+  `role` VARCHAR(100) NOT NULL,
+  `department` VARCHAR(100) NOT NULL,
+  `character_name` VARCHAR(255) DEFAULT NULL,
+  `billing_order` INT UNSIGNED NOT NULL DEFAULT 0,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  INDEX `index_credits_movie_id_billing_order` (`movie_id`, `billing_order`),
+  INDEX `index_credits_person_id` (`person_id`),
+  CONSTRAINT `fk_credits_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_credits_person_id_to_people_id` FOREIGN KEY (`person_id`) REFERENCES `people` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `credits`;
+DROP TABLE IF EXISTS `people`;
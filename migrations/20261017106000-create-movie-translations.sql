@@ -0,0 +1,19 @@
+-- +migrate Up
+-- language is a BCP 47 language tag such as vi or pt-BR, the translated title and overview replace the original
+-- ones of the movie for the users who prefer the language
+CREATE TABLE IF NOT EXISTS `movie_translations` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `language` VARCHAR(35) NOT NULL,
+  `title` VARCHAR(255) NOT NULL,
+  `overview` VARCHAR(1000) DEFAULT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`movie_id`, `language`),
+  FULLTEXT INDEX `fulltext_movie_translations` (`title`, `overview`),
+  CONSTRAINT `fk_movie_translations_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_translations`;
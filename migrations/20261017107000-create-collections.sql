@@ -0,0 +1,32 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `collections` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `overview` VARCHAR(5000) DEFAULT NULL,
+  `poster_path` VARCHAR(2048) DEFAULT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- position is the order of the movie in the collection starting from 1, a movie belongs to at most one collection
+CREATE TABLE IF NOT EXISTS `collection_movies` (
+  `collection_id` BIGINT UNSIGNED NOT NULL,
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `position` INT UNSIGNED NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`collection_id`, `movie_id`),
+  UNIQUE INDEX `unique_collection_movies_collection_id_position` (`collection_id`, `position`),
+  UNIQUE INDEX `unique_collection_movies_movie_id` (`movie_id`),
+  CONSTRAINT `fk_collection_movies_collection_id_to_collections_id` FOREIGN KEY (`collection_id`) REFERENCES `collections` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_collection_movies_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `collection_movies`;
+DROP TABLE IF EXISTS `collections`;
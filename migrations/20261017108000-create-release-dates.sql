@@ -0,0 +1,28 @@
+-- +migrate Up
+-- country is an ISO 3166-1 alpha-2 code and release_type is theatrical, digital or physical. certification is the
+-- local certification such as PG-13 or FSK 16 and minimum_age is the age which it allows the movie from, it is
+-- used to compare the certifications of the different countries
+CREATE TABLE IF NOT EXISTS `release_dates` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `country` CHAR(2) NOT NULL,
+  `release_type` VARCHAR(20) NOT NULL,
+  `release_date` TIMESTAMP NOT NULL,
+  `certification` VARCHAR(20) DEFAULT NULL,
+  `minimum_age` TINYINT UNSIGNED DEFAULT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `unique_release_dates_movie_id_country_release_type` (`movie_id`, `country`, `release_type`),
+  INDEX `index_release_dates_country_release_date` (`country`, `release_date`),
+  CONSTRAINT `fk_release_dates_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- country is the default of the country query parameter for the user
+ALTER TABLE `users` ADD COLUMN `country` CHAR(2) DEFAULT NULL AFTER `reveal_spoilers`;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `country`;
+DROP TABLE IF EXISTS `release_dates`;
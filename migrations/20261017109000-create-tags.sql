@@ -0,0 +1,33 @@
+-- +migrate Up
+-- slug is the normalized tag such as "time-travel", it is written in lower case with the words joined by "-"
+CREATE TABLE IF NOT EXISTS `tags` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `slug` VARCHAR(50) NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `unique_tags_slug` (`slug`),
+  FULLTEXT INDEX `fulltext_tags` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- a row is a tag which a user applied to a movie, a user applies a tag to a movie at most once
+CREATE TABLE IF NOT EXISTS `movie_tags` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `tag_id` BIGINT UNSIGNED NOT NULL,
+  `user_id` BIGINT UNSIGNED NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`movie_id`, `tag_id`, `user_id`),
+  INDEX `index_movie_tags_tag_id_movie_id` (`tag_id`, `movie_id`),
+  CONSTRAINT `fk_movie_tags_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_movie_tags_tag_id_to_tags_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_movie_tags_user_id_to_users_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_tags`;
+DROP TABLE IF EXISTS `tags`;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- source is the system which identifies the movie by external_id such as imdb, tmdb or wikidata, a movie has at most
+-- one id per source and an id of a source identifies one movie
+CREATE TABLE IF NOT EXISTS `movie_external_ids` (
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `source` VARCHAR(20) NOT NULL,
+  `external_id` VARCHAR(50) NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`movie_id`, `source`),
+  UNIQUE INDEX `unique_movie_external_ids_source_external_id` (`source`, `external_id`),
+  CONSTRAINT `fk_movie_external_ids_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `movie_external_ids`;
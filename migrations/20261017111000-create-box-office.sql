@@ -0,0 +1,38 @@
+-- +migrate Up
+-- budget and revenue are amounts in the minor unit of currency such as cents of USD, currency is an ISO 4217 code.
+-- The amounts which were stored before are whole US dollars
+ALTER TABLE `movies`
+  MODIFY COLUMN `budget` BIGINT UNSIGNED DEFAULT NULL,
+  MODIFY COLUMN `revenue` BIGINT DEFAULT NULL,
+  ADD COLUMN `currency` CHAR(3) DEFAULT NULL AFTER `revenue`;
+
+UPDATE `movies` SET `budget` = `budget` * 100, `revenue` = `revenue` * 100, `currency` = 'USD'
+WHERE `budget` IS NOT NULL OR `revenue` IS NOT NULL;
+
+-- gross is the amount which the movie grossed in territory during the week starting on week_start, in the minor
+-- unit of currency. territory is an ISO 3166-1 alpha-2 country code
+CREATE TABLE IF NOT EXISTS `box_office_grosses` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `movie_id` BIGINT UNSIGNED NOT NULL,
+  `territory` CHAR(2) NOT NULL,
+  `week_start` DATE NOT NULL,
+  `gross` BIGINT NOT NULL,
+  `currency` CHAR(3) NOT NULL,
+
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `unique_box_office_grosses_movie_id_territory_week_start` (`movie_id`, `territory`, `week_start`),
+  CONSTRAINT `fk_box_office_grosses_movie_id_to_movies_id` FOREIGN KEY (`movie_id`) REFERENCES `movies` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `box_office_grosses`;
+
+UPDATE `movies` SET `budget` = `budget` DIV 100, `revenue` = `revenue` DIV 100;
+
+ALTER TABLE `movies`
+  DROP COLUMN `currency`,
+  MODIFY COLUMN `budget` INT DEFAULT NULL,
+  MODIFY COLUMN `revenue` INT DEFAULT NULL;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- release_date is a date without a time zone which is known only to release_date_precision, a date which is known
+-- only to the year or to the month is stored as its first day such as 1927-01-01 for 1927
+ALTER TABLE `movies`
+  MODIFY COLUMN `release_date` DATE DEFAULT NULL,
+  ADD COLUMN `release_date_precision` ENUM('year', 'month', 'day') DEFAULT NULL AFTER `release_date`,
+  ADD INDEX `index_movies_release_date_release_date_precision` (`release_date`, `release_date_precision`);
+
+UPDATE `movies` SET `release_date_precision` = 'day' WHERE `release_date` IS NOT NULL;
+
+ALTER TABLE `release_dates` MODIFY COLUMN `release_date` DATE NOT NULL;
+
+-- +migrate Down
+-- TIMESTAMP can not store the dates before 1970, they are cleared
+UPDATE `movies` SET `release_date` = NULL WHERE `release_date` < '1970-01-02';
+DELETE FROM `release_dates` WHERE `release_date` < '1970-01-02';
+
+ALTER TABLE `release_dates` MODIFY COLUMN `release_date` TIMESTAMP NOT NULL;
+
+ALTER TABLE `movies`
+  DROP INDEX `index_movies_release_date_release_date_precision`,
+  DROP COLUMN `release_date_precision`,
+  MODIFY COLUMN `release_date` TIMESTAMP DEFAULT NULL;
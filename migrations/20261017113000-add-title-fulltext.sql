@@ -0,0 +1,8 @@
+-- +migrate Up
+-- a MATCH must name the columns of a FULLTEXT index exactly, these indexes search the titles only
+CREATE FULLTEXT INDEX `fulltext_movies_original_title` ON `movies` (`original_title`);
+CREATE FULLTEXT INDEX `fulltext_movie_translations_title` ON `movie_translations` (`title`);
+
+-- +migrate Down
+ALTER TABLE `movie_translations` DROP INDEX `fulltext_movie_translations_title`;
+ALTER TABLE `movies` DROP INDEX `fulltext_movies_original_title`;
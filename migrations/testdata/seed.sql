@@ -11,6 +11,30 @@ VALUES (
     '$2a$10$qGzkPHjjh/n8N60ARb.BvObjkthrEFF.NCjPKN3RPqDQbpec0JEtG' -- password: secret --
   );
 
+INSERT INTO `users` (`username`, `email`, `hashed_password`, `role`)
+VALUES (
+    'testmoderator',
+    'testmoderator@gmail.com',
+    '$2a$10$qGzkPHjjh/n8N60ARb.BvObjkthrEFF.NCjPKN3RPqDQbpec0JEtG', -- password: secret --
+    'moderator'
+  );
+
+INSERT INTO `users` (`username`, `email`, `hashed_password`, `role`)
+VALUES (
+    'testadmin',
+    'testadmin@gmail.com',
+    '$2a$10$qGzkPHjjh/n8N60ARb.BvObjkthrEFF.NCjPKN3RPqDQbpec0JEtG', -- password: secret --
+    'admin'
+  );
+
+INSERT INTO `users` (`username`, `email`, `hashed_password`, `is_critic`)
+VALUES (
+    'testcritic',
+    'testcritic@gmail.com',
+    '$2a$10$qGzkPHjjh/n8N60ARb.BvObjkthrEFF.NCjPKN3RPqDQbpec0JEtG', -- password: secret --
+    true
+  );
+
 SELECT 'insert movies';
 
 INSERT INTO `movies` (
@@ -19,53 +43,181 @@ INSERT INTO `movies` (
     `overview`,
     `adult`,
     `release_date`,
+    `release_date_precision`,
     `budget`,
-    `revenue`
+    `revenue`,
+    `currency`
   )
 VALUES (
     "accumsan sed, facilisis vitae,",
     "Nigeria",
     "risus. Donec nibh enim, gravida sit amet, dapibus id, blandit at, nisi. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus. Proin vel nisl. Quisque fringilla euismod enim. Etiam gravida molestie arcu. Sed eu nibh vulputate mauris sagittis placerat. Cras dictum ultricies ligula. Nullam enim. Sed nulla ante, iaculis nec, eleifend non, dapibus rutrum, justo. Praesent luctus. Curabitur egestas nunc sed libero. Proin sed turpis nec mauris blandit mattis. Cras",
     true,
-    "2022-01-04 02:20:10",
-    6036080,
-    2576380
+    "2022-01-04",
+    "day",
+    603608000,
+    257638000,
+    "USD"
   ),
   (
     "vitae velit egestas lacinia.",
     "Singapore",
     "dui. Suspendisse ac metus vitae velit egestas lacinia. Sed congue, elit sed consequat auctor, nunc nulla vulputate dui, nec tempus mauris erat eget ipsum. Suspendisse sagittis. Nullam vitae diam. Proin dolor. Nulla semper tellus id nunc interdum feugiat. Sed nec metus facilisis lorem tristique aliquet. Phasellus fermentum convallis ligula. Donec luctus aliquet odio. Etiam ligula tortor, dictum eu, placerat eget, venenatis a, magna. Lorem",
     true,
-    "2022-06-07 16:04:01",
-    8028273,
-    9315618
+    "2022-06-07",
+    "day",
+    802827300,
+    931561800,
+    "USD"
   ),
   (
     "nunc sit",
     "Belgium",
     "Class aptent taciti sociosqu ad litora torquent per conubia nostra, per inceptos hymenaeos. Mauris ut quam vel sapien imperdiet ornare. In faucibus. Morbi vehicula. Pellentesque tincidunt tempus risus. Donec egestas. Duis ac arcu. Nunc mauris. Morbi non sapien molestie orci tincidunt adipiscing. Mauris molestie pharetra nibh. Aliquam ornare, libero at auctor ullamcorper, nisl arcu iaculis enim, sit amet ornare lectus justo eu arcu. Morbi sit amet massa. Quisque porttitor eros nec",
     false,
-    "2021-12-04 00:59:26",
-    432042,
-    2997960
+    "2021-12-04",
+    "day",
+    43204200,
+    299796000,
+    "USD"
   ),
   (
     "semper pretium neque. Morbi quis urna.",
     "Vietnam",
     "Sed pharetra, felis eget varius ultrices, mauris ipsum porta elit, a feugiat tellus lorem eu metus. In lorem. Donec elementum, lorem ut aliquam iaculis, lacus pede sagittis augue, eu tempor erat neque non quam. Pellentesque habitant morbi tristique senectus et netus et malesuada fames ac turpis egestas. Aliquam fringilla cursus purus.",
     true,
-    "2023-04-24 14:17:01",
-    1086918,
-    8711503
+    "1915-01-01",
+    "year",
+    108691800,
+    871150300,
+    "USD"
   ),
   (
     "arcu. Vivamus sit amet risus. Donec egestas. Aliquam",
     "Belgium",
     "egestas, urna justo faucibus lectus, a sollicitudin orci sem eget massa. Suspendisse eleifend. Cras sed leo. Cras vehicula aliquet libero. Integer in magna. Phasellus dolor elit, pellentesque a, facilisis non, bibendum sed, est. Nunc laoreet lectus quis massa. Mauris vestibulum, neque sed dictum eleifend, nunc risus varius orci, in consequat enim diam vel arcu. Curabitur ut odio vel est tempor bibendum. Donec felis orci, adipiscing non, luctus sit amet, faucibus ut, nulla.",
     false,
-    "2023-01-09 02:25:17",
-    3568324,
-    5716009
+    "2023-01-09",
+    "day",
+    356832400,
+    571600900,
+    "USD"
   );
 
+SELECT 'insert movie genres';
+
+INSERT INTO `movie_genres` (`movie_id`, `genre_id`)
+SELECT `movies`.`id`, `genres`.`id`
+FROM `movies`
+INNER JOIN `genres`
+ON (`movies`.`id`, `genres`.`slug`) IN (
+  (1, 'horror'), (1, 'thriller'),
+  (2, 'comedy'), (2, 'romance'),
+  (3, 'action'), (3, 'science-fiction'), (3, 'thriller'),
+  (4, 'drama'),
+  (5, 'animation'), (5, 'family')
+);
+
+SELECT 'insert people';
+
+INSERT INTO `people` (`name`, `biography`)
+VALUES
+  ("Christopher Nolan", "British-American filmmaker known for his non-linear storytelling."),
+  ("Emma Thompson", NULL),
+  ("Nguyen Van An", NULL),
+  ("Lucas Peeters", "Belgian actor and screenwriter."),
+  ("Hans Zimmer", "German film score composer.");
+
+SELECT 'insert credits';
+
+INSERT INTO `credits` (`movie_id`, `person_id`, `role`, `department`, `character_name`, `billing_order`)
+VALUES
+  (1, 1, "Director", "Directing", NULL, 0),
+  (1, 2, "Actor", "Acting", "Mara", 0),
+  (1, 4, "Actor", "Acting", "The Stranger", 1),
+  (1, 5, "Original Music Composer", "Sound", NULL, 1),
+  (3, 1, "Director", "Directing", NULL, 0),
+  (3, 1, "Screenplay", "Writing", NULL, 1),
+  (3, 3, "Actor", "Acting", "An", 0),
+  (3, 5, "Original Music Composer", "Sound", NULL, 2),
+  (5, 4, "Actor", "Acting", "Narrator", 0),
+  (5, 4, "Director", "Directing", NULL, 0);
+
+SELECT 'insert movie translations';
+
+INSERT INTO `movie_translations` (`movie_id`, `language`, `title`, `overview`)
+VALUES
+  (1, "vi", "Người lạ trong đêm", "Một người lạ xuất hiện trong ngôi làng nhỏ và mọi thứ thay đổi."),
+  (1, "fr", "L'étranger de la nuit", NULL),
+  (3, "vi", "Mãi mãi", "Câu chuyện về An và những ngày cuối cùng của mùa hè."),
+  (3, "pt-BR", "Para sempre", "A história de An e os últimos dias do verão.");
+
+SELECT 'insert collections';
+
+INSERT INTO `collections` (`name`, `overview`)
+VALUES
+  ("The Stranger Collection", "The stories of the strangers who change a small village forever.");
+
+INSERT INTO `collection_movies` (`collection_id`, `movie_id`, `position`)
+VALUES
+  (1, 1, 1),
+  (1, 3, 2);
+
+SELECT 'insert release dates';
+
+INSERT INTO `release_dates` (`movie_id`, `country`, `release_type`, `release_date`, `certification`, `minimum_age`)
+VALUES
+  (1, "US", "theatrical", "2019-10-31", "R", 17),
+  (1, "US", "digital", "2020-02-14", "R", 17),
+  (1, "JP", "theatrical", "2020-03-20", "R15+", 15),
+  (2, "US", "theatrical", "2021-07-02", "PG", 0),
+  (2, "JP", "theatrical", "2021-09-10", "G", 0),
+  (2, "DE", "physical", "2022-01-20", "FSK 6", 6),
+  (3, "JP", "theatrical", "2022-04-15", "PG12", 12),
+  (3, "VN", "theatrical", "2022-04-01", "T13", 13),
+  (3, "DE", "digital", "2022-06-30", "FSK 12", 12),
+  (5, "JP", "digital", "2023-01-09", NULL, NULL);
+
+SELECT 'insert tags';
+
+INSERT INTO `tags` (`slug`)
+VALUES
+  ("time-travel"),
+  ("slow-burn"),
+  ("twist-ending");
+
+INSERT INTO `movie_tags` (`movie_id`, `tag_id`, `user_id`)
+VALUES
+  (1, 1, 1),
+  (1, 1, 2),
+  (1, 1, 4),
+  (1, 2, 1),
+  (1, 3, 4),
+  (3, 1, 1),
+  (3, 2, 1),
+  (3, 2, 2);
+
+SELECT 'insert movie external ids';
+
+INSERT INTO `movie_external_ids` (`movie_id`, `source`, `external_id`)
+VALUES
+  (1, "imdb", "tt0111161"),
+  (1, "tmdb", "278"),
+  (1, "wikidata", "Q172241"),
+  (2, "imdb", "tt0068646"),
+  (3, "imdb", "tt0468569"),
+  (3, "tmdb", "155");
+
+SELECT 'insert box office grosses';
+
+INSERT INTO `box_office_grosses` (`movie_id`, `territory`, `week_start`, `gross`, `currency`)
+VALUES
+  (1, "US", "2022-01-07", 9813250000, "USD"),
+  (1, "US", "2022-01-14", 5120070000, "USD"),
+  (1, "US", "2022-01-21", 2310000000, "USD"),
+  (1, "JP", "2022-01-14", 120000000, "JPY"),
+  (1, "JP", "2022-01-21", 85000000, "JPY"),
+  (3, "US", "2022-04-01", 450000000, "USD"),
+  (3, "VN", "2022-04-01", 3500000000000, "VND");
+
 COMMIT;
@@ -0,0 +1,35 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode returns the opaque cursor of the position, the position is written as url safe base64 of its JSON so
+// that the cursor can be passed in a query without escaping
+func Encode(position interface{}) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode reads the position of a cursor which is returned by Encode, it returns ErrInvalid for any other cursor
+func Decode(cursor string, position interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalid
+	}
+
+	if err := json.Unmarshal(data, position); err != nil {
+		return ErrInvalid
+	}
+
+	return nil
+}
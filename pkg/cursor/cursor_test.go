@@ -0,0 +1,70 @@
+package cursor_test
+
+import (
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/cursor"
+	"github.com/stretchr/testify/assert"
+)
+
+type position struct {
+	ID    uint64   `json:"id"`
+	Score *float64 `json:"score,omitempty"`
+}
+
+func TestEncodeDecode(t *testing.T) {
+	score := 7.123456789012345
+
+	cases := []struct {
+		name     string
+		position position
+	}{
+		{
+			name:     "round_trips_position",
+			position: position{ID: 42},
+		},
+		{
+			name:     "round_trips_float_exactly",
+			position: position{ID: 1, Score: &score},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			encoded, err := cursor.Encode(c.position)
+			assert.NoError(t, err)
+			assert.NotContains(t, encoded, "=")
+
+			var decoded position
+			assert.NoError(t, cursor.Decode(encoded, &decoded))
+			assert.Equal(t, c.position, decoded)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	cases := []struct {
+		name   string
+		cursor string
+	}{
+		{
+			name:   "returns_error_when_cursor_is_not_base64",
+			cursor: "not a cursor!",
+		},
+		{
+			name:   "returns_error_when_cursor_is_not_json",
+			cursor: "bm90IGpzb24",
+		},
+		{
+			name:   "returns_error_when_cursor_has_other_type",
+			cursor: "eyJpZCI6Im9uZSJ9",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var decoded position
+			assert.Equal(t, cursor.ErrInvalid, cursor.Decode(c.cursor, &decoded))
+		})
+	}
+}
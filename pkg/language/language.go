@@ -0,0 +1,90 @@
+package language
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseAcceptLanguage returns the language tags of an Accept-Language header ordered by preference, e.g.
+// "vi;q=0.8, en-US, en;q=0.9" returns [en-us en vi]. The tags are lower cased, the wildcard and the tags with
+// q=0 or an invalid q are left out
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var weightedTags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := Normalize(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || strings.TrimSpace(name) != "q" {
+				continue
+			}
+
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				q = 0
+			}
+			quality = q
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		weightedTags = append(weightedTags, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(weightedTags, func(i, j int) bool {
+		return weightedTags[i].quality > weightedTags[j].quality
+	})
+
+	tags := make([]string, len(weightedTags))
+	for i, weightedTag := range weightedTags {
+		tags[i] = weightedTag.tag
+	}
+
+	return tags
+}
+
+// Normalize lower cases the language tag and separates its subtags with "-", e.g. "pt_BR" returns "pt-br"
+func Normalize(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
+
+// Match returns the best of the available tags for the preferred tags which are ordered by preference.
+// A preferred tag matches an available tag which is the same or which has the same primary language, e.g.
+// "pt-BR" matches "pt" and "en" matches "en-US", the same one is chosen first. ok is false when no tag matches
+func Match(preferred []string, available []string) (tag string, ok bool) {
+	for _, p := range preferred {
+		p = Normalize(p)
+		for _, a := range available {
+			if Normalize(a) == p {
+				return a, true
+			}
+		}
+
+		for _, a := range available {
+			if primary(Normalize(a)) == primary(p) {
+				return a, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// primary returns the primary language subtag of the normalized tag, e.g. "en" of "en-us"
+func primary(tag string) string {
+	primary, _, _ := strings.Cut(tag, "-")
+	return primary
+}
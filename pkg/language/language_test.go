@@ -0,0 +1,104 @@
+package language_test
+
+import (
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/language"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	cases := []struct {
+		name     string
+		header   string
+		expected []string
+	}{
+		{
+			name:     "returns_nothing_when_header_is_empty",
+			header:   "",
+			expected: []string{},
+		},
+		{
+			name:     "orders_tags_by_quality",
+			header:   "vi;q=0.8, en-US, en;q=0.9",
+			expected: []string{"en-us", "en", "vi"},
+		},
+		{
+			name:     "keeps_order_of_header_when_quality_is_same",
+			header:   "fr, de",
+			expected: []string{"fr", "de"},
+		},
+		{
+			name:     "leaves_out_wildcard_and_unacceptable_tags",
+			header:   "ja, *;q=0.5, ko;q=0, zh;q=abc",
+			expected: []string{"ja"},
+		},
+		{
+			name:     "normalizes_tags",
+			header:   " pt_BR ;q=0.7",
+			expected: []string{"pt-br"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, language.ParseAcceptLanguage(c.header))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		preferred   []string
+		available   []string
+		expected    string
+		expectMatch bool
+	}{
+		{
+			name:        "matches_same_tag",
+			preferred:   []string{"vi"},
+			available:   []string{"en", "vi"},
+			expected:    "vi",
+			expectMatch: true,
+		},
+		{
+			name:        "matches_same_tag_before_same_primary_language",
+			preferred:   []string{"pt-BR"},
+			available:   []string{"pt", "pt-br"},
+			expected:    "pt-br",
+			expectMatch: true,
+		},
+		{
+			name:        "matches_same_primary_language",
+			preferred:   []string{"en"},
+			available:   []string{"en-US"},
+			expected:    "en-US",
+			expectMatch: true,
+		},
+		{
+			name:        "matches_in_order_of_preference",
+			preferred:   []string{"ja", "fr", "en"},
+			available:   []string{"en", "fr-CA"},
+			expected:    "fr-CA",
+			expectMatch: true,
+		},
+		{
+			name:      "returns_no_match_when_nothing_matches",
+			preferred: []string{"ja"},
+			available: []string{"en", "vi"},
+		},
+		{
+			name:      "returns_no_match_when_nothing_is_preferred",
+			available: []string{"en"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, ok := language.Match(c.preferred, c.available)
+			assert.Equal(t, c.expected, res)
+			assert.Equal(t, c.expectMatch, ok)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package searchquery
+
+import (
+	"sort"
+	"strings"
+)
+
+// HighlightOptions wraps every match in PreTag and PostTag. A fragment is about FragmentSize characters around
+// the matches and is cut between words, the whole text is a single fragment when FragmentSize is 0. At most
+// MaxFragments fragments are returned, there is no limit when it is 0
+type HighlightOptions struct {
+	PreTag       string
+	PostTag      string
+	FragmentSize int
+	MaxFragments int
+}
+
+// span is the runes from start to end (exclusive) of a text
+type span struct {
+	start int
+	end   int
+}
+
+// Highlight returns the fragments of text in which the terms of the query match, field is the field of the text
+// and only the terms of the field or of FieldAny match. The excluded terms never match and the words are compared
+// without case like the full-text search. It returns nil when no term matches
+func (q Query) Highlight(text string, field string, options HighlightOptions) []string {
+	runes := []rune(text)
+	words := wordSpans(runes)
+	matches := q.matches(runes, words, field)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	if options.FragmentSize <= 0 || len(runes) <= options.FragmentSize {
+		return []string{mark(runes, span{start: 0, end: len(runes)}, matches, options)}
+	}
+
+	fragments := make([]string, 0)
+	previousEnd := 0
+	for i := 0; i < len(matches); {
+		if options.MaxFragments > 0 && len(fragments) == options.MaxFragments {
+			break
+		}
+
+		fragment := fragmentAround(matches[i], words, len(runes), options.FragmentSize)
+		if fragment.start < previousEnd {
+			fragment.start = previousEnd
+			if fragment.start > matches[i].start {
+				fragment.start = matches[i].start
+			}
+		}
+
+		j := i + 1
+		for j < len(matches) && matches[j].end <= fragment.end {
+			j++
+		}
+
+		fragments = append(fragments, strings.TrimSpace(mark(runes, fragment, matches[i:j], options)))
+		previousEnd = fragment.end
+		i = j
+	}
+
+	return fragments
+}
+
+// matches returns the spans of the words which the terms match ordered by their start, the overlapping spans
+// are merged
+func (q Query) matches(runes []rune, words []span, field string) []span {
+	matches := make([]span, 0)
+	for _, term := range q.Terms {
+		if term.Exclude || (term.Field != FieldAny && term.Field != field) {
+			continue
+		}
+
+		for i := 0; i+len(term.Words) <= len(words); i++ {
+			if term.matchesAt(runes, words[i:]) {
+				matches = append(matches, span{start: words[i].start, end: words[i+len(term.Words)-1].end})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].start < matches[j].start
+	})
+
+	merged := make([]span, 0, len(matches))
+	for _, match := range matches {
+		last := len(merged) - 1
+		if last >= 0 && match.start < merged[last].end {
+			if match.end > merged[last].end {
+				merged[last].end = match.end
+			}
+			continue
+		}
+
+		merged = append(merged, match)
+	}
+
+	return merged
+}
+
+// matchesAt returns whether the term matches the words starting at the first one
+func (t Term) matchesAt(runes []rune, words []span) bool {
+	for i, word := range t.Words {
+		text := runes[words[i].start:words[i].end]
+		if t.Prefix {
+			prefix := []rune(word)
+			return len(text) >= len(prefix) && strings.EqualFold(string(text[:len(prefix)]), word)
+		}
+
+		if !strings.EqualFold(string(text), word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wordSpans returns the spans of the words of the runes, a word is a run of the runes which words keeps
+func wordSpans(runes []rune) []span {
+	spans := make([]span, 0)
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(runes) && isWordRune(runes[i]) {
+			i++
+		}
+		spans = append(spans, span{start: start, end: i})
+	}
+
+	return spans
+}
+
+// fragmentAround returns the span of about size runes which has the match at its center, it starts at the
+// beginning of a word and ends at the end of a word so that no word is cut unless it is at an end of the text
+func fragmentAround(match span, words []span, length int, size int) span {
+	padding := (size - (match.end - match.start)) / 2
+	if padding < 0 {
+		padding = 0
+	}
+
+	fragment := span{start: match.start - padding, end: match.end + padding}
+	if fragment.start < 0 {
+		fragment.end -= fragment.start
+		fragment.start = 0
+	}
+
+	if fragment.end > length {
+		fragment.start -= fragment.end - length
+		fragment.end = length
+		if fragment.start < 0 {
+			fragment.start = 0
+		}
+	}
+
+	for _, word := range words {
+		if fragment.start == 0 {
+			break
+		}
+
+		if word.start >= fragment.start {
+			if word.start < match.start {
+				fragment.start = word.start
+			} else {
+				fragment.start = match.start
+			}
+			break
+		}
+	}
+
+	for i := len(words) - 1; i >= 0 && fragment.end < length; i-- {
+		if words[i].end <= fragment.end {
+			if words[i].end > match.end {
+				fragment.end = words[i].end
+			} else {
+				fragment.end = match.end
+			}
+			break
+		}
+	}
+
+	return fragment
+}
+
+// mark returns the runes of the fragment with the matches in it wrapped in the tags
+func mark(runes []rune, fragment span, matches []span, options HighlightOptions) string {
+	var b strings.Builder
+	position := fragment.start
+	for _, match := range matches {
+		b.WriteString(string(runes[position:match.start]))
+		b.WriteString(options.PreTag)
+		b.WriteString(string(runes[match.start:match.end]))
+		b.WriteString(options.PostTag)
+		position = match.end
+	}
+	b.WriteString(string(runes[position:fragment.end]))
+
+	return b.String()
+}
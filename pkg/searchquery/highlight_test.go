@@ -0,0 +1,88 @@
+package searchquery_test
+
+import (
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	testOverview := "Warrant officer Ellen Ripley wakes up after 57 years in stasis. The crew of the Nostromo was " +
+		"killed by an alien creature and nobody believes Ripley, the aliens are real."
+
+	cases := []struct {
+		name     string
+		query    string
+		text     string
+		field    string
+		options  searchquery.HighlightOptions
+		expected []string
+	}{
+		{
+			name:     "wraps_matches_of_whole_text_when_it_is_short",
+			query:    "alien",
+			text:     "Alien: Covenant",
+			options:  searchquery.HighlightOptions{PreTag: "<em>", PostTag: "</em>", FragmentSize: 100},
+			expected: []string{"<em>Alien</em>: Covenant"},
+		},
+		{
+			name:     "wraps_phrase_and_words_starting_with_prefix",
+			query:    `"the thing" carp*`,
+			text:     "John Carpenter's The Thing",
+			options:  searchquery.HighlightOptions{PreTag: "[", PostTag: "]"},
+			expected: []string{"John [Carpenter]'s [The Thing]"},
+		},
+		{
+			name:     "leaves_out_excluded_terms_and_terms_of_other_field",
+			query:    "title:alien -ship space",
+			text:     "Alien space ship",
+			options:  searchquery.HighlightOptions{PreTag: "[", PostTag: "]"},
+			expected: []string{"Alien [space] ship"},
+		},
+		{
+			name:     "wraps_terms_of_field",
+			query:    "title:alien -ship space",
+			text:     "Alien space ship",
+			field:    searchquery.FieldTitle,
+			options:  searchquery.HighlightOptions{PreTag: "[", PostTag: "]"},
+			expected: []string{"[Alien] [space] ship"},
+		},
+		{
+			name:    "returns_fragments_around_matches_cut_between_words",
+			query:   "ripley alien*",
+			text:    testOverview,
+			options: searchquery.HighlightOptions{PreTag: "<em>", PostTag: "</em>", FragmentSize: 40},
+			expected: []string{
+				"officer Ellen <em>Ripley</em> wakes up after",
+				"was killed by an <em>alien</em> creature and",
+				"nobody believes <em>Ripley</em>, the <em>aliens</em> are",
+			},
+		},
+		{
+			name:    "returns_at_most_max_fragments",
+			query:   "ripley alien*",
+			text:    testOverview,
+			options: searchquery.HighlightOptions{PreTag: "<em>", PostTag: "</em>", FragmentSize: 40, MaxFragments: 2},
+			expected: []string{
+				"officer Ellen <em>Ripley</em> wakes up after",
+				"was killed by an <em>alien</em> creature and",
+			},
+		},
+		{
+			name:     "returns_nil_when_no_term_matches",
+			query:    "predator",
+			text:     "Alien: Covenant",
+			options:  searchquery.HighlightOptions{PreTag: "<em>", PostTag: "</em>"},
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			query, err := searchquery.Parse(c.query)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, query.Highlight(c.text, c.field, c.options))
+		})
+	}
+}
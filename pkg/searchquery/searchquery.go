@@ -0,0 +1,214 @@
+package searchquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	// FieldAny matches a term with every searchable text of a movie
+	FieldAny = ""
+	// FieldTitle matches a term with the titles of a movie only, e.g. title:alien
+	FieldTitle = "title"
+)
+
+// MaxTerms bounds the number of terms of a query, every term is a condition of the search
+const MaxTerms = 10
+
+var fields = map[string]bool{FieldTitle: true}
+
+var (
+	ErrNoTerm        = errors.New("query has no word to search")
+	ErrOnlyExclusion = errors.New("query must have a term which is not excluded")
+	ErrTooManyTerms  = fmt.Errorf("query has more than %d terms", MaxTerms)
+)
+
+// SyntaxError is a malformed query, Position is the 1-based position of the character where it is found
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// Term matches its Words in Field. The words of a Phrase are matched next to each other in order, the only word
+// of a Prefix term matches every word starting with it and an Exclude term removes what it matches
+type Term struct {
+	Words   []string
+	Field   string
+	Phrase  bool
+	Prefix  bool
+	Exclude bool
+}
+
+type Query struct {
+	Terms []Term
+}
+
+// Parse parses a query made of terms separated by spaces, all of them must be satisfied:
+//
+//	alien               matches the word
+//	ali*                matches the words starting with ali
+//	"alien isolation"   matches the phrase
+//	-alien              removes what the term matches
+//	title:alien         matches the term with the titles only
+//
+// A word is a run of letters, digits and "_", any other character separates the words and is never an operator,
+// so a term such as spider-man is matched as the phrase "spider man". A term without any word is left out
+func Parse(query string) (Query, error) {
+	runes := []rune(query)
+	terms := make([]Term, 0)
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		term, next, err := parseTerm(runes, i)
+		if err != nil {
+			return Query{}, err
+		}
+		i = next
+
+		if len(term.Words) > 0 {
+			terms = append(terms, term)
+		}
+	}
+
+	if len(terms) == 0 {
+		return Query{}, ErrNoTerm
+	}
+
+	if len(terms) > MaxTerms {
+		return Query{}, ErrTooManyTerms
+	}
+
+	for _, term := range terms {
+		if !term.Exclude {
+			return Query{Terms: terms}, nil
+		}
+	}
+
+	return Query{}, ErrOnlyExclusion
+}
+
+// parseTerm parses the term starting at runes[start] and returns it with the position following it
+func parseTerm(runes []rune, start int) (Term, int, error) {
+	term := Term{}
+	i := start
+
+	if runes[i] == '-' {
+		term.Exclude = true
+		i++
+		if endOfTerm(runes, i) {
+			return Term{}, 0, &SyntaxError{Position: start + 1, Message: `"-" must be followed by a term`}
+		}
+	}
+
+	if field, n := fieldAt(runes, i); n > 0 {
+		term.Field = field
+		i += n
+		if endOfTerm(runes, i) {
+			return Term{}, 0, &SyntaxError{
+				Position: i - n + 1,
+				Message:  fmt.Sprintf(`"%s:" must be followed by a term`, field),
+			}
+		}
+	}
+
+	if runes[i] == '"' {
+		end := indexRune(runes, i+1, '"')
+		if end < 0 {
+			return Term{}, 0, &SyntaxError{Position: i + 1, Message: "unclosed quote"}
+		}
+
+		term.Words = words(runes[i+1 : end])
+		term.Phrase = true
+		if len(term.Words) == 0 {
+			return Term{}, 0, &SyntaxError{Position: i + 1, Message: "empty phrase"}
+		}
+
+		next := end + 1
+		if next < len(runes) && runes[next] == '*' {
+			return Term{}, 0, &SyntaxError{Position: next + 1, Message: "prefix matching is not supported on a phrase"}
+		}
+
+		if !endOfTerm(runes, next) {
+			return Term{}, 0, &SyntaxError{Position: next + 1, Message: "a phrase must be followed by a space"}
+		}
+
+		return term, next, nil
+	}
+
+	end := i
+	for ; !endOfTerm(runes, end); end++ {
+		if runes[end] == '"' {
+			return Term{}, 0, &SyntaxError{Position: end + 1, Message: "a quote must start a term"}
+		}
+	}
+
+	text := runes[i:end]
+	for len(text) > 0 && text[len(text)-1] == '*' {
+		text = text[:len(text)-1]
+		term.Prefix = true
+	}
+
+	term.Words = words(text)
+	if len(term.Words) > 1 {
+		if term.Prefix {
+			return Term{}, 0, &SyntaxError{Position: start + 1, Message: "prefix matching is not supported on a phrase"}
+		}
+		term.Phrase = true
+	}
+
+	return term, end, nil
+}
+
+// fieldAt returns the field name which is written as "name:" at runes[start] with its length including ":",
+// the length is 0 when there is no known field
+func fieldAt(runes []rune, start int) (string, int) {
+	end := start
+	for end < len(runes) && unicode.IsLetter(runes[end]) {
+		end++
+	}
+
+	if end == len(runes) || runes[end] != ':' {
+		return "", 0
+	}
+
+	field := strings.ToLower(string(runes[start:end]))
+	if !fields[field] {
+		return "", 0
+	}
+
+	return field, end - start + 1
+}
+
+func endOfTerm(runes []rune, i int) bool {
+	return i >= len(runes) || unicode.IsSpace(runes[i])
+}
+
+func indexRune(runes []rune, start int, r rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func words(runes []rune) []string {
+	return strings.FieldsFunc(string(runes), func(r rune) bool {
+		return !isWordRune(r)
+	})
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
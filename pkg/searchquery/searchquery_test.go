@@ -0,0 +1,123 @@
+package searchquery_test
+
+import (
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/searchquery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    string
+		expected searchquery.Query
+		err      error
+	}{
+		{
+			name:  "parses_words",
+			query: "  alien   isolation ",
+			expected: searchquery.Query{Terms: []searchquery.Term{
+				{Words: []string{"alien"}},
+				{Words: []string{"isolation"}},
+			}},
+		},
+		{
+			name:  "parses_prefix_phrase_exclusion_and_field",
+			query: `ali* "the thing" -remake title:"blade runner" -title:2049`,
+			expected: searchquery.Query{Terms: []searchquery.Term{
+				{Words: []string{"ali"}, Prefix: true},
+				{Words: []string{"the", "thing"}, Phrase: true},
+				{Words: []string{"remake"}, Exclude: true},
+				{Words: []string{"blade", "runner"}, Field: searchquery.FieldTitle, Phrase: true},
+				{Words: []string{"2049"}, Field: searchquery.FieldTitle, Exclude: true},
+			}},
+		},
+		{
+			name:  "splits_operators_and_punctuation_into_phrase",
+			query: `spider-man+@home o'brien`,
+			expected: searchquery.Query{Terms: []searchquery.Term{
+				{Words: []string{"spider", "man", "home"}, Phrase: true},
+				{Words: []string{"o", "brien"}, Phrase: true},
+			}},
+		},
+		{
+			name:  "keeps_unknown_field_as_words",
+			query: "mission:impossible Title:Alien",
+			expected: searchquery.Query{Terms: []searchquery.Term{
+				{Words: []string{"mission", "impossible"}, Phrase: true},
+				{Words: []string{"Alien"}, Field: searchquery.FieldTitle},
+			}},
+		},
+		{
+			name:  "leaves_out_terms_without_word",
+			query: "@@ ** 千と千尋 >< ()",
+			expected: searchquery.Query{Terms: []searchquery.Term{
+				{Words: []string{"千と千尋"}},
+			}},
+		},
+		{
+			name:  "returns_error_when_quote_is_not_closed",
+			query: `alien "the thing`,
+			err:   &searchquery.SyntaxError{Position: 7, Message: "unclosed quote"},
+		},
+		{
+			name:  "returns_error_when_phrase_is_empty",
+			query: `alien "  "`,
+			err:   &searchquery.SyntaxError{Position: 7, Message: "empty phrase"},
+		},
+		{
+			name:  "returns_error_when_phrase_has_prefix",
+			query: `"the thing"*`,
+			err:   &searchquery.SyntaxError{Position: 12, Message: "prefix matching is not supported on a phrase"},
+		},
+		{
+			name:  "returns_error_when_phrase_is_followed_by_word",
+			query: `"the thing"s`,
+			err:   &searchquery.SyntaxError{Position: 12, Message: "a phrase must be followed by a space"},
+		},
+		{
+			name:  "returns_error_when_quote_is_inside_word",
+			query: `the"thing"`,
+			err:   &searchquery.SyntaxError{Position: 4, Message: "a quote must start a term"},
+		},
+		{
+			name:  "returns_error_when_words_have_prefix",
+			query: `alien spider-ma*`,
+			err:   &searchquery.SyntaxError{Position: 7, Message: "prefix matching is not supported on a phrase"},
+		},
+		{
+			name:  "returns_error_when_exclusion_has_no_term",
+			query: "alien - remake",
+			err:   &searchquery.SyntaxError{Position: 7, Message: `"-" must be followed by a term`},
+		},
+		{
+			name:  "returns_error_when_field_has_no_term",
+			query: "alien -title: remake",
+			err:   &searchquery.SyntaxError{Position: 8, Message: `"title:" must be followed by a term`},
+		},
+		{
+			name:  "returns_error_when_query_has_no_word",
+			query: " @@ * ",
+			err:   searchquery.ErrNoTerm,
+		},
+		{
+			name:  "returns_error_when_all_terms_are_excluded",
+			query: "-alien -title:remake",
+			err:   searchquery.ErrOnlyExclusion,
+		},
+		{
+			name:  "returns_error_when_query_has_too_many_terms",
+			query: "a b c d e f g h i j -k",
+			err:   searchquery.ErrTooManyTerms,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := searchquery.Parse(c.query)
+			assert.Equal(t, c.expected, res)
+			assert.Equal(t, c.err, err)
+		})
+	}
+}
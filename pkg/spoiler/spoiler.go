@@ -0,0 +1,42 @@
+package spoiler
+
+import "strings"
+
+const (
+	// Delimiter encloses an inline spoiler range, e.g. "the hero ||dies|| at the end"
+	Delimiter = "||"
+	// Placeholder replaces a masked spoiler
+	Placeholder = "[spoiler]"
+)
+
+// Mask replaces every closed ||...|| range of text with Placeholder and reports whether anything
+// was replaced, a Delimiter without a closing one is kept as it is
+func Mask(text string) (string, bool) {
+	var b strings.Builder
+	masked := false
+
+	for {
+		start := strings.Index(text, Delimiter)
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(text[start+len(Delimiter):], Delimiter)
+		if end < 0 {
+			break
+		}
+
+		b.WriteString(text[:start])
+		b.WriteString(Placeholder)
+		text = text[start+len(Delimiter)+end+len(Delimiter):]
+		masked = true
+	}
+
+	if !masked {
+		return text, false
+	}
+
+	b.WriteString(text)
+
+	return b.String(), true
+}
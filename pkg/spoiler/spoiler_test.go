@@ -0,0 +1,54 @@
+package spoiler_test
+
+import (
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/spoiler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMask(t *testing.T) {
+	cases := []struct {
+		name         string
+		text         string
+		expected     string
+		expectMasked bool
+	}{
+		{
+			name:     "returns_text_as_it_is_when_there_is_no_spoiler",
+			text:     "a great movie",
+			expected: "a great movie",
+		},
+		{
+			name:         "masks_inline_spoilers",
+			text:         "the hero ||dies|| and ||the villain wins||.",
+			expected:     "the hero [spoiler] and [spoiler].",
+			expectMasked: true,
+		},
+		{
+			name:         "keeps_unclosed_delimiter",
+			text:         "||twist|| then || no end",
+			expected:     "[spoiler] then || no end",
+			expectMasked: true,
+		},
+		{
+			name:     "keeps_text_with_only_unclosed_delimiter",
+			text:     "a || b",
+			expected: "a || b",
+		},
+		{
+			name:         "masks_multibyte_text",
+			text:         "犯人は||執事||だ",
+			expected:     "犯人は[spoiler]だ",
+			expectMasked: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, masked := spoiler.Mask(c.text)
+			assert.Equal(t, c.expected, res)
+			assert.Equal(t, c.expectMasked, masked)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package textdiff
+
+import (
+	"regexp"
+	"strings"
+)
+
+type Op string
+
+const (
+	OpEqual  Op = "equal"
+	OpInsert Op = "insert"
+	OpDelete Op = "delete"
+)
+
+// Segment is a piece of text which is kept, inserted or deleted
+type Segment struct {
+	Op   Op
+	Text string
+}
+
+// maxCells bounds the size of the table used to find the longest common subsequence, the changed parts
+// which are larger than it are diffed as a whole deletion and insertion
+const maxCells = 1 << 20
+
+var tokenPattern = regexp.MustCompile(`^\s+|\S+\s*`)
+
+// Diff returns the word by word changes which turn from into to, each word is compared with its trailing
+// spaces. The adjacent segments of the same operation are merged and joining the non inserted segments
+// gives from back
+func Diff(from, to string) []Segment {
+	a, b := tokenPattern.FindAllString(from, -1), tokenPattern.FindAllString(to, -1)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	segments := make([]Segment, 0)
+	segments = appendTokens(segments, OpEqual, a[:prefix])
+	segments = appendMiddle(segments, a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	segments = appendTokens(segments, OpEqual, a[len(a)-suffix:])
+
+	return segments
+}
+
+func appendMiddle(segments []Segment, a []string, b []string) []Segment {
+	if len(a)*len(b) > maxCells {
+		segments = appendTokens(segments, OpDelete, a)
+		return appendTokens(segments, OpInsert, b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			segments = appendTokens(segments, OpEqual, a[i:i+1])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segments = appendTokens(segments, OpDelete, a[i:i+1])
+			i++
+		default:
+			segments = appendTokens(segments, OpInsert, b[j:j+1])
+			j++
+		}
+	}
+
+	segments = appendTokens(segments, OpDelete, a[i:])
+
+	return appendTokens(segments, OpInsert, b[j:])
+}
+
+// appendTokens appends the tokens as one segment, or into the last segment when it has the same operation
+func appendTokens(segments []Segment, op Op, tokens []string) []Segment {
+	if len(tokens) == 0 {
+		return segments
+	}
+
+	text := strings.Join(tokens, "")
+	if last := len(segments) - 1; last >= 0 && segments[last].Op == op {
+		segments[last].Text += text
+		return segments
+	}
+
+	return append(segments, Segment{Op: op, Text: text})
+}
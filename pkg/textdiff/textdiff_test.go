@@ -0,0 +1,78 @@
+package textdiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samthehai/ml-backend-test-samthehai/pkg/textdiff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []textdiff.Segment
+	}{
+		{
+			name:     "returns_one_equal_segment_when_text_is_not_changed",
+			from:     "really enjoyed it",
+			to:       "really enjoyed it",
+			expected: []textdiff.Segment{{Op: textdiff.OpEqual, Text: "really enjoyed it"}},
+		},
+		{
+			name: "returns_replaced_word",
+			from: "really enjoyed it",
+			to:   "really hated it",
+			expected: []textdiff.Segment{
+				{Op: textdiff.OpEqual, Text: "really "},
+				{Op: textdiff.OpDelete, Text: "enjoyed "},
+				{Op: textdiff.OpInsert, Text: "hated "},
+				{Op: textdiff.OpEqual, Text: "it"},
+			},
+		},
+		{
+			name: "returns_inserted_and_deleted_words_in_the_middle",
+			from: "the hero dies at the very end",
+			to:   "the brave hero dies at the end",
+			expected: []textdiff.Segment{
+				{Op: textdiff.OpEqual, Text: "the "},
+				{Op: textdiff.OpInsert, Text: "brave "},
+				{Op: textdiff.OpEqual, Text: "hero dies at the "},
+				{Op: textdiff.OpDelete, Text: "very "},
+				{Op: textdiff.OpEqual, Text: "end"},
+			},
+		},
+		{
+			name:     "returns_inserted_text_when_from_is_empty",
+			from:     "",
+			to:       "new text",
+			expected: []textdiff.Segment{{Op: textdiff.OpInsert, Text: "new text"}},
+		},
+		{
+			name:     "returns_no_segment_when_both_are_empty",
+			expected: []textdiff.Segment{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, textdiff.Diff(c.from, c.to))
+		})
+	}
+}
+
+func TestDiffOfLargeChange(t *testing.T) {
+	from := "intro " + strings.Repeat("a ", 2000) + "outro"
+	to := "intro " + strings.Repeat("b ", 2000) + "outro"
+
+	segments := textdiff.Diff(from, to)
+
+	assert.Equal(t, []textdiff.Segment{
+		{Op: textdiff.OpEqual, Text: "intro "},
+		{Op: textdiff.OpDelete, Text: strings.Repeat("a ", 2000)},
+		{Op: textdiff.OpInsert, Text: strings.Repeat("b ", 2000)},
+		{Op: textdiff.OpEqual, Text: "outro"},
+	}, segments)
+}
@@ -10,6 +10,10 @@ func TimePtr(v time.Time) *time.Time {
 	return &v
 }
 
+func IntPtr(v int) *int {
+	return &v
+}
+
 func Int64Ptr(v int64) *int64 {
 	return &v
 }
@@ -17,3 +21,15 @@ func Int64Ptr(v int64) *int64 {
 func Uint64Ptr(v uint64) *uint64 {
 	return &v
 }
+
+func Uint8Ptr(v uint8) *uint8 {
+	return &v
+}
+
+func BoolPtr(v bool) *bool {
+	return &v
+}
+
+func Float64Ptr(v float64) *float64 {
+	return &v
+}